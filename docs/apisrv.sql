@@ -16,10 +16,15 @@ CREATE TABLE "users" (
 	"userId" SERIAL NOT NULL,
 	"login" varchar(64) NOT NULL,
 	"password" varchar(64) NOT NULL,
-	"authKey" varchar(32),
 	"createdAt" timestamp with time zone NOT NULL DEFAULT now(),
 	"lastActivityAt" timestamp with time zone,
 	"statusId" int4 NOT NULL,
+	"roleIds" int4[] NOT NULL DEFAULT '{}',
+	"totpSecret" varchar(64),
+	"isTotpEnabled" bool NOT NULL DEFAULT false,
+	"totpCounter" int8 NOT NULL DEFAULT 0,
+	"recoveryCodes" varchar[] NOT NULL DEFAULT '{}',
+	"isService" bool NOT NULL DEFAULT false,
 	CONSTRAINT "users_pkey" PRIMARY KEY("userId")
 );
 
@@ -27,6 +32,76 @@ CREATE INDEX "IX_FK_users_statusId_users" ON "users" USING BTREE (
 	"statusId"
 );
 
+CREATE TABLE "roles" (
+	"roleId" int4 NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+	"title" varchar(255) NOT NULL,
+	"permissions" varchar[] NOT NULL DEFAULT '{}',
+	"isTotpRequired" bool NOT NULL DEFAULT false,
+	"statusId" int4 NOT NULL,
+	PRIMARY KEY("roleId")
+);
+
+CREATE TABLE "sessions" (
+	"sessionId" int4 NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+	"userId" int4 NOT NULL,
+	"tokenHash" varchar(64) NOT NULL,
+	"ip" varchar(64) NOT NULL,
+	"userAgent" varchar(512) NOT NULL,
+	"device" varchar(64) NOT NULL,
+	"idleTimeout" int4 NOT NULL,
+	"createdAt" timestamp with time zone NOT NULL DEFAULT now(),
+	"lastActivityAt" timestamp with time zone NOT NULL,
+	"expiresAt" timestamp with time zone NOT NULL,
+	PRIMARY KEY("sessionId"),
+	CONSTRAINT "sessions_tokenHash_key" UNIQUE("tokenHash")
+);
+
+CREATE INDEX "IX_sessions_userId" ON "sessions" USING BTREE (
+	"userId"
+);
+
+CREATE TABLE "loginAttempts" (
+	"loginAttemptId" int4 NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+	"login" varchar(64) NOT NULL,
+	"userId" int4,
+	"ip" varchar(64) NOT NULL,
+	"userAgent" varchar(512) NOT NULL,
+	"isSuccess" bool NOT NULL,
+	"reason" varchar(32),
+	"isCleared" bool NOT NULL DEFAULT false,
+	"createdAt" timestamp with time zone NOT NULL DEFAULT now(),
+	PRIMARY KEY("loginAttemptId")
+);
+
+CREATE INDEX "IX_loginAttempts_login_createdAt" ON "loginAttempts" USING BTREE (
+	"login",
+	"createdAt"
+);
+
+CREATE INDEX "IX_loginAttempts_ip_createdAt" ON "loginAttempts" USING BTREE (
+	"ip",
+	"createdAt"
+);
+
+CREATE TABLE "apiKeys" (
+	"apiKeyId" int4 NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+	"userId" int4 NOT NULL,
+	"title" varchar(255) NOT NULL,
+	"prefix" varchar(16) NOT NULL,
+	"tokenHash" varchar(64) NOT NULL,
+	"permissions" varchar[] NOT NULL DEFAULT '{}',
+	"expiresAt" timestamp with time zone,
+	"lastUsedAt" timestamp with time zone,
+	"lastUsedIp" varchar(64),
+	"createdAt" timestamp with time zone NOT NULL DEFAULT now(),
+	"statusId" int4 NOT NULL,
+	PRIMARY KEY("apiKeyId"),
+	CONSTRAINT "apiKeys_tokenHash_key" UNIQUE("tokenHash")
+);
+
+CREATE INDEX "IX_apiKeys_userId" ON "apiKeys" USING BTREE (
+	"userId"
+);
 
 CREATE TABLE "vfsFiles" (
 	"fileId" SERIAL NOT NULL,
@@ -95,6 +170,7 @@ CREATE INDEX "IX_vfsHashes_indexedAt" ON "vfsHashes" USING BTREE (
 CREATE TABLE "categories" (
 	"categoryId" int4 NOT NULL GENERATED BY DEFAULT AS IDENTITY,
 	"title" varchar(255) NOT NULL,
+	"slug" varchar(255) NOT NULL,
 	"sort" int4 DEFAULT NULL,
 	"statusId" int4 NOT NULL,
 	PRIMARY KEY("categoryId")
@@ -110,6 +186,7 @@ CREATE TABLE "tags" (
 CREATE TABLE "news" (
 	"newsId" int4 NOT NULL GENERATED BY DEFAULT AS IDENTITY,
 	"title" varchar(255) NOT NULL,
+	"slug" varchar(255) NOT NULL,
 	"shortText" varchar(1024) NOT NULL,
 	"content" text,
 	"author" varchar(255),
@@ -117,10 +194,223 @@ CREATE TABLE "news" (
 	"tagIds" int4[] NOT NULL,
 	"publishedAt" timestamp with time zone NOT NULL,
 	"createdAt" timestamp with time zone NOT NULL DEFAULT now(),
+	"updatedAt" timestamp with time zone NOT NULL DEFAULT now(),
 	"statusId" int4 NOT NULL,
+	"searchVector" tsvector GENERATED ALWAYS AS (
+		setweight(to_tsvector('russian', coalesce("title", '')), 'A') ||
+		setweight(to_tsvector('russian', coalesce("shortText", '')), 'B') ||
+		setweight(to_tsvector('russian', coalesce("content", '')), 'C')
+	) STORED,
 	PRIMARY KEY("newsId")
 );
 
+CREATE INDEX "IX_news_searchVector" ON "news" USING GIN (
+	"searchVector"
+);
+
+CREATE INDEX "IX_news_publishedAt_newsId" ON "news" USING BTREE (
+	"publishedAt" DESC,
+	"newsId" DESC
+);
+
+-- news updatedAt is set on every change, it is used as Last-Modified of feeds.
+CREATE FUNCTION "setUpdatedAt"() RETURNS trigger AS $$
+BEGIN
+	NEW."updatedAt" := now();
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "TR_news_updatedAt" BEFORE UPDATE ON "news" FOR EACH ROW WHEN (OLD IS DISTINCT FROM NEW) EXECUTE FUNCTION "setUpdatedAt"();
+
+CREATE UNIQUE INDEX "UQ_news_slug" ON "news" USING BTREE (
+	"slug"
+);
+
+CREATE UNIQUE INDEX "UQ_categories_slug" ON "categories" USING BTREE (
+	"slug"
+);
+
+CREATE TABLE "newsSlugs" (
+	"newsSlugId" int4 NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+	"slug" varchar(255) NOT NULL,
+	"newsId" int4 NOT NULL,
+	"createdAt" timestamp with time zone NOT NULL DEFAULT now(),
+	PRIMARY KEY("newsSlugId")
+);
+
+CREATE UNIQUE INDEX "UQ_newsSlugs_slug" ON "newsSlugs" USING BTREE (
+	"slug"
+);
+
+CREATE TABLE "newsRevisions" (
+	"newsRevisionId" int4 NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+	"newsId" int4 NOT NULL,
+	"userId" int4,
+	"action" varchar(16) NOT NULL,
+	"title" varchar(255) NOT NULL,
+	"slug" varchar(255) NOT NULL,
+	"shortText" varchar(1024) NOT NULL,
+	"content" text,
+	"author" varchar(255),
+	"categoryId" int4 NOT NULL,
+	"tagIds" int4[] NOT NULL,
+	"publishedAt" timestamp with time zone NOT NULL,
+	"newsStatusId" int4 NOT NULL,
+	"createdAt" timestamp with time zone NOT NULL DEFAULT now(),
+	PRIMARY KEY("newsRevisionId")
+);
+
+CREATE INDEX "IX_newsRevisions_newsId" ON "newsRevisions" USING BTREE (
+	"newsId"
+);
+
+CREATE TABLE "newsViews" (
+	"newsId" int4 NOT NULL,
+	"date" date NOT NULL,
+	"count" int4 NOT NULL DEFAULT 0,
+	PRIMARY KEY("newsId", "date")
+);
+
+CREATE INDEX "IX_newsViews_date" ON "newsViews" USING BTREE (
+	"date"
+);
+
+CREATE TABLE "suggestions" (
+	"suggestionId" int4 NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+	"title" varchar(255) NOT NULL,
+	"shortText" varchar(255) NOT NULL,
+	"text" text NOT NULL,
+	"categoryId" int4 NOT NULL,
+	"tags" varchar(64)[] NOT NULL DEFAULT '{}',
+	"ip" varchar(64) NOT NULL,
+	"contact" varchar(255),
+	"newsId" int4,
+	"createdAt" timestamp with time zone NOT NULL DEFAULT now(),
+	"moderationStatusId" int4 NOT NULL DEFAULT 1,
+	PRIMARY KEY("suggestionId")
+);
+
+CREATE INDEX "IX_suggestions_moderationStatusId" ON "suggestions" USING BTREE (
+	"moderationStatusId"
+);
+
+CREATE TABLE "suggestionDecisions" (
+	"suggestionDecisionId" int4 NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+	"suggestionId" int4 NOT NULL,
+	"userId" int4 NOT NULL,
+	"moderationStatusId" int4 NOT NULL,
+	"reason" varchar(1024),
+	"newsId" int4,
+	"createdAt" timestamp with time zone NOT NULL DEFAULT now(),
+	PRIMARY KEY("suggestionDecisionId")
+);
+
+CREATE INDEX "IX_suggestionDecisions_suggestionId" ON "suggestionDecisions" USING BTREE (
+	"suggestionId"
+);
+
+CREATE TABLE "webhooks" (
+	"webhookId" int4 NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+	"title" varchar(255) NOT NULL,
+	"url" varchar(1024) NOT NULL,
+	"secret" varchar(64) NOT NULL,
+	"events" varchar(64)[] NOT NULL DEFAULT '{}',
+	"createdAt" timestamp with time zone NOT NULL DEFAULT now(),
+	"statusId" int4 NOT NULL,
+	PRIMARY KEY("webhookId")
+);
+
+CREATE TABLE "webhookDeliveries" (
+	"webhookDeliveryId" int4 NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+	"webhookId" int4 NOT NULL,
+	"event" varchar(64) NOT NULL,
+	"key" varchar(255),
+	"payload" text NOT NULL,
+	"attempts" int4 NOT NULL DEFAULT 0,
+	"nextAttemptAt" timestamp with time zone NOT NULL DEFAULT now(),
+	"responseCode" int4,
+	"error" varchar(1024),
+	"deliveredAt" timestamp with time zone,
+	"createdAt" timestamp with time zone NOT NULL DEFAULT now(),
+	"deliveryStatusId" int4 NOT NULL DEFAULT 1,
+	PRIMARY KEY("webhookDeliveryId")
+);
+
+CREATE UNIQUE INDEX "UQ_webhookDeliveries_webhookId_key" ON "webhookDeliveries" USING BTREE (
+	"webhookId",
+	"key"
+);
+
+CREATE INDEX "IX_webhookDeliveries_nextAttemptAt" ON "webhookDeliveries" USING BTREE (
+	"nextAttemptAt"
+) WHERE "deliveryStatusId" = 1;
+
+CREATE TABLE "webhookCursors" (
+	"webhookCursorId" varchar(64) NOT NULL,
+	"value" timestamp with time zone NOT NULL,
+	PRIMARY KEY("webhookCursorId")
+);
+
+CREATE TABLE "auditLog" (
+	"auditLogId" int4 NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+	"userId" int4,
+	"ip" varchar(64) NOT NULL,
+	"namespace" varchar(64) NOT NULL,
+	"method" varchar(64) NOT NULL,
+	"entityId" int4,
+	"params" text,
+	"isSuccess" bool NOT NULL,
+	"errorCode" int4,
+	"duration" int4 NOT NULL,
+	"createdAt" timestamp with time zone NOT NULL DEFAULT now(),
+	PRIMARY KEY("auditLogId")
+);
+
+CREATE INDEX "IX_auditLog_createdAt" ON "auditLog" USING BTREE (
+	"createdAt"
+);
+
+CREATE INDEX "IX_auditLog_namespace_entityId" ON "auditLog" USING BTREE (
+	"namespace",
+	"entityId"
+);
+
+CREATE TABLE "trashItems" (
+	"trashItemId" int4 NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+	"objectType" varchar(32) NOT NULL,
+	"objectId" int4 NOT NULL,
+	"prevStatusId" int4 NOT NULL,
+	"deletedAt" timestamp with time zone NOT NULL DEFAULT now(),
+	PRIMARY KEY("trashItemId"),
+	CONSTRAINT "trashItems_objectType_objectId_key" UNIQUE("objectType", "objectId")
+);
+
+CREATE INDEX "IX_trashItems_deletedAt" ON "trashItems" USING BTREE (
+	"deletedAt"
+);
+
+-- trashItems are tracked by trigger on statusId change to and from deleted (3), trigger argument is a primary key column.
+CREATE FUNCTION "trackTrashItem"() RETURNS trigger AS $$
+DECLARE
+	"id" int4 := (to_jsonb(NEW) ->> TG_ARGV[0])::int4;
+BEGIN
+	IF NEW."statusId" = 3 AND OLD."statusId" <> 3 THEN
+		INSERT INTO "trashItems" ("objectType", "objectId", "prevStatusId") VALUES (TG_TABLE_NAME, "id", OLD."statusId")
+		ON CONFLICT ("objectType", "objectId") DO UPDATE SET "prevStatusId" = EXCLUDED."prevStatusId", "deletedAt" = now();
+	ELSIF OLD."statusId" = 3 AND NEW."statusId" <> 3 THEN
+		DELETE FROM "trashItems" WHERE "objectType" = TG_TABLE_NAME AND "objectId" = "id";
+	END IF;
+	RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "TR_news_trashItems" AFTER UPDATE OF "statusId" ON "news" FOR EACH ROW EXECUTE FUNCTION "trackTrashItem"('newsId');
+CREATE TRIGGER "TR_categories_trashItems" AFTER UPDATE OF "statusId" ON "categories" FOR EACH ROW EXECUTE FUNCTION "trackTrashItem"('categoryId');
+CREATE TRIGGER "TR_tags_trashItems" AFTER UPDATE OF "statusId" ON "tags" FOR EACH ROW EXECUTE FUNCTION "trackTrashItem"('tagId');
+CREATE TRIGGER "TR_users_trashItems" AFTER UPDATE OF "statusId" ON "users" FOR EACH ROW EXECUTE FUNCTION "trackTrashItem"('userId');
+CREATE TRIGGER "TR_vfsFiles_trashItems" AFTER UPDATE OF "statusId" ON "vfsFiles" FOR EACH ROW EXECUTE FUNCTION "trackTrashItem"('fileId');
+
 
 ALTER TABLE "users" ADD CONSTRAINT "FK_users_statusId" FOREIGN KEY ("statusId")
 	REFERENCES "statuses"("statusId")
@@ -157,6 +447,41 @@ ALTER TABLE "vfsFolders" ADD CONSTRAINT "vfsFolders_statusId_fkey" FOREIGN KEY (
 	ON UPDATE RESTRICT
 	NOT DEFERRABLE;
 
+ALTER TABLE "roles" ADD CONSTRAINT "Ref_roles_to_statuses" FOREIGN KEY ("statusId")
+	REFERENCES "statuses"("statusId")
+	MATCH SIMPLE
+	ON DELETE RESTRICT
+	ON UPDATE RESTRICT
+	NOT DEFERRABLE;
+
+ALTER TABLE "sessions" ADD CONSTRAINT "Ref_sessions_to_users" FOREIGN KEY ("userId")
+	REFERENCES "users"("userId")
+	MATCH SIMPLE
+	ON DELETE CASCADE
+	ON UPDATE RESTRICT
+	NOT DEFERRABLE;
+
+ALTER TABLE "loginAttempts" ADD CONSTRAINT "Ref_loginAttempts_to_users" FOREIGN KEY ("userId")
+	REFERENCES "users"("userId")
+	MATCH SIMPLE
+	ON DELETE SET NULL
+	ON UPDATE RESTRICT
+	NOT DEFERRABLE;
+
+ALTER TABLE "apiKeys" ADD CONSTRAINT "Ref_apiKeys_to_users" FOREIGN KEY ("userId")
+	REFERENCES "users"("userId")
+	MATCH SIMPLE
+	ON DELETE CASCADE
+	ON UPDATE RESTRICT
+	NOT DEFERRABLE;
+
+ALTER TABLE "apiKeys" ADD CONSTRAINT "Ref_apiKeys_to_statuses" FOREIGN KEY ("statusId")
+	REFERENCES "statuses"("statusId")
+	MATCH SIMPLE
+	ON DELETE RESTRICT
+	ON UPDATE RESTRICT
+	NOT DEFERRABLE;
+
 ALTER TABLE "categories" ADD CONSTRAINT "Ref_categories_to_statuses" FOREIGN KEY ("statusId")
 	REFERENCES "statuses"("statusId")
 	MATCH SIMPLE
@@ -185,4 +510,86 @@ ALTER TABLE "news" ADD CONSTRAINT "Ref_news_to_statuses" FOREIGN KEY ("statusId"
 	ON UPDATE RESTRICT
 	NOT DEFERRABLE;
 
+ALTER TABLE "newsSlugs" ADD CONSTRAINT "Ref_newsSlugs_to_news" FOREIGN KEY ("newsId")
+	REFERENCES "news"("newsId")
+	MATCH SIMPLE
+	ON DELETE CASCADE
+	ON UPDATE RESTRICT
+	NOT DEFERRABLE;
+
+ALTER TABLE "newsRevisions" ADD CONSTRAINT "Ref_newsRevisions_to_news" FOREIGN KEY ("newsId")
+	REFERENCES "news"("newsId")
+	MATCH SIMPLE
+	ON DELETE CASCADE
+	ON UPDATE RESTRICT
+	NOT DEFERRABLE;
 
+ALTER TABLE "newsRevisions" ADD CONSTRAINT "Ref_newsRevisions_to_users" FOREIGN KEY ("userId")
+	REFERENCES "users"("userId")
+	MATCH SIMPLE
+	ON DELETE SET NULL
+	ON UPDATE RESTRICT
+	NOT DEFERRABLE;
+
+ALTER TABLE "newsViews" ADD CONSTRAINT "Ref_newsViews_to_news" FOREIGN KEY ("newsId")
+	REFERENCES "news"("newsId")
+	MATCH SIMPLE
+	ON DELETE CASCADE
+	ON UPDATE RESTRICT
+	NOT DEFERRABLE;
+
+ALTER TABLE "suggestions" ADD CONSTRAINT "Ref_suggestions_to_categories" FOREIGN KEY ("categoryId")
+	REFERENCES "categories"("categoryId")
+	MATCH SIMPLE
+	ON DELETE RESTRICT
+	ON UPDATE RESTRICT
+	NOT DEFERRABLE;
+
+ALTER TABLE "suggestions" ADD CONSTRAINT "Ref_suggestions_to_news" FOREIGN KEY ("newsId")
+	REFERENCES "news"("newsId")
+	MATCH SIMPLE
+	ON DELETE SET NULL
+	ON UPDATE RESTRICT
+	NOT DEFERRABLE;
+
+ALTER TABLE "suggestionDecisions" ADD CONSTRAINT "Ref_suggestionDecisions_to_suggestions" FOREIGN KEY ("suggestionId")
+	REFERENCES "suggestions"("suggestionId")
+	MATCH SIMPLE
+	ON DELETE CASCADE
+	ON UPDATE RESTRICT
+	NOT DEFERRABLE;
+
+ALTER TABLE "suggestionDecisions" ADD CONSTRAINT "Ref_suggestionDecisions_to_users" FOREIGN KEY ("userId")
+	REFERENCES "users"("userId")
+	MATCH SIMPLE
+	ON DELETE RESTRICT
+	ON UPDATE RESTRICT
+	NOT DEFERRABLE;
+
+ALTER TABLE "suggestionDecisions" ADD CONSTRAINT "Ref_suggestionDecisions_to_news" FOREIGN KEY ("newsId")
+	REFERENCES "news"("newsId")
+	MATCH SIMPLE
+	ON DELETE SET NULL
+	ON UPDATE RESTRICT
+	NOT DEFERRABLE;
+
+ALTER TABLE "webhooks" ADD CONSTRAINT "Ref_webhooks_to_statuses" FOREIGN KEY ("statusId")
+	REFERENCES "statuses"("statusId")
+	MATCH SIMPLE
+	ON DELETE RESTRICT
+	ON UPDATE RESTRICT
+	NOT DEFERRABLE;
+
+ALTER TABLE "webhookDeliveries" ADD CONSTRAINT "Ref_webhookDeliveries_to_webhooks" FOREIGN KEY ("webhookId")
+	REFERENCES "webhooks"("webhookId")
+	MATCH SIMPLE
+	ON DELETE CASCADE
+	ON UPDATE RESTRICT
+	NOT DEFERRABLE;
+
+ALTER TABLE "auditLog" ADD CONSTRAINT "Ref_auditLog_to_users" FOREIGN KEY ("userId")
+	REFERENCES "users"("userId")
+	MATCH SIMPLE
+	ON DELETE SET NULL
+	ON UPDATE RESTRICT
+	NOT DEFERRABLE;
@@ -9,6 +9,7 @@ require (
 	github.com/go-pg/pg/v10 v10.14.0
 	github.com/go-pg/urlstruct v1.0.1
 	github.com/go-playground/validator/v10 v10.27.0
+	github.com/hashicorp/golang-lru v1.0.2
 	github.com/hypnoglow/go-pg-monitor v1.2.0
 	github.com/hypnoglow/go-pg-monitor/gopgv10 v1.2.0
 	github.com/labstack/echo/v4 v4.13.4
@@ -40,7 +41,6 @@ require (
 	github.com/go-playground/universal-translator v0.18.1 // indirect
 	github.com/gopherjs/gopherjs v1.17.2 // indirect
 	github.com/gorilla/websocket v1.5.3 // indirect
-	github.com/hashicorp/golang-lru v1.0.2 // indirect
 	github.com/iancoleman/orderedmap v0.3.0 // indirect
 	github.com/inconshreveable/mousetrap v1.1.0 // indirect
 	github.com/jinzhu/inflection v1.0.0 // indirect
@@ -2,12 +2,17 @@ package app
 
 import (
 	"context"
+	"fmt"
 	"net"
+	"strings"
 	"time"
 
 	"apisrv/pkg/db"
+	"apisrv/pkg/feed"
 	"apisrv/pkg/newsportal"
+	"apisrv/pkg/sitemap"
 	"apisrv/pkg/vt"
+	"apisrv/pkg/webhook"
 
 	"github.com/go-pg/pg/v10"
 	monitor "github.com/hypnoglow/go-pg-monitor"
@@ -26,12 +31,29 @@ type Config struct {
 		Port      int
 		IsDevel   bool
 		EnableVFS bool
+		// BaseURL is a public url of the api server, which serves api, feeds and sitemaps, default is http://localhost:{Port}.
+		BaseURL string
+		// SiteURL is a public url of the news site, which serves news, category and tag pages linked from feeds and sitemaps,
+		// default is BaseURL.
+		SiteURL string
+		// TrustedProxies is a list of proxy CIDRs, which X-Real-IP header is trusted from. Loopback, link-local
+		// and private networks are always trusted. Client IP is used by view counter and anti-spam, so public networks
+		// should be added only for proxies, which overwrite X-Real-IP.
+		TrustedProxies []string
 	}
 	Sentry struct {
 		Environment string
 		DSN         string
 	}
-	VFS vfs.Config
+	VFS      vfs.Config
+	Feed     feed.Config
+	Sitemap  sitemap.Config
+	Cache    newsportal.CacheConfig
+	Views    newsportal.ViewCounterConfig
+	AntiSpam newsportal.AntiSpamConfig
+	Webhooks webhook.Config
+	Login    vt.LoginConfig
+	Trash    vt.TrashConfig
 }
 
 type App struct {
@@ -44,7 +66,13 @@ type App struct {
 	echo    *echo.Echo
 	vtsrv   zenrpc.Server
 
-	newsService *newsportal.Service
+	newsService  *newsportal.Service
+	newsCache    *newsportal.Cache
+	viewCounter  *newsportal.ViewCounter
+	spamGuard    *newsportal.SpamGuard
+	dispatcher   *webhook.Dispatcher
+	loginGuard   *vt.LoginGuard
+	trashCleaner *vt.TrashCleaner
 }
 
 func New(appName string, sl embedlog.Logger, cfg Config, dbo db.DB, dbc *pg.DB) *App {
@@ -60,16 +88,46 @@ func New(appName string, sl embedlog.Logger, cfg Config, dbo db.DB, dbc *pg.DB)
 	// setup echo
 	a.echo.HideBanner = true
 	a.echo.HidePort = true
-	_, mask, _ := net.ParseCIDR("0.0.0.0/0")
-	a.echo.IPExtractor = echo.ExtractIPFromRealIPHeader(echo.TrustIPRange(mask))
+	a.echo.IPExtractor = echo.ExtractIPFromRealIPHeader(a.trustedProxies()...)
 
 	// add services
 	a.newsService = newsportal.NewNewsService(dbo)
-	a.vtsrv = vt.New(a.db, a.Logger, a.cfg.Server.IsDevel)
+	a.viewCounter = newsportal.NewViewCounter(dbo, a.Logger, cfg.Views)
+	a.newsService = a.newsService.WithViewCounter(a.viewCounter)
+	if cfg.AntiSpam.Enabled {
+		a.spamGuard = newsportal.NewSpamGuard(cfg.AntiSpam)
+		a.newsService = a.newsService.WithSpamGuard(a.spamGuard)
+	}
+	a.dispatcher = webhook.NewDispatcher(dbo, a.Logger, cfg.Webhooks)
+	a.loginGuard = vt.NewLoginGuard(dbo, cfg.Login)
+	a.trashCleaner = vt.NewTrashCleaner(dbo, a.Logger, cfg.Trash)
+	a.vtsrv = vt.New(a.db, a.Logger, a.cfg.Server.IsDevel, a.loginGuard)
+
+	if cfg.Cache.Enabled {
+		a.newsCache = newsportal.NewCache(cfg.Cache)
+		a.newsService = a.newsService.WithCache(a.newsCache)
+		a.vtsrv.Use(vt.DataChangedMiddleware(a.newsCache.Invalidate))
+	}
 
 	return a
 }
 
+// trustedProxies returns trust options of X-Real-IP header for configured proxy CIDRs, invalid CIDRs are skipped.
+func (a *App) trustedProxies() []echo.TrustOption {
+	var opts []echo.TrustOption
+	for _, cidr := range a.cfg.Server.TrustedProxies {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			a.Error(context.Background(), "skip invalid trusted proxy", "cidr", cidr, "err", err)
+			continue
+		}
+
+		opts = append(opts, echo.TrustIPRange(ipNet))
+	}
+
+	return opts
+}
+
 // Run is a function that runs application.
 func (a *App) Run(ctx context.Context) error {
 	a.registerMetrics()
@@ -79,9 +137,31 @@ func (a *App) Run(ctx context.Context) error {
 	a.registerVTApiHandlers()
 	a.registerMetadata()
 
+	go a.viewCounter.Run(ctx)
+	go a.dispatcher.Run(ctx)
+	go a.trashCleaner.Run(ctx)
+
 	return a.runHTTPServer(ctx, a.cfg.Server.Host, a.cfg.Server.Port)
 }
 
+// baseURL returns public url of the api server.
+func (a *App) baseURL() string {
+	if a.cfg.Server.BaseURL != "" {
+		return strings.TrimRight(a.cfg.Server.BaseURL, "/")
+	}
+
+	return fmt.Sprintf("http://localhost:%d", a.cfg.Server.Port)
+}
+
+// siteURL returns public url of the news site.
+func (a *App) siteURL() string {
+	if a.cfg.Server.SiteURL != "" {
+		return strings.TrimRight(a.cfg.Server.SiteURL, "/")
+	}
+
+	return a.baseURL()
+}
+
 // VTTypeScriptClient returns TypeScript client for VT.
 func (a *App) VTTypeScriptClient() ([]byte, error) {
 	gen := rpcgen.FromSMD(a.vtsrv.SMD())
@@ -98,4 +178,8 @@ func (a *App) Shutdown(timeout time.Duration) {
 	if err := a.echo.Shutdown(ctx); err != nil {
 		a.Error(ctx, "shutting down server", "err", err)
 	}
+
+	if err := a.viewCounter.Flush(ctx); err != nil {
+		a.Error(ctx, "flushing news views", "err", err)
+	}
 }
@@ -8,7 +8,9 @@ import (
 	"sort"
 	"strings"
 
+	"apisrv/pkg/feed"
 	"apisrv/pkg/rpc"
+	"apisrv/pkg/sitemap"
 
 	sentryecho "github.com/getsentry/sentry-go/echo"
 	"github.com/labstack/echo/v4"
@@ -82,8 +84,14 @@ func (a *App) registerAPIHandlers() {
 
 	a.echo.Any("/v1/rpc/", zm.EchoHandler(zm.XRequestID(srv)))
 	a.echo.Any("/v1/rpc/doc/", echo.WrapHandler(http.HandlerFunc(zenrpc.SMDBoxHandler)))
-	a.echo.Any("/v1/rpc/openrpc.json", echo.WrapHandler(http.HandlerFunc(rpcgen.Handler(gen.OpenRPC("apisrv", "http://localhost:8075/v1/rpc")))))
+	a.echo.Any("/v1/rpc/openrpc.json", echo.WrapHandler(http.HandlerFunc(rpcgen.Handler(gen.OpenRPC("apisrv", a.baseURL()+"/v1/rpc")))))
 	a.echo.Any("/v1/rpc/api.ts", echo.WrapHandler(http.HandlerFunc(rpcgen.Handler(gen.TSClient(nil)))))
+
+	// rss, atom & json feeds
+	feed.NewHandler(a.newsService, a.cfg.Feed, a.baseURL(), a.siteURL()).Register(a.echo.Group("/feed"))
+
+	// sitemap index, child sitemaps & google news sitemap
+	sitemap.NewHandler(a.db, a.cfg.Sitemap, a.baseURL(), a.siteURL()).Register(a.echo)
 }
 
 // registerVTApiHandlers registers vt rpc server.
@@ -5,6 +5,10 @@ import (
 	"strconv"
 	"time"
 
+	"apisrv/pkg/db"
+	"apisrv/pkg/newsportal"
+	"apisrv/pkg/vt"
+
 	monitor "github.com/hypnoglow/go-pg-monitor"
 	"github.com/hypnoglow/go-pg-monitor/gopgv10"
 	"github.com/labstack/echo/v4"
@@ -24,10 +28,90 @@ func (a *App) registerMetrics() {
 	)
 	a.mon.Open()
 
+	if a.newsCache != nil {
+		registerCacheMetrics(a.appName, a.newsCache)
+	}
+
+	if a.spamGuard != nil {
+		registerSpamMetrics(a.appName, a.spamGuard)
+	}
+
+	registerLoginMetrics(a.appName, a.loginGuard)
+
 	a.echo.Use(httpMetrics(a.appName))
 	a.echo.Any("/metrics", echo.WrapHandler(promhttp.Handler()))
 }
 
+// registerCacheMetrics adds news cache hit/miss counters and size gauge.
+func registerCacheMetrics(appName string, cache *newsportal.Cache) {
+	prometheus.MustRegister(
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: appName,
+			Subsystem: "news_cache",
+			Name:      "hits_total",
+			Help:      "News cache hits count.",
+		}, func() float64 { return float64(cache.Stats().Hits) }),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: appName,
+			Subsystem: "news_cache",
+			Name:      "misses_total",
+			Help:      "News cache misses count.",
+		}, func() float64 { return float64(cache.Stats().Misses) }),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: appName,
+			Subsystem: "news_cache",
+			Name:      "entries",
+			Help:      "News cache entries count.",
+		}, func() float64 { return float64(cache.Len()) }),
+	)
+}
+
+// registerSpamMetrics adds rejected news suggestions counter by reason.
+func registerSpamMetrics(appName string, guard *newsportal.SpamGuard) {
+	reasons := map[string]func(newsportal.SpamStats) uint64{
+		"rate_limit": func(s newsportal.SpamStats) uint64 { return s.RateLimited },
+		"duplicate":  func(s newsportal.SpamStats) uint64 { return s.Duplicate },
+		"honeypot":   func(s newsportal.SpamStats) uint64 { return s.Honeypot },
+		"challenge":  func(s newsportal.SpamStats) uint64 { return s.Challenge },
+	}
+
+	for reason, stat := range reasons {
+		prometheus.MustRegister(prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace:   appName,
+			Subsystem:   "news_suggestions",
+			Name:        "rejected_total",
+			Help:        "Rejected as spam news suggestions count by reason.",
+			ConstLabels: prometheus.Labels{"reason": reason},
+		}, func() float64 { return float64(stat(guard.Stats())) }))
+	}
+}
+
+// registerLoginMetrics adds successful and failed by reason VT login attempts counters.
+func registerLoginMetrics(appName string, guard *vt.LoginGuard) {
+	prometheus.MustRegister(prometheus.NewCounterFunc(prometheus.CounterOpts{
+		Namespace: appName,
+		Subsystem: "vt_login",
+		Name:      "success_total",
+		Help:      "Successful VT login attempts count.",
+	}, func() float64 { return float64(guard.Stats().Success) }))
+
+	reasons := map[string]func(vt.LoginStats) uint64{
+		db.LoginReasonInvalid: func(s vt.LoginStats) uint64 { return s.Invalid },
+		db.LoginReasonDelayed: func(s vt.LoginStats) uint64 { return s.Delayed },
+		db.LoginReasonLocked:  func(s vt.LoginStats) uint64 { return s.Locked },
+	}
+
+	for reason, stat := range reasons {
+		prometheus.MustRegister(prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace:   appName,
+			Subsystem:   "vt_login",
+			Name:        "failures_total",
+			Help:        "Failed VT login attempts count by reason.",
+			ConstLabels: prometheus.Labels{"reason": reason},
+		}, func() float64 { return float64(stat(guard.Stats())) }))
+	}
+}
+
 // httpMetrics is the middleware function that logs duration of responses.
 func httpMetrics(appName string) echo.MiddlewareFunc {
 	labels := []string{"method", "uri", "code"}
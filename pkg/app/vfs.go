@@ -12,7 +12,11 @@ import (
 	zm "github.com/vmkteam/zenrpc-middleware"
 )
 
-const NSVFS = "vfs"
+const (
+	NSVFS = vt.NSVFS
+	// methodUpload is a method name for RBAC check of vfs uploads, it is allowed by "vfs:upload" or "vfs:write" permissions.
+	methodUpload = "upload"
+)
 
 // RegisterVFS register VFS handler and RPC service
 func (a *App) RegisterVFS(cfg vfs.Config) error {
@@ -21,10 +25,13 @@ func (a *App) RegisterVFS(cfg vfs.Config) error {
 		return err
 	}
 
-	cr := db.NewCommonRepo(a.db)
+	cr, ar := db.NewCommonRepo(a.db), db.NewAuditRepo(a.db)
 	vfsRepo := vfsdb.NewVfsRepo(a.db)
-	a.echo.Any("/v1/vfs/upload/file", zm.EchoHandler(vt.HTTPAuthMiddleware(cr, vf.UploadHandler(vfsRepo))))
-	a.echo.Any("/v1/vfs/upload/hash", echo.WrapHandler(vt.HTTPAuthMiddleware(cr, vf.HashUploadHandler(&vfsRepo))))
+	upload := func(next http.Handler) http.Handler {
+		return vt.HTTPAuthMiddleware(cr, a.loginGuard, NSVFS, methodUpload, vt.HTTPAuditMiddleware(ar, a.Logger, NSVFS, methodUpload, next))
+	}
+	a.echo.Any("/v1/vfs/upload/file", zm.EchoHandler(upload(vf.UploadHandler(vfsRepo))))
+	a.echo.Any("/v1/vfs/upload/hash", echo.WrapHandler(upload(vf.HashUploadHandler(&vfsRepo))))
 	a.echo.GET(a.cfg.VFS.WebPath, echo.WrapHandler(http.StripPrefix(a.cfg.VFS.WebPath, http.FileServer(http.Dir(a.cfg.VFS.Path)))))
 	vt.WebPath = a.cfg.VFS.WebPath
 
@@ -0,0 +1,130 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+)
+
+type AuditRepo struct {
+	db      orm.DB
+	filters map[string][]Filter
+	sort    map[string][]SortField
+	join    map[string][]string
+}
+
+// NewAuditRepo returns new repository
+func NewAuditRepo(db orm.DB) AuditRepo {
+	return AuditRepo{
+		db:      db,
+		filters: map[string][]Filter{},
+		sort: map[string][]SortField{
+			Tables.AuditLog.Name: {{Column: Columns.AuditLog.CreatedAt, Direction: SortDesc}},
+		},
+		join: map[string][]string{
+			Tables.AuditLog.Name: {TableColumns, Columns.AuditLog.User},
+		},
+	}
+}
+
+// WithTransaction is a function that wraps AuditRepo with pg.Tx transaction.
+func (ar AuditRepo) WithTransaction(tx *pg.Tx) AuditRepo {
+	ar.db = tx
+	return ar
+}
+
+// WithEnabledOnly is a function that adds "statusId"=1 as base filter.
+func (ar AuditRepo) WithEnabledOnly() AuditRepo {
+	f := make(map[string][]Filter, len(ar.filters))
+	for i := range ar.filters {
+		f[i] = make([]Filter, len(ar.filters[i]))
+		copy(f[i], ar.filters[i])
+		f[i] = append(f[i], StatusEnabledFilter)
+	}
+	ar.filters = f
+
+	return ar
+}
+
+/*** AuditLog ***/
+
+// FullAuditLog returns full joins with all columns
+func (ar AuditRepo) FullAuditLog() OpFunc {
+	return WithColumns(ar.join[Tables.AuditLog.Name]...)
+}
+
+// DefaultAuditLogSort returns default sort.
+func (ar AuditRepo) DefaultAuditLogSort() OpFunc {
+	return WithSort(ar.sort[Tables.AuditLog.Name]...)
+}
+
+// AuditLogByID is a function that returns AuditLog by ID(s) or nil.
+func (ar AuditRepo) AuditLogByID(ctx context.Context, id int, ops ...OpFunc) (*AuditLog, error) {
+	return ar.OneAuditLog(ctx, &AuditLogSearch{ID: &id}, ops...)
+}
+
+// OneAuditLog is a function that returns one AuditLog by filters. It could return pg.ErrMultiRows.
+func (ar AuditRepo) OneAuditLog(ctx context.Context, search *AuditLogSearch, ops ...OpFunc) (*AuditLog, error) {
+	obj := &AuditLog{}
+	err := buildQuery(ctx, ar.db, obj, search, ar.filters[Tables.AuditLog.Name], PagerTwo, ops...).Select()
+
+	if errors.Is(err, pg.ErrMultiRows) {
+		return nil, err
+	} else if errors.Is(err, pg.ErrNoRows) {
+		return nil, nil
+	}
+
+	return obj, err
+}
+
+// AuditLogsByFilters returns AuditLog list.
+func (ar AuditRepo) AuditLogsByFilters(ctx context.Context, search *AuditLogSearch, pager Pager, ops ...OpFunc) (auditLogs []AuditLog, err error) {
+	err = buildQuery(ctx, ar.db, &auditLogs, search, ar.filters[Tables.AuditLog.Name], pager, ops...).Select()
+	return
+}
+
+// CountAuditLogs returns count
+func (ar AuditRepo) CountAuditLogs(ctx context.Context, search *AuditLogSearch, ops ...OpFunc) (int, error) {
+	return buildQuery(ctx, ar.db, &AuditLog{}, search, ar.filters[Tables.AuditLog.Name], PagerOne, ops...).Count()
+}
+
+// AddAuditLog adds AuditLog to DB.
+func (ar AuditRepo) AddAuditLog(ctx context.Context, auditLog *AuditLog, ops ...OpFunc) (*AuditLog, error) {
+	q := ar.db.ModelContext(ctx, auditLog)
+	if len(ops) == 0 {
+		q = q.ExcludeColumn(Columns.AuditLog.CreatedAt)
+	}
+	applyOps(q, ops...)
+	_, err := q.Insert()
+
+	return auditLog, err
+}
+
+// UpdateAuditLog updates AuditLog in DB.
+func (ar AuditRepo) UpdateAuditLog(ctx context.Context, auditLog *AuditLog, ops ...OpFunc) (bool, error) {
+	q := ar.db.ModelContext(ctx, auditLog).WherePK()
+	if len(ops) == 0 {
+		q = q.ExcludeColumn(Columns.AuditLog.ID, Columns.AuditLog.UserID, Columns.AuditLog.IP, Columns.AuditLog.Namespace, Columns.AuditLog.Method, Columns.AuditLog.EntityID, Columns.AuditLog.Params, Columns.AuditLog.IsSuccess, Columns.AuditLog.ErrorCode, Columns.AuditLog.Duration, Columns.AuditLog.CreatedAt)
+	}
+	applyOps(q, ops...)
+	res, err := q.Update()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, err
+}
+
+// DeleteAuditLog deletes AuditLog from DB.
+func (ar AuditRepo) DeleteAuditLog(ctx context.Context, id int) (deleted bool, err error) {
+	auditLog := &AuditLog{ID: id}
+
+	res, err := ar.db.ModelContext(ctx, auditLog).WherePK().Delete()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, err
+}
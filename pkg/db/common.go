@@ -20,13 +20,23 @@ func NewCommonRepo(db orm.DB) CommonRepo {
 	return CommonRepo{
 		db: db,
 		filters: map[string][]Filter{
-			Tables.User.Name: {StatusFilter},
+			Tables.Role.Name:   {StatusFilter},
+			Tables.User.Name:   {StatusFilter},
+			Tables.APIKey.Name: {StatusFilter},
 		},
 		sort: map[string][]SortField{
-			Tables.User.Name: {{Column: Columns.User.CreatedAt, Direction: SortDesc}},
+			Tables.Role.Name:         {{Column: Columns.Role.Title, Direction: SortAsc}},
+			Tables.User.Name:         {{Column: Columns.User.CreatedAt, Direction: SortDesc}},
+			Tables.Session.Name:      {{Column: Columns.Session.CreatedAt, Direction: SortDesc}},
+			Tables.LoginAttempt.Name: {{Column: Columns.LoginAttempt.CreatedAt, Direction: SortDesc}},
+			Tables.APIKey.Name:       {{Column: Columns.APIKey.CreatedAt, Direction: SortDesc}},
 		},
 		join: map[string][]string{
-			Tables.User.Name: {TableColumns},
+			Tables.Role.Name:         {TableColumns},
+			Tables.User.Name:         {TableColumns},
+			Tables.Session.Name:      {TableColumns, Columns.Session.User},
+			Tables.LoginAttempt.Name: {TableColumns, Columns.LoginAttempt.User},
+			Tables.APIKey.Name:       {TableColumns, Columns.APIKey.User},
 		},
 	}
 }
@@ -50,6 +60,79 @@ func (cr CommonRepo) WithEnabledOnly() CommonRepo {
 	return cr
 }
 
+/*** Role ***/
+
+// FullRole returns full joins with all columns
+func (cr CommonRepo) FullRole() OpFunc {
+	return WithColumns(cr.join[Tables.Role.Name]...)
+}
+
+// DefaultRoleSort returns default sort.
+func (cr CommonRepo) DefaultRoleSort() OpFunc {
+	return WithSort(cr.sort[Tables.Role.Name]...)
+}
+
+// RoleByID is a function that returns Role by ID(s) or nil.
+func (cr CommonRepo) RoleByID(ctx context.Context, id int, ops ...OpFunc) (*Role, error) {
+	return cr.OneRole(ctx, &RoleSearch{ID: &id}, ops...)
+}
+
+// OneRole is a function that returns one Role by filters. It could return pg.ErrMultiRows.
+func (cr CommonRepo) OneRole(ctx context.Context, search *RoleSearch, ops ...OpFunc) (*Role, error) {
+	obj := &Role{}
+	err := buildQuery(ctx, cr.db, obj, search, cr.filters[Tables.Role.Name], PagerTwo, ops...).Select()
+
+	if errors.Is(err, pg.ErrMultiRows) {
+		return nil, err
+	} else if errors.Is(err, pg.ErrNoRows) {
+		return nil, nil
+	}
+
+	return obj, err
+}
+
+// RolesByFilters returns Role list.
+func (cr CommonRepo) RolesByFilters(ctx context.Context, search *RoleSearch, pager Pager, ops ...OpFunc) (roles []Role, err error) {
+	err = buildQuery(ctx, cr.db, &roles, search, cr.filters[Tables.Role.Name], pager, ops...).Select()
+	return
+}
+
+// CountRoles returns count
+func (cr CommonRepo) CountRoles(ctx context.Context, search *RoleSearch, ops ...OpFunc) (int, error) {
+	return buildQuery(ctx, cr.db, &Role{}, search, cr.filters[Tables.Role.Name], PagerOne, ops...).Count()
+}
+
+// AddRole adds Role to DB.
+func (cr CommonRepo) AddRole(ctx context.Context, role *Role, ops ...OpFunc) (*Role, error) {
+	q := cr.db.ModelContext(ctx, role)
+	applyOps(q, ops...)
+	_, err := q.Insert()
+
+	return role, err
+}
+
+// UpdateRole updates Role in DB.
+func (cr CommonRepo) UpdateRole(ctx context.Context, role *Role, ops ...OpFunc) (bool, error) {
+	q := cr.db.ModelContext(ctx, role).WherePK()
+	if len(ops) == 0 {
+		q = q.ExcludeColumn(Columns.Role.ID)
+	}
+	applyOps(q, ops...)
+	res, err := q.Update()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, err
+}
+
+// DeleteRole set statusId to deleted in DB.
+func (cr CommonRepo) DeleteRole(ctx context.Context, id int) (deleted bool, err error) {
+	role := &Role{ID: id, StatusID: StatusDeleted}
+
+	return cr.UpdateRole(ctx, role, WithColumns(Columns.Role.StatusID))
+}
+
 /*** User ***/
 
 // FullUser returns full joins with all columns
@@ -125,3 +208,241 @@ func (cr CommonRepo) DeleteUser(ctx context.Context, id int) (deleted bool, err
 
 	return cr.UpdateUser(ctx, user, WithColumns(Columns.User.StatusID))
 }
+
+/*** Session ***/
+
+// FullSession returns full joins with all columns
+func (cr CommonRepo) FullSession() OpFunc {
+	return WithColumns(cr.join[Tables.Session.Name]...)
+}
+
+// DefaultSessionSort returns default sort.
+func (cr CommonRepo) DefaultSessionSort() OpFunc {
+	return WithSort(cr.sort[Tables.Session.Name]...)
+}
+
+// SessionByID is a function that returns Session by ID(s) or nil.
+func (cr CommonRepo) SessionByID(ctx context.Context, id int, ops ...OpFunc) (*Session, error) {
+	return cr.OneSession(ctx, &SessionSearch{ID: &id}, ops...)
+}
+
+// OneSession is a function that returns one Session by filters. It could return pg.ErrMultiRows.
+func (cr CommonRepo) OneSession(ctx context.Context, search *SessionSearch, ops ...OpFunc) (*Session, error) {
+	obj := &Session{}
+	err := buildQuery(ctx, cr.db, obj, search, cr.filters[Tables.Session.Name], PagerTwo, ops...).Select()
+
+	if errors.Is(err, pg.ErrMultiRows) {
+		return nil, err
+	} else if errors.Is(err, pg.ErrNoRows) {
+		return nil, nil
+	}
+
+	return obj, err
+}
+
+// SessionsByFilters returns Session list.
+func (cr CommonRepo) SessionsByFilters(ctx context.Context, search *SessionSearch, pager Pager, ops ...OpFunc) (sessions []Session, err error) {
+	err = buildQuery(ctx, cr.db, &sessions, search, cr.filters[Tables.Session.Name], pager, ops...).Select()
+	return
+}
+
+// CountSessions returns count
+func (cr CommonRepo) CountSessions(ctx context.Context, search *SessionSearch, ops ...OpFunc) (int, error) {
+	return buildQuery(ctx, cr.db, &Session{}, search, cr.filters[Tables.Session.Name], PagerOne, ops...).Count()
+}
+
+// AddSession adds Session to DB.
+func (cr CommonRepo) AddSession(ctx context.Context, session *Session, ops ...OpFunc) (*Session, error) {
+	q := cr.db.ModelContext(ctx, session)
+	if len(ops) == 0 {
+		q = q.ExcludeColumn(Columns.Session.CreatedAt)
+	}
+	applyOps(q, ops...)
+	_, err := q.Insert()
+
+	return session, err
+}
+
+// UpdateSession updates Session in DB.
+func (cr CommonRepo) UpdateSession(ctx context.Context, session *Session, ops ...OpFunc) (bool, error) {
+	q := cr.db.ModelContext(ctx, session).WherePK()
+	if len(ops) == 0 {
+		q = q.ExcludeColumn(Columns.Session.ID, Columns.Session.UserID, Columns.Session.TokenHash, Columns.Session.IP, Columns.Session.UserAgent, Columns.Session.Device, Columns.Session.IdleTimeout, Columns.Session.CreatedAt, Columns.Session.ExpiresAt)
+	}
+	applyOps(q, ops...)
+	res, err := q.Update()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, err
+}
+
+// DeleteSession deletes Session from DB.
+func (cr CommonRepo) DeleteSession(ctx context.Context, id int) (deleted bool, err error) {
+	session := &Session{ID: id}
+
+	res, err := cr.db.ModelContext(ctx, session).WherePK().Delete()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, err
+}
+
+/*** LoginAttempt ***/
+
+// FullLoginAttempt returns full joins with all columns
+func (cr CommonRepo) FullLoginAttempt() OpFunc {
+	return WithColumns(cr.join[Tables.LoginAttempt.Name]...)
+}
+
+// DefaultLoginAttemptSort returns default sort.
+func (cr CommonRepo) DefaultLoginAttemptSort() OpFunc {
+	return WithSort(cr.sort[Tables.LoginAttempt.Name]...)
+}
+
+// LoginAttemptByID is a function that returns LoginAttempt by ID(s) or nil.
+func (cr CommonRepo) LoginAttemptByID(ctx context.Context, id int, ops ...OpFunc) (*LoginAttempt, error) {
+	return cr.OneLoginAttempt(ctx, &LoginAttemptSearch{ID: &id}, ops...)
+}
+
+// OneLoginAttempt is a function that returns one LoginAttempt by filters. It could return pg.ErrMultiRows.
+func (cr CommonRepo) OneLoginAttempt(ctx context.Context, search *LoginAttemptSearch, ops ...OpFunc) (*LoginAttempt, error) {
+	obj := &LoginAttempt{}
+	err := buildQuery(ctx, cr.db, obj, search, cr.filters[Tables.LoginAttempt.Name], PagerTwo, ops...).Select()
+
+	if errors.Is(err, pg.ErrMultiRows) {
+		return nil, err
+	} else if errors.Is(err, pg.ErrNoRows) {
+		return nil, nil
+	}
+
+	return obj, err
+}
+
+// LoginAttemptsByFilters returns LoginAttempt list.
+func (cr CommonRepo) LoginAttemptsByFilters(ctx context.Context, search *LoginAttemptSearch, pager Pager, ops ...OpFunc) (loginAttempts []LoginAttempt, err error) {
+	err = buildQuery(ctx, cr.db, &loginAttempts, search, cr.filters[Tables.LoginAttempt.Name], pager, ops...).Select()
+	return
+}
+
+// CountLoginAttempts returns count
+func (cr CommonRepo) CountLoginAttempts(ctx context.Context, search *LoginAttemptSearch, ops ...OpFunc) (int, error) {
+	return buildQuery(ctx, cr.db, &LoginAttempt{}, search, cr.filters[Tables.LoginAttempt.Name], PagerOne, ops...).Count()
+}
+
+// AddLoginAttempt adds LoginAttempt to DB.
+func (cr CommonRepo) AddLoginAttempt(ctx context.Context, loginAttempt *LoginAttempt, ops ...OpFunc) (*LoginAttempt, error) {
+	q := cr.db.ModelContext(ctx, loginAttempt)
+	if len(ops) == 0 {
+		q = q.ExcludeColumn(Columns.LoginAttempt.CreatedAt)
+	}
+	applyOps(q, ops...)
+	_, err := q.Insert()
+
+	return loginAttempt, err
+}
+
+// UpdateLoginAttempt updates LoginAttempt in DB.
+func (cr CommonRepo) UpdateLoginAttempt(ctx context.Context, loginAttempt *LoginAttempt, ops ...OpFunc) (bool, error) {
+	q := cr.db.ModelContext(ctx, loginAttempt).WherePK()
+	if len(ops) == 0 {
+		q = q.ExcludeColumn(Columns.LoginAttempt.ID, Columns.LoginAttempt.Login, Columns.LoginAttempt.UserID, Columns.LoginAttempt.IP, Columns.LoginAttempt.UserAgent, Columns.LoginAttempt.IsSuccess, Columns.LoginAttempt.Reason, Columns.LoginAttempt.CreatedAt)
+	}
+	applyOps(q, ops...)
+	res, err := q.Update()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, err
+}
+
+// DeleteLoginAttempt deletes LoginAttempt from DB.
+func (cr CommonRepo) DeleteLoginAttempt(ctx context.Context, id int) (deleted bool, err error) {
+	loginAttempt := &LoginAttempt{ID: id}
+
+	res, err := cr.db.ModelContext(ctx, loginAttempt).WherePK().Delete()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, err
+}
+
+/*** APIKey ***/
+
+// FullAPIKey returns full joins with all columns
+func (cr CommonRepo) FullAPIKey() OpFunc {
+	return WithColumns(cr.join[Tables.APIKey.Name]...)
+}
+
+// DefaultAPIKeySort returns default sort.
+func (cr CommonRepo) DefaultAPIKeySort() OpFunc {
+	return WithSort(cr.sort[Tables.APIKey.Name]...)
+}
+
+// APIKeyByID is a function that returns APIKey by ID(s) or nil.
+func (cr CommonRepo) APIKeyByID(ctx context.Context, id int, ops ...OpFunc) (*APIKey, error) {
+	return cr.OneAPIKey(ctx, &APIKeySearch{ID: &id}, ops...)
+}
+
+// OneAPIKey is a function that returns one APIKey by filters. It could return pg.ErrMultiRows.
+func (cr CommonRepo) OneAPIKey(ctx context.Context, search *APIKeySearch, ops ...OpFunc) (*APIKey, error) {
+	obj := &APIKey{}
+	err := buildQuery(ctx, cr.db, obj, search, cr.filters[Tables.APIKey.Name], PagerTwo, ops...).Select()
+
+	if errors.Is(err, pg.ErrMultiRows) {
+		return nil, err
+	} else if errors.Is(err, pg.ErrNoRows) {
+		return nil, nil
+	}
+
+	return obj, err
+}
+
+// APIKeysByFilters returns APIKey list.
+func (cr CommonRepo) APIKeysByFilters(ctx context.Context, search *APIKeySearch, pager Pager, ops ...OpFunc) (aPIKeys []APIKey, err error) {
+	err = buildQuery(ctx, cr.db, &aPIKeys, search, cr.filters[Tables.APIKey.Name], pager, ops...).Select()
+	return
+}
+
+// CountAPIKeys returns count
+func (cr CommonRepo) CountAPIKeys(ctx context.Context, search *APIKeySearch, ops ...OpFunc) (int, error) {
+	return buildQuery(ctx, cr.db, &APIKey{}, search, cr.filters[Tables.APIKey.Name], PagerOne, ops...).Count()
+}
+
+// AddAPIKey adds APIKey to DB.
+func (cr CommonRepo) AddAPIKey(ctx context.Context, aPIKey *APIKey, ops ...OpFunc) (*APIKey, error) {
+	q := cr.db.ModelContext(ctx, aPIKey)
+	if len(ops) == 0 {
+		q = q.ExcludeColumn(Columns.APIKey.CreatedAt)
+	}
+	applyOps(q, ops...)
+	_, err := q.Insert()
+
+	return aPIKey, err
+}
+
+// UpdateAPIKey updates APIKey in DB.
+func (cr CommonRepo) UpdateAPIKey(ctx context.Context, aPIKey *APIKey, ops ...OpFunc) (bool, error) {
+	q := cr.db.ModelContext(ctx, aPIKey).WherePK()
+	if len(ops) == 0 {
+		q = q.ExcludeColumn(Columns.APIKey.ID, Columns.APIKey.UserID, Columns.APIKey.Prefix, Columns.APIKey.TokenHash, Columns.APIKey.CreatedAt)
+	}
+	applyOps(q, ops...)
+	res, err := q.Update()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, err
+}
+
+// DeleteAPIKey set statusId to deleted in DB.
+func (cr CommonRepo) DeleteAPIKey(ctx context.Context, id int) (deleted bool, err error) {
+	aPIKey := &APIKey{ID: id, StatusID: StatusDeleted}
+
+	return cr.UpdateAPIKey(ctx, aPIKey, WithColumns(Columns.APIKey.StatusID))
+}
@@ -3,15 +3,16 @@ package db
 import (
 	"context"
 	"time"
+
+	"github.com/go-pg/pg/v10"
 )
 
-// AuthenticateUser update authKey and last activity while user login/logout
-func (cr CommonRepo) AuthenticateUser(ctx context.Context, dbu *User, authKey string) (bool, error) {
-	dbu.AuthKey = authKey
-	now := time.Now()
-	dbu.LastActivityAt = &now
-	return cr.UpdateUser(ctx, dbu, WithColumns(Columns.User.AuthKey, Columns.User.LastActivityAt))
-}
+const (
+	// login attempt failure reasons
+	LoginReasonInvalid = "invalid"
+	LoginReasonDelayed = "delayed"
+	LoginReasonLocked  = "locked"
+)
 
 func (cr CommonRepo) UpdateUserActivity(ctx context.Context, dbu *User) (bool, error) {
 	now := time.Now()
@@ -19,16 +20,129 @@ func (cr CommonRepo) UpdateUserActivity(ctx context.Context, dbu *User) (bool, e
 	return cr.UpdateUser(ctx, dbu, WithColumns(Columns.User.LastActivityAt))
 }
 
-func (cr CommonRepo) EnabledUserByAuthKey(ctx context.Context, authKey string) (*User, error) {
-	s := StatusEnabled
-	return cr.OneUser(ctx, &UserSearch{AuthKey: &authKey, StatusID: &s})
-}
-
 func (cr CommonRepo) EnabledUserByLogin(ctx context.Context, login string) (*User, error) {
 	s := StatusEnabled
 	return cr.OneUser(ctx, &UserSearch{Login: &login, StatusID: &s})
 }
 
 func (cr CommonRepo) UpdateUserPassword(ctx context.Context, dbu *User) (bool, error) {
-	return cr.UpdateUser(ctx, dbu, WithColumns(Columns.User.Password, Columns.User.AuthKey))
+	return cr.UpdateUser(ctx, dbu, WithColumns(Columns.User.Password))
+}
+
+// UpdateUserTOTP updates user two-factor authentication secret, state, last used code counter and recovery codes.
+func (cr CommonRepo) UpdateUserTOTP(ctx context.Context, dbu *User) (bool, error) {
+	if dbu.RecoveryCodes == nil {
+		dbu.RecoveryCodes = []string{}
+	}
+
+	return cr.UpdateUser(ctx, dbu, WithColumns(Columns.User.TOTPSecret, Columns.User.IsTOTPEnabled, Columns.User.TOTPCounter, Columns.User.RecoveryCodes))
+}
+
+// IsExpired checks that session absolute or idle expiration time has come.
+func (s Session) IsExpired(now time.Time) bool {
+	return !now.Before(s.ExpiresAt) || !now.Before(s.LastActivityAt.Add(time.Duration(s.IdleTimeout)*time.Second))
+}
+
+// SessionByTokenHash returns Session with its User by token hash or nil.
+func (cr CommonRepo) SessionByTokenHash(ctx context.Context, tokenHash string) (*Session, error) {
+	return cr.OneSession(ctx, &SessionSearch{TokenHash: &tokenHash}, cr.FullSession())
+}
+
+// UpdateSessionActivity updates session and its user last activity.
+func (cr CommonRepo) UpdateSessionActivity(ctx context.Context, session *Session, dbu *User) error {
+	now := time.Now()
+	session.LastActivityAt = now
+	if _, err := cr.UpdateSession(ctx, session, WithColumns(Columns.Session.LastActivityAt)); err != nil {
+		return err
+	}
+
+	_, err := cr.UpdateUserActivity(ctx, dbu)
+	return err
+}
+
+// DeleteUserSessions deletes all sessions of the user except session with exceptID, if it is not zero.
+// It returns the number of deleted sessions.
+func (cr CommonRepo) DeleteUserSessions(ctx context.Context, userID, exceptID int) (int, error) {
+	q := cr.db.ModelContext(ctx, (*Session)(nil)).Where(`? = ?`, pg.Ident(Columns.Session.UserID), userID)
+	if exceptID != 0 {
+		q.Where(`? != ?`, pg.Ident(Columns.Session.ID), exceptID)
+	}
+
+	res, err := q.Delete()
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected(), nil
+}
+
+// DeleteExpiredSessions deletes sessions of the user, which absolute or idle expiration time has come.
+func (cr CommonRepo) DeleteExpiredSessions(ctx context.Context, userID int) error {
+	_, err := cr.db.ModelContext(ctx, (*Session)(nil)).
+		Where(`? = ?`, pg.Ident(Columns.Session.UserID), userID).
+		WhereGroup(func(q *pg.Query) (*pg.Query, error) {
+			q = q.Where(`? <= now()`, pg.Ident(Columns.Session.ExpiresAt)).
+				WhereOr(`? + ? * interval '1 second' <= now()`, pg.Ident(Columns.Session.LastActivityAt), pg.Ident(Columns.Session.IdleTimeout))
+			return q, nil
+		}).
+		Delete()
+
+	return err
+}
+
+// LoginFailures is a number of failed login attempts and time of the last one.
+type LoginFailures struct {
+	Count int
+	Last  *time.Time
+}
+
+// FailedLogins returns uncleared failed login attempts with invalid credentials since from, where column is equal to value.
+// Column is Columns.LoginAttempt.Login or Columns.LoginAttempt.IP.
+func (cr CommonRepo) FailedLogins(ctx context.Context, column, value string, from time.Time) (LoginFailures, error) {
+	var res LoginFailures
+
+	_, err := cr.db.QueryOneContext(ctx, &res, `
+		SELECT count(*) AS "count", max(?) AS "last" FROM ?
+		WHERE ? = ? AND ? >= ? AND ? = ? AND NOT ? AND NOT ?`,
+		pg.Ident(Columns.LoginAttempt.CreatedAt), pg.Ident(Tables.LoginAttempt.Name),
+		pg.Ident(column), value, pg.Ident(Columns.LoginAttempt.CreatedAt), from,
+		pg.Ident(Columns.LoginAttempt.Reason), LoginReasonInvalid,
+		pg.Ident(Columns.LoginAttempt.IsSuccess), pg.Ident(Columns.LoginAttempt.IsCleared),
+	)
+
+	return res, err
+}
+
+// ClearFailedLogins marks failed login attempts, where column is equal to value, as cleared, so they are not counted anymore.
+// It returns the number of cleared attempts.
+func (cr CommonRepo) ClearFailedLogins(ctx context.Context, column, value string) (int, error) {
+	res, err := cr.db.ModelContext(ctx, (*LoginAttempt)(nil)).
+		Set(`? = true`, pg.Ident(Columns.LoginAttempt.IsCleared)).
+		Where(`? = ?`, pg.Ident(column), value).
+		Where(`NOT ?`, pg.Ident(Columns.LoginAttempt.IsSuccess)).
+		Where(`NOT ?`, pg.Ident(Columns.LoginAttempt.IsCleared)).
+		Update()
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected(), nil
+}
+
+// IsExpired checks that API key expiration time has come, key without expiration time never expires.
+func (k APIKey) IsExpired(now time.Time) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
+
+// APIKeyByTokenHash returns not deleted APIKey with its User by token hash or nil.
+func (cr CommonRepo) APIKeyByTokenHash(ctx context.Context, tokenHash string) (*APIKey, error) {
+	return cr.OneAPIKey(ctx, &APIKeySearch{TokenHash: &tokenHash}, cr.FullAPIKey())
+}
+
+// UpdateAPIKeyUsage updates API key last used time and IP.
+func (cr CommonRepo) UpdateAPIKeyUsage(ctx context.Context, key *APIKey, ip string) error {
+	now := time.Now()
+	key.LastUsedAt, key.LastUsedIP = &now, &ip
+	_, err := cr.UpdateAPIKey(ctx, key, WithColumns(Columns.APIKey.LastUsedAt, Columns.APIKey.LastUsedIP))
+	return err
 }
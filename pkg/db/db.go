@@ -4,6 +4,7 @@ package db
 
 import (
 	"context"
+	"errors"
 	"hash/crc64"
 	"reflect"
 
@@ -11,6 +12,15 @@ import (
 	"github.com/go-pg/pg/v10/orm"
 )
 
+// pgUniqueViolation is a postgres error code of unique constraint violation.
+const pgUniqueViolation = "23505"
+
+// IsUniqueViolation checks that err is a violation of the unique index or constraint.
+func IsUniqueViolation(err error, index string) bool {
+	var pgErr pg.Error
+	return errors.As(err, &pgErr) && pgErr.Field('C') == pgUniqueViolation && pgErr.Field('n') == index
+}
+
 // DB stores db connection
 type DB struct {
 	*pg.DB
@@ -48,11 +58,18 @@ func (db *DB) runInTransaction(ctx context.Context, fns ...func(*pg.Tx) error) e
 
 // RunInLock runs chain of functions in transaction with lock until first error
 func (db *DB) RunInLock(ctx context.Context, lockName string, fns ...func(*pg.Tx) error) error {
-	lock := int64(crc64.Checksum([]byte(lockName), db.crcTable))
+	return db.RunInLocks(ctx, []string{lockName}, fns...)
+}
 
+// RunInLocks runs chain of functions in transaction with all locks until first error.
+// Locks are taken in the given order, so callers must use the same order to avoid deadlocks.
+func (db *DB) RunInLocks(ctx context.Context, lockNames []string, fns ...func(*pg.Tx) error) error {
 	return db.RunInTransaction(ctx, func(tx *pg.Tx) (err error) {
-		if _, err = tx.Exec("select pg_advisory_xact_lock(?) -- ?", lock, lockName); err != nil {
-			return
+		for _, lockName := range lockNames {
+			lock := int64(crc64.Checksum([]byte(lockName), db.crcTable))
+			if _, err = tx.Exec("select pg_advisory_xact_lock(?) -- ?", lock, lockName); err != nil {
+				return
+			}
 		}
 
 		for _, fn := range fns {
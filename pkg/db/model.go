@@ -9,8 +9,26 @@ import (
 )
 
 var Columns = struct {
+	Role struct {
+		ID, Title, Permissions, IsTOTPRequired, StatusID string
+	}
 	User struct {
-		ID, CreatedAt, Login, Password, AuthKey, LastActivityAt, StatusID string
+		ID, CreatedAt, Login, Password, LastActivityAt, StatusID, RoleIDs, TOTPSecret, IsTOTPEnabled, TOTPCounter, RecoveryCodes, IsService string
+	}
+	Session struct {
+		ID, UserID, TokenHash, IP, UserAgent, Device, IdleTimeout, CreatedAt, LastActivityAt, ExpiresAt string
+
+		User string
+	}
+	LoginAttempt struct {
+		ID, Login, UserID, IP, UserAgent, IsSuccess, Reason, IsCleared, CreatedAt string
+
+		User string
+	}
+	APIKey struct {
+		ID, UserID, Title, Prefix, TokenHash, Permissions, ExpiresAt, LastUsedAt, LastUsedIP, CreatedAt, StatusID string
+
+		User string
 	}
 	VfsFile struct {
 		ID, FolderID, Title, Path, Params, IsFavorite, MimeType, FileSize, FileExists, CreatedAt, StatusID string
@@ -23,27 +41,134 @@ var Columns = struct {
 		ParentFolder string
 	}
 	Category struct {
-		ID, Title, Sort, StatusID string
+		ID, Title, Slug, Sort, StatusID string
 	}
 	News struct {
-		ID, Title, ShortText, Content, Author, CategoryID, TagIDs, PublishedAt, CreatedAt, StatusID string
+		ID, Title, Slug, ShortText, Content, Author, CategoryID, TagIDs, PublishedAt, CreatedAt, UpdatedAt, StatusID string
 
 		Category string
 	}
+	NewsRevision struct {
+		ID, NewsID, UserID, Action, Title, Slug, ShortText, Content, Author, CategoryID, TagIDs, PublishedAt, NewsStatusID, CreatedAt string
+
+		News, User string
+	}
+	NewsSlug struct {
+		ID, Slug, NewsID, CreatedAt string
+
+		News string
+	}
+	Suggestion struct {
+		ID, Title, ShortText, Text, CategoryID, Tags, IP, Contact, NewsID, CreatedAt, ModerationStatusID string
+
+		Category, News string
+	}
+	SuggestionDecision struct {
+		ID, SuggestionID, UserID, ModerationStatusID, Reason, NewsID, CreatedAt string
+
+		Suggestion, User, News string
+	}
 	Tag struct {
 		ID, Name, StatusID string
 	}
+	Webhook struct {
+		ID, Title, URL, Secret, Events, CreatedAt, StatusID string
+	}
+	WebhookDelivery struct {
+		ID, WebhookID, Event, Key, Payload, Attempts, NextAttemptAt, ResponseCode, Error, DeliveredAt, CreatedAt, DeliveryStatusID string
+
+		Webhook string
+	}
+	WebhookCursor struct {
+		ID, Value string
+	}
+	AuditLog struct {
+		ID, UserID, IP, Namespace, Method, EntityID, Params, IsSuccess, ErrorCode, Duration, CreatedAt string
+
+		User string
+	}
+	TrashItem struct {
+		ID, ObjectType, ObjectID, PrevStatusID, DeletedAt string
+	}
 }{
+	Role: struct {
+		ID, Title, Permissions, IsTOTPRequired, StatusID string
+	}{
+		ID:             "roleId",
+		Title:          "title",
+		Permissions:    "permissions",
+		IsTOTPRequired: "isTotpRequired",
+		StatusID:       "statusId",
+	},
 	User: struct {
-		ID, CreatedAt, Login, Password, AuthKey, LastActivityAt, StatusID string
+		ID, CreatedAt, Login, Password, LastActivityAt, StatusID, RoleIDs, TOTPSecret, IsTOTPEnabled, TOTPCounter, RecoveryCodes, IsService string
 	}{
 		ID:             "userId",
 		CreatedAt:      "createdAt",
 		Login:          "login",
 		Password:       "password",
-		AuthKey:        "authKey",
 		LastActivityAt: "lastActivityAt",
 		StatusID:       "statusId",
+		RoleIDs:        "roleIds",
+		TOTPSecret:     "totpSecret",
+		IsTOTPEnabled:  "isTotpEnabled",
+		TOTPCounter:    "totpCounter",
+		RecoveryCodes:  "recoveryCodes",
+		IsService:      "isService",
+	},
+	Session: struct {
+		ID, UserID, TokenHash, IP, UserAgent, Device, IdleTimeout, CreatedAt, LastActivityAt, ExpiresAt string
+
+		User string
+	}{
+		ID:             "sessionId",
+		UserID:         "userId",
+		TokenHash:      "tokenHash",
+		IP:             "ip",
+		UserAgent:      "userAgent",
+		Device:         "device",
+		IdleTimeout:    "idleTimeout",
+		CreatedAt:      "createdAt",
+		LastActivityAt: "lastActivityAt",
+		ExpiresAt:      "expiresAt",
+
+		User: "User",
+	},
+	LoginAttempt: struct {
+		ID, Login, UserID, IP, UserAgent, IsSuccess, Reason, IsCleared, CreatedAt string
+
+		User string
+	}{
+		ID:        "loginAttemptId",
+		Login:     "login",
+		UserID:    "userId",
+		IP:        "ip",
+		UserAgent: "userAgent",
+		IsSuccess: "isSuccess",
+		Reason:    "reason",
+		IsCleared: "isCleared",
+		CreatedAt: "createdAt",
+
+		User: "User",
+	},
+	APIKey: struct {
+		ID, UserID, Title, Prefix, TokenHash, Permissions, ExpiresAt, LastUsedAt, LastUsedIP, CreatedAt, StatusID string
+
+		User string
+	}{
+		ID:          "apiKeyId",
+		UserID:      "userId",
+		Title:       "title",
+		Prefix:      "prefix",
+		TokenHash:   "tokenHash",
+		Permissions: "permissions",
+		ExpiresAt:   "expiresAt",
+		LastUsedAt:  "lastUsedAt",
+		LastUsedIP:  "lastUsedIp",
+		CreatedAt:   "createdAt",
+		StatusID:    "statusId",
+
+		User: "User",
 	},
 	VfsFile: struct {
 		ID, FolderID, Title, Path, Params, IsFavorite, MimeType, FileSize, FileExists, CreatedAt, StatusID string
@@ -79,20 +204,22 @@ var Columns = struct {
 		ParentFolder: "ParentFolder",
 	},
 	Category: struct {
-		ID, Title, Sort, StatusID string
+		ID, Title, Slug, Sort, StatusID string
 	}{
 		ID:       "categoryId",
 		Title:    "title",
+		Slug:     "slug",
 		Sort:     "sort",
 		StatusID: "statusId",
 	},
 	News: struct {
-		ID, Title, ShortText, Content, Author, CategoryID, TagIDs, PublishedAt, CreatedAt, StatusID string
+		ID, Title, Slug, ShortText, Content, Author, CategoryID, TagIDs, PublishedAt, CreatedAt, UpdatedAt, StatusID string
 
 		Category string
 	}{
 		ID:          "newsId",
 		Title:       "title",
+		Slug:        "slug",
 		ShortText:   "shortText",
 		Content:     "content",
 		Author:      "author",
@@ -100,10 +227,83 @@ var Columns = struct {
 		TagIDs:      "tagIds",
 		PublishedAt: "publishedAt",
 		CreatedAt:   "createdAt",
+		UpdatedAt:   "updatedAt",
 		StatusID:    "statusId",
 
 		Category: "Category",
 	},
+	NewsRevision: struct {
+		ID, NewsID, UserID, Action, Title, Slug, ShortText, Content, Author, CategoryID, TagIDs, PublishedAt, NewsStatusID, CreatedAt string
+
+		News, User string
+	}{
+		ID:           "newsRevisionId",
+		NewsID:       "newsId",
+		UserID:       "userId",
+		Action:       "action",
+		Title:        "title",
+		Slug:         "slug",
+		ShortText:    "shortText",
+		Content:      "content",
+		Author:       "author",
+		CategoryID:   "categoryId",
+		TagIDs:       "tagIds",
+		PublishedAt:  "publishedAt",
+		NewsStatusID: "newsStatusId",
+		CreatedAt:    "createdAt",
+
+		News: "News",
+		User: "User",
+	},
+	NewsSlug: struct {
+		ID, Slug, NewsID, CreatedAt string
+
+		News string
+	}{
+		ID:        "newsSlugId",
+		Slug:      "slug",
+		NewsID:    "newsId",
+		CreatedAt: "createdAt",
+
+		News: "News",
+	},
+	Suggestion: struct {
+		ID, Title, ShortText, Text, CategoryID, Tags, IP, Contact, NewsID, CreatedAt, ModerationStatusID string
+
+		Category, News string
+	}{
+		ID:                 "suggestionId",
+		Title:              "title",
+		ShortText:          "shortText",
+		Text:               "text",
+		CategoryID:         "categoryId",
+		Tags:               "tags",
+		IP:                 "ip",
+		Contact:            "contact",
+		NewsID:             "newsId",
+		CreatedAt:          "createdAt",
+		ModerationStatusID: "moderationStatusId",
+
+		Category: "Category",
+		News:     "News",
+	},
+	SuggestionDecision: struct {
+		ID, SuggestionID, UserID, ModerationStatusID, Reason, NewsID, CreatedAt string
+
+		Suggestion, User, News string
+	}{
+		ID:                 "suggestionDecisionId",
+		SuggestionID:       "suggestionId",
+		UserID:             "userId",
+		ModerationStatusID: "moderationStatusId",
+		Reason:             "reason",
+		NewsID:             "newsId",
+		CreatedAt:          "createdAt",
+
+		Suggestion: "Suggestion",
+		User:       "User",
+		News:       "News",
+	},
 	Tag: struct {
 		ID, Name, StatusID string
 	}{
@@ -111,12 +311,89 @@ var Columns = struct {
 		Name:     "name",
 		StatusID: "statusId",
 	},
+	Webhook: struct {
+		ID, Title, URL, Secret, Events, CreatedAt, StatusID string
+	}{
+		ID:        "webhookId",
+		Title:     "title",
+		URL:       "url",
+		Secret:    "secret",
+		Events:    "events",
+		CreatedAt: "createdAt",
+		StatusID:  "statusId",
+	},
+	WebhookDelivery: struct {
+		ID, WebhookID, Event, Key, Payload, Attempts, NextAttemptAt, ResponseCode, Error, DeliveredAt, CreatedAt, DeliveryStatusID string
+
+		Webhook string
+	}{
+		ID:               "webhookDeliveryId",
+		WebhookID:        "webhookId",
+		Event:            "event",
+		Key:              "key",
+		Payload:          "payload",
+		Attempts:         "attempts",
+		NextAttemptAt:    "nextAttemptAt",
+		ResponseCode:     "responseCode",
+		Error:            "error",
+		DeliveredAt:      "deliveredAt",
+		CreatedAt:        "createdAt",
+		DeliveryStatusID: "deliveryStatusId",
+
+		Webhook: "Webhook",
+	},
+	WebhookCursor: struct {
+		ID, Value string
+	}{
+		ID:    "webhookCursorId",
+		Value: "value",
+	},
+	AuditLog: struct {
+		ID, UserID, IP, Namespace, Method, EntityID, Params, IsSuccess, ErrorCode, Duration, CreatedAt string
+
+		User string
+	}{
+		ID:        "auditLogId",
+		UserID:    "userId",
+		IP:        "ip",
+		Namespace: "namespace",
+		Method:    "method",
+		EntityID:  "entityId",
+		Params:    "params",
+		IsSuccess: "isSuccess",
+		ErrorCode: "errorCode",
+		Duration:  "duration",
+		CreatedAt: "createdAt",
+
+		User: "User",
+	},
+	TrashItem: struct {
+		ID, ObjectType, ObjectID, PrevStatusID, DeletedAt string
+	}{
+		ID:           "trashItemId",
+		ObjectType:   "objectType",
+		ObjectID:     "objectId",
+		PrevStatusID: "prevStatusId",
+		DeletedAt:    "deletedAt",
+	},
 }
 
 var Tables = struct {
+	Role struct {
+		Name, Alias string
+	}
 	User struct {
 		Name, Alias string
 	}
+	Session struct {
+		Name, Alias string
+	}
+	LoginAttempt struct {
+		Name, Alias string
+	}
+	APIKey struct {
+		Name, Alias string
+	}
 	VfsFile struct {
 		Name, Alias string
 	}
@@ -129,16 +406,67 @@ var Tables = struct {
 	News struct {
 		Name, Alias string
 	}
+	NewsRevision struct {
+		Name, Alias string
+	}
+	NewsSlug struct {
+		Name, Alias string
+	}
+	Suggestion struct {
+		Name, Alias string
+	}
+	SuggestionDecision struct {
+		Name, Alias string
+	}
 	Tag struct {
 		Name, Alias string
 	}
+	Webhook struct {
+		Name, Alias string
+	}
+	WebhookDelivery struct {
+		Name, Alias string
+	}
+	WebhookCursor struct {
+		Name, Alias string
+	}
+	AuditLog struct {
+		Name, Alias string
+	}
+	TrashItem struct {
+		Name, Alias string
+	}
 }{
+	Role: struct {
+		Name, Alias string
+	}{
+		Name:  "roles",
+		Alias: "t",
+	},
 	User: struct {
 		Name, Alias string
 	}{
 		Name:  "users",
 		Alias: "t",
 	},
+	Session: struct {
+		Name, Alias string
+	}{
+		Name:  "sessions",
+		Alias: "t",
+	},
+	LoginAttempt: struct {
+		Name, Alias string
+	}{
+		Name:  "loginAttempts",
+		Alias: "t",
+	},
+	APIKey: struct {
+		Name, Alias string
+	}{
+		Name:  "apiKeys",
+		Alias: "t",
+	},
 	VfsFile: struct {
 		Name, Alias string
 	}{
@@ -163,12 +491,76 @@ var Tables = struct {
 		Name:  "news",
 		Alias: "t",
 	},
+	NewsRevision: struct {
+		Name, Alias string
+	}{
+		Name:  "newsRevisions",
+		Alias: "t",
+	},
+	NewsSlug: struct {
+		Name, Alias string
+	}{
+		Name:  "newsSlugs",
+		Alias: "t",
+	},
+	Suggestion: struct {
+		Name, Alias string
+	}{
+		Name:  "suggestions",
+		Alias: "t",
+	},
+	SuggestionDecision: struct {
+		Name, Alias string
+	}{
+		Name:  "suggestionDecisions",
+		Alias: "t",
+	},
 	Tag: struct {
 		Name, Alias string
 	}{
 		Name:  "tags",
 		Alias: "t",
 	},
+	Webhook: struct {
+		Name, Alias string
+	}{
+		Name:  "webhooks",
+		Alias: "t",
+	},
+	WebhookDelivery: struct {
+		Name, Alias string
+	}{
+		Name:  "webhookDeliveries",
+		Alias: "t",
+	},
+	WebhookCursor: struct {
+		Name, Alias string
+	}{
+		Name:  "webhookCursors",
+		Alias: "t",
+	},
+	AuditLog: struct {
+		Name, Alias string
+	}{
+		Name:  "auditLog",
+		Alias: "t",
+	},
+	TrashItem: struct {
+		Name, Alias string
+	}{
+		Name:  "trashItems",
+		Alias: "t",
+	},
+}
+
+type Role struct {
+	tableName struct{} `pg:"roles,alias:t,discard_unknown_columns"`
+
+	ID             int      `pg:"roleId,pk"`
+	Title          string   `pg:"title,use_zero"`
+	Permissions    []string `pg:"permissions,array,use_zero"`
+	IsTOTPRequired bool     `pg:"isTotpRequired,use_zero"`
+	StatusID       int      `pg:"statusId,use_zero"`
 }
 
 type User struct {
@@ -178,9 +570,65 @@ type User struct {
 	CreatedAt      time.Time  `pg:"createdAt,use_zero"`
 	Login          string     `pg:"login,use_zero"`
 	Password       string     `pg:"password,use_zero"`
-	AuthKey        string     `pg:"authKey,use_zero"`
 	LastActivityAt *time.Time `pg:"lastActivityAt"`
 	StatusID       int        `pg:"statusId,use_zero"`
+	RoleIDs        []int      `pg:"roleIds,array,use_zero"`
+	TOTPSecret     *string    `pg:"totpSecret"`
+	IsTOTPEnabled  bool       `pg:"isTotpEnabled,use_zero"`
+	TOTPCounter    int64      `pg:"totpCounter,use_zero"`
+	RecoveryCodes  []string   `pg:"recoveryCodes,array,use_zero"`
+	IsService      bool       `pg:"isService,use_zero"`
+}
+
+type Session struct {
+	tableName struct{} `pg:"sessions,alias:t,discard_unknown_columns"`
+
+	ID             int       `pg:"sessionId,pk"`
+	UserID         int       `pg:"userId,use_zero"`
+	TokenHash      string    `pg:"tokenHash,use_zero"`
+	IP             string    `pg:"ip,use_zero"`
+	UserAgent      string    `pg:"userAgent,use_zero"`
+	Device         string    `pg:"device,use_zero"`
+	IdleTimeout    int       `pg:"idleTimeout,use_zero"`
+	CreatedAt      time.Time `pg:"createdAt,use_zero"`
+	LastActivityAt time.Time `pg:"lastActivityAt,use_zero"`
+	ExpiresAt      time.Time `pg:"expiresAt,use_zero"`
+
+	User *User `pg:"fk:userId,rel:has-one"`
+}
+
+type LoginAttempt struct {
+	tableName struct{} `pg:"loginAttempts,alias:t,discard_unknown_columns"`
+
+	ID        int       `pg:"loginAttemptId,pk"`
+	Login     string    `pg:"login,use_zero"`
+	UserID    *int      `pg:"userId"`
+	IP        string    `pg:"ip,use_zero"`
+	UserAgent string    `pg:"userAgent,use_zero"`
+	IsSuccess bool      `pg:"isSuccess,use_zero"`
+	Reason    *string   `pg:"reason"`
+	IsCleared bool      `pg:"isCleared,use_zero"`
+	CreatedAt time.Time `pg:"createdAt,use_zero"`
+
+	User *User `pg:"fk:userId,rel:has-one"`
+}
+
+type APIKey struct {
+	tableName struct{} `pg:"apiKeys,alias:t,discard_unknown_columns"`
+
+	ID          int        `pg:"apiKeyId,pk"`
+	UserID      int        `pg:"userId,use_zero"`
+	Title       string     `pg:"title,use_zero"`
+	Prefix      string     `pg:"prefix,use_zero"`
+	TokenHash   string     `pg:"tokenHash,use_zero"`
+	Permissions []string   `pg:"permissions,array,use_zero"`
+	ExpiresAt   *time.Time `pg:"expiresAt"`
+	LastUsedAt  *time.Time `pg:"lastUsedAt"`
+	LastUsedIP  *string    `pg:"lastUsedIp"`
+	CreatedAt   time.Time  `pg:"createdAt,use_zero"`
+	StatusID    int        `pg:"statusId,use_zero"`
+
+	User *User `pg:"fk:userId,rel:has-one"`
 }
 
 type VfsFile struct {
@@ -219,6 +667,7 @@ type Category struct {
 
 	ID       int    `pg:"categoryId,pk"`
 	Title    string `pg:"title,use_zero"`
+	Slug     string `pg:"slug,use_zero"`
 	Sort     *int   `pg:"sort"`
 	StatusID int    `pg:"statusId,use_zero"`
 }
@@ -228,6 +677,7 @@ type News struct {
 
 	ID          int       `pg:"newsId,pk"`
 	Title       string    `pg:"title,use_zero"`
+	Slug        string    `pg:"slug,use_zero"`
 	ShortText   string    `pg:"shortText,use_zero"`
 	Content     *string   `pg:"content"`
 	Author      *string   `pg:"author"`
@@ -235,11 +685,80 @@ type News struct {
 	TagIDs      []int     `pg:"tagIds,array,use_zero"`
 	PublishedAt time.Time `pg:"publishedAt,use_zero"`
 	CreatedAt   time.Time `pg:"createdAt,use_zero"`
+	UpdatedAt   time.Time `pg:"updatedAt,use_zero"`
 	StatusID    int       `pg:"statusId,use_zero"`
 
 	Category *Category `pg:"fk:categoryId,rel:has-one"`
 }
 
+type NewsRevision struct {
+	tableName struct{} `pg:"newsRevisions,alias:t,discard_unknown_columns"`
+
+	ID           int       `pg:"newsRevisionId,pk"`
+	NewsID       int       `pg:"newsId,use_zero"`
+	UserID       *int      `pg:"userId"`
+	Action       string    `pg:"action,use_zero"`
+	Title        string    `pg:"title,use_zero"`
+	Slug         string    `pg:"slug,use_zero"`
+	ShortText    string    `pg:"shortText,use_zero"`
+	Content      *string   `pg:"content"`
+	Author       *string   `pg:"author"`
+	CategoryID   int       `pg:"categoryId,use_zero"`
+	TagIDs       []int     `pg:"tagIds,array,use_zero"`
+	PublishedAt  time.Time `pg:"publishedAt,use_zero"`
+	NewsStatusID int       `pg:"newsStatusId,use_zero"`
+	CreatedAt    time.Time `pg:"createdAt,use_zero"`
+
+	News *News `pg:"fk:newsId,rel:has-one"`
+	User *User `pg:"fk:userId,rel:has-one"`
+}
+
+type NewsSlug struct {
+	tableName struct{} `pg:"newsSlugs,alias:t,discard_unknown_columns"`
+
+	ID        int       `pg:"newsSlugId,pk"`
+	Slug      string    `pg:"slug,use_zero"`
+	NewsID    int       `pg:"newsId,use_zero"`
+	CreatedAt time.Time `pg:"createdAt,use_zero"`
+
+	News *News `pg:"fk:newsId,rel:has-one"`
+}
+
+type Suggestion struct {
+	tableName struct{} `pg:"suggestions,alias:t,discard_unknown_columns"`
+
+	ID                 int       `pg:"suggestionId,pk"`
+	Title              string    `pg:"title,use_zero"`
+	ShortText          string    `pg:"shortText,use_zero"`
+	Text               string    `pg:"text,use_zero"`
+	CategoryID         int       `pg:"categoryId,use_zero"`
+	Tags               []string  `pg:"tags,array,use_zero"`
+	IP                 string    `pg:"ip,use_zero"`
+	Contact            *string   `pg:"contact"`
+	NewsID             *int      `pg:"newsId"`
+	CreatedAt          time.Time `pg:"createdAt,use_zero"`
+	ModerationStatusID int       `pg:"moderationStatusId,use_zero"`
+
+	Category *Category `pg:"fk:categoryId,rel:has-one"`
+	News     *News     `pg:"fk:newsId,rel:has-one"`
+}
+
+type SuggestionDecision struct {
+	tableName struct{} `pg:"suggestionDecisions,alias:t,discard_unknown_columns"`
+
+	ID                 int       `pg:"suggestionDecisionId,pk"`
+	SuggestionID       int       `pg:"suggestionId,use_zero"`
+	UserID             int       `pg:"userId,use_zero"`
+	ModerationStatusID int       `pg:"moderationStatusId,use_zero"`
+	Reason             *string   `pg:"reason"`
+	NewsID             *int      `pg:"newsId"`
+	CreatedAt          time.Time `pg:"createdAt,use_zero"`
+
+	Suggestion *Suggestion `pg:"fk:suggestionId,rel:has-one"`
+	User       *User       `pg:"fk:userId,rel:has-one"`
+	News       *News       `pg:"fk:newsId,rel:has-one"`
+}
+
 type Tag struct {
 	tableName struct{} `pg:"tags,alias:t,discard_unknown_columns"`
 
@@ -247,3 +766,69 @@ type Tag struct {
 	Name     string `pg:"name,use_zero"`
 	StatusID int    `pg:"statusId,use_zero"`
 }
+
+type Webhook struct {
+	tableName struct{} `pg:"webhooks,alias:t,discard_unknown_columns"`
+
+	ID        int       `pg:"webhookId,pk"`
+	Title     string    `pg:"title,use_zero"`
+	URL       string    `pg:"url,use_zero"`
+	Secret    string    `pg:"secret,use_zero"`
+	Events    []string  `pg:"events,array,use_zero"`
+	CreatedAt time.Time `pg:"createdAt,use_zero"`
+	StatusID  int       `pg:"statusId,use_zero"`
+}
+
+type WebhookDelivery struct {
+	tableName struct{} `pg:"webhookDeliveries,alias:t,discard_unknown_columns"`
+
+	ID               int        `pg:"webhookDeliveryId,pk"`
+	WebhookID        int        `pg:"webhookId,use_zero"`
+	Event            string     `pg:"event,use_zero"`
+	Key              *string    `pg:"key"`
+	Payload          string     `pg:"payload,use_zero"`
+	Attempts         int        `pg:"attempts,use_zero"`
+	NextAttemptAt    time.Time  `pg:"nextAttemptAt,use_zero"`
+	ResponseCode     *int       `pg:"responseCode"`
+	Error            *string    `pg:"error"`
+	DeliveredAt      *time.Time `pg:"deliveredAt"`
+	CreatedAt        time.Time  `pg:"createdAt,use_zero"`
+	DeliveryStatusID int        `pg:"deliveryStatusId,use_zero"`
+
+	Webhook *Webhook `pg:"fk:webhookId,rel:has-one"`
+}
+
+type WebhookCursor struct {
+	tableName struct{} `pg:"webhookCursors,alias:t,discard_unknown_columns"`
+
+	ID    string    `pg:"webhookCursorId,pk"`
+	Value time.Time `pg:"value,use_zero"`
+}
+
+type AuditLog struct {
+	tableName struct{} `pg:"auditLog,alias:t,discard_unknown_columns"`
+
+	ID        int       `pg:"auditLogId,pk"`
+	UserID    *int      `pg:"userId"`
+	IP        string    `pg:"ip,use_zero"`
+	Namespace string    `pg:"namespace,use_zero"`
+	Method    string    `pg:"method,use_zero"`
+	EntityID  *int      `pg:"entityId"`
+	Params    *string   `pg:"params"`
+	IsSuccess bool      `pg:"isSuccess,use_zero"`
+	ErrorCode *int      `pg:"errorCode"`
+	Duration  int       `pg:"duration,use_zero"`
+	CreatedAt time.Time `pg:"createdAt,use_zero"`
+
+	User *User `pg:"fk:userId,rel:has-one"`
+}
+
+type TrashItem struct {
+	tableName struct{} `pg:"trashItems,alias:t,discard_unknown_columns"`
+
+	ID           int       `pg:"trashItemId,pk"`
+	ObjectType   string    `pg:"objectType,use_zero"`
+	ObjectID     int       `pg:"objectId,use_zero"`
+	PrevStatusID int       `pg:"prevStatusId,use_zero"`
+	DeletedAt    time.Time `pg:"deletedAt,use_zero"`
+}
@@ -52,6 +52,54 @@ type Searcher interface {
 	WithApply(a applier)
 }
 
+type RoleSearch struct {
+	search
+
+	ID             *int
+	Title          *string
+	IsTOTPRequired *bool
+	StatusID       *int
+	IDs            []int
+	TitleILike     *string
+}
+
+func (rs *RoleSearch) Apply(query *orm.Query) *orm.Query {
+	if rs == nil {
+		return query
+	}
+	if rs.ID != nil {
+		rs.where(query, Tables.Role.Alias, Columns.Role.ID, rs.ID)
+	}
+	if rs.Title != nil {
+		rs.where(query, Tables.Role.Alias, Columns.Role.Title, rs.Title)
+	}
+	if rs.IsTOTPRequired != nil {
+		rs.where(query, Tables.Role.Alias, Columns.Role.IsTOTPRequired, rs.IsTOTPRequired)
+	}
+	if rs.StatusID != nil {
+		rs.where(query, Tables.Role.Alias, Columns.Role.StatusID, rs.StatusID)
+	}
+	if len(rs.IDs) > 0 {
+		Filter{Columns.Role.ID, rs.IDs, SearchTypeArray, false}.Apply(query)
+	}
+	if rs.TitleILike != nil {
+		Filter{Columns.Role.Title, *rs.TitleILike, SearchTypeILike, false}.Apply(query)
+	}
+
+	rs.apply(query)
+
+	return query
+}
+
+func (rs *RoleSearch) Q() applier {
+	return func(query *orm.Query) (*orm.Query, error) {
+		if rs == nil {
+			return query, nil
+		}
+		return rs.Apply(query), nil
+	}
+}
+
 type UserSearch struct {
 	search
 
@@ -59,14 +107,17 @@ type UserSearch struct {
 	CreatedAt          *time.Time
 	Login              *string
 	Password           *string
-	AuthKey            *string
 	LastActivityAt     *time.Time
 	StatusID           *int
+	TOTPSecret         *string
+	IsTOTPEnabled      *bool
+	TOTPCounter        *int64
+	IsService          *bool
 	IDs                []int
+	RoleID             *int
 	NotID              *int
 	LoginILike         *string
 	PasswordILike      *string
-	AuthKeyILike       *string
 	LastActivityAtFrom *time.Time
 	LastActivityAtTo   *time.Time
 }
@@ -87,18 +138,30 @@ func (us *UserSearch) Apply(query *orm.Query) *orm.Query {
 	if us.Password != nil {
 		us.where(query, Tables.User.Alias, Columns.User.Password, us.Password)
 	}
-	if us.AuthKey != nil {
-		us.where(query, Tables.User.Alias, Columns.User.AuthKey, us.AuthKey)
-	}
 	if us.LastActivityAt != nil {
 		us.where(query, Tables.User.Alias, Columns.User.LastActivityAt, us.LastActivityAt)
 	}
 	if us.StatusID != nil {
 		us.where(query, Tables.User.Alias, Columns.User.StatusID, us.StatusID)
 	}
+	if us.TOTPSecret != nil {
+		us.where(query, Tables.User.Alias, Columns.User.TOTPSecret, us.TOTPSecret)
+	}
+	if us.IsTOTPEnabled != nil {
+		us.where(query, Tables.User.Alias, Columns.User.IsTOTPEnabled, us.IsTOTPEnabled)
+	}
+	if us.TOTPCounter != nil {
+		us.where(query, Tables.User.Alias, Columns.User.TOTPCounter, us.TOTPCounter)
+	}
+	if us.IsService != nil {
+		us.where(query, Tables.User.Alias, Columns.User.IsService, us.IsService)
+	}
 	if len(us.IDs) > 0 {
 		Filter{Columns.User.ID, us.IDs, SearchTypeArray, false}.Apply(query)
 	}
+	if us.RoleID != nil {
+		Filter{Columns.User.RoleIDs, *us.RoleID, SearchTypeArrayContains, false}.Apply(query)
+	}
 	if us.NotID != nil {
 		Filter{Columns.User.ID, *us.NotID, SearchTypeEquals, true}.Apply(query)
 	}
@@ -108,9 +171,6 @@ func (us *UserSearch) Apply(query *orm.Query) *orm.Query {
 	if us.PasswordILike != nil {
 		Filter{Columns.User.Password, *us.PasswordILike, SearchTypeILike, false}.Apply(query)
 	}
-	if us.AuthKeyILike != nil {
-		Filter{Columns.User.AuthKey, *us.AuthKeyILike, SearchTypeILike, false}.Apply(query)
-	}
 	if us.LastActivityAtFrom != nil {
 		Filter{Columns.User.LastActivityAt, *us.LastActivityAtFrom, SearchTypeGE, false}.Apply(query)
 	}
@@ -132,6 +192,230 @@ func (us *UserSearch) Q() applier {
 	}
 }
 
+type SessionSearch struct {
+	search
+
+	ID             *int
+	UserID         *int
+	TokenHash      *string
+	IP             *string
+	UserAgent      *string
+	Device         *string
+	IdleTimeout    *int
+	CreatedAt      *time.Time
+	LastActivityAt *time.Time
+	ExpiresAt      *time.Time
+	IDs            []int
+	NotID          *int
+	ExpiresAtFrom  *time.Time
+}
+
+func (ss *SessionSearch) Apply(query *orm.Query) *orm.Query {
+	if ss == nil {
+		return query
+	}
+	if ss.ID != nil {
+		ss.where(query, Tables.Session.Alias, Columns.Session.ID, ss.ID)
+	}
+	if ss.UserID != nil {
+		ss.where(query, Tables.Session.Alias, Columns.Session.UserID, ss.UserID)
+	}
+	if ss.TokenHash != nil {
+		ss.where(query, Tables.Session.Alias, Columns.Session.TokenHash, ss.TokenHash)
+	}
+	if ss.IP != nil {
+		ss.where(query, Tables.Session.Alias, Columns.Session.IP, ss.IP)
+	}
+	if ss.UserAgent != nil {
+		ss.where(query, Tables.Session.Alias, Columns.Session.UserAgent, ss.UserAgent)
+	}
+	if ss.Device != nil {
+		ss.where(query, Tables.Session.Alias, Columns.Session.Device, ss.Device)
+	}
+	if ss.IdleTimeout != nil {
+		ss.where(query, Tables.Session.Alias, Columns.Session.IdleTimeout, ss.IdleTimeout)
+	}
+	if ss.CreatedAt != nil {
+		ss.where(query, Tables.Session.Alias, Columns.Session.CreatedAt, ss.CreatedAt)
+	}
+	if ss.LastActivityAt != nil {
+		ss.where(query, Tables.Session.Alias, Columns.Session.LastActivityAt, ss.LastActivityAt)
+	}
+	if ss.ExpiresAt != nil {
+		ss.where(query, Tables.Session.Alias, Columns.Session.ExpiresAt, ss.ExpiresAt)
+	}
+	if len(ss.IDs) > 0 {
+		Filter{Columns.Session.ID, ss.IDs, SearchTypeArray, false}.Apply(query)
+	}
+	if ss.NotID != nil {
+		Filter{Columns.Session.ID, *ss.NotID, SearchTypeEquals, true}.Apply(query)
+	}
+	if ss.ExpiresAtFrom != nil {
+		Filter{Columns.Session.ExpiresAt, *ss.ExpiresAtFrom, SearchTypeGE, false}.Apply(query)
+	}
+
+	ss.apply(query)
+
+	return query
+}
+
+func (ss *SessionSearch) Q() applier {
+	return func(query *orm.Query) (*orm.Query, error) {
+		if ss == nil {
+			return query, nil
+		}
+		return ss.Apply(query), nil
+	}
+}
+
+type LoginAttemptSearch struct {
+	search
+
+	ID            *int
+	Login         *string
+	UserID        *int
+	IP            *string
+	UserAgent     *string
+	IsSuccess     *bool
+	Reason        *string
+	IsCleared     *bool
+	CreatedAt     *time.Time
+	IDs           []int
+	LoginILike    *string
+	CreatedAtFrom *time.Time
+	CreatedAtTo   *time.Time
+}
+
+func (las *LoginAttemptSearch) Apply(query *orm.Query) *orm.Query {
+	if las == nil {
+		return query
+	}
+	if las.ID != nil {
+		las.where(query, Tables.LoginAttempt.Alias, Columns.LoginAttempt.ID, las.ID)
+	}
+	if las.Login != nil {
+		las.where(query, Tables.LoginAttempt.Alias, Columns.LoginAttempt.Login, las.Login)
+	}
+	if las.UserID != nil {
+		las.where(query, Tables.LoginAttempt.Alias, Columns.LoginAttempt.UserID, las.UserID)
+	}
+	if las.IP != nil {
+		las.where(query, Tables.LoginAttempt.Alias, Columns.LoginAttempt.IP, las.IP)
+	}
+	if las.UserAgent != nil {
+		las.where(query, Tables.LoginAttempt.Alias, Columns.LoginAttempt.UserAgent, las.UserAgent)
+	}
+	if las.IsSuccess != nil {
+		las.where(query, Tables.LoginAttempt.Alias, Columns.LoginAttempt.IsSuccess, las.IsSuccess)
+	}
+	if las.Reason != nil {
+		las.where(query, Tables.LoginAttempt.Alias, Columns.LoginAttempt.Reason, las.Reason)
+	}
+	if las.IsCleared != nil {
+		las.where(query, Tables.LoginAttempt.Alias, Columns.LoginAttempt.IsCleared, las.IsCleared)
+	}
+	if las.CreatedAt != nil {
+		las.where(query, Tables.LoginAttempt.Alias, Columns.LoginAttempt.CreatedAt, las.CreatedAt)
+	}
+	if len(las.IDs) > 0 {
+		Filter{Columns.LoginAttempt.ID, las.IDs, SearchTypeArray, false}.Apply(query)
+	}
+	if las.LoginILike != nil {
+		Filter{Columns.LoginAttempt.Login, *las.LoginILike, SearchTypeILike, false}.Apply(query)
+	}
+	if las.CreatedAtFrom != nil {
+		Filter{Columns.LoginAttempt.CreatedAt, *las.CreatedAtFrom, SearchTypeGE, false}.Apply(query)
+	}
+	if las.CreatedAtTo != nil {
+		Filter{Columns.LoginAttempt.CreatedAt, *las.CreatedAtTo, SearchTypeLE, false}.Apply(query)
+	}
+
+	las.apply(query)
+
+	return query
+}
+
+func (las *LoginAttemptSearch) Q() applier {
+	return func(query *orm.Query) (*orm.Query, error) {
+		if las == nil {
+			return query, nil
+		}
+		return las.Apply(query), nil
+	}
+}
+
+type APIKeySearch struct {
+	search
+
+	ID         *int
+	UserID     *int
+	Title      *string
+	Prefix     *string
+	TokenHash  *string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	LastUsedIP *string
+	CreatedAt  *time.Time
+	StatusID   *int
+	IDs        []int
+	TitleILike *string
+}
+
+func (as *APIKeySearch) Apply(query *orm.Query) *orm.Query {
+	if as == nil {
+		return query
+	}
+	if as.ID != nil {
+		as.where(query, Tables.APIKey.Alias, Columns.APIKey.ID, as.ID)
+	}
+	if as.UserID != nil {
+		as.where(query, Tables.APIKey.Alias, Columns.APIKey.UserID, as.UserID)
+	}
+	if as.Title != nil {
+		as.where(query, Tables.APIKey.Alias, Columns.APIKey.Title, as.Title)
+	}
+	if as.Prefix != nil {
+		as.where(query, Tables.APIKey.Alias, Columns.APIKey.Prefix, as.Prefix)
+	}
+	if as.TokenHash != nil {
+		as.where(query, Tables.APIKey.Alias, Columns.APIKey.TokenHash, as.TokenHash)
+	}
+	if as.ExpiresAt != nil {
+		as.where(query, Tables.APIKey.Alias, Columns.APIKey.ExpiresAt, as.ExpiresAt)
+	}
+	if as.LastUsedAt != nil {
+		as.where(query, Tables.APIKey.Alias, Columns.APIKey.LastUsedAt, as.LastUsedAt)
+	}
+	if as.LastUsedIP != nil {
+		as.where(query, Tables.APIKey.Alias, Columns.APIKey.LastUsedIP, as.LastUsedIP)
+	}
+	if as.CreatedAt != nil {
+		as.where(query, Tables.APIKey.Alias, Columns.APIKey.CreatedAt, as.CreatedAt)
+	}
+	if as.StatusID != nil {
+		as.where(query, Tables.APIKey.Alias, Columns.APIKey.StatusID, as.StatusID)
+	}
+	if len(as.IDs) > 0 {
+		Filter{Columns.APIKey.ID, as.IDs, SearchTypeArray, false}.Apply(query)
+	}
+	if as.TitleILike != nil {
+		Filter{Columns.APIKey.Title, *as.TitleILike, SearchTypeILike, false}.Apply(query)
+	}
+
+	as.apply(query)
+
+	return query
+}
+
+func (as *APIKeySearch) Q() applier {
+	return func(query *orm.Query) (*orm.Query, error) {
+		if as == nil {
+			return query, nil
+		}
+		return as.Apply(query), nil
+	}
+}
+
 type VfsFileSearch struct {
 	search
 
@@ -281,6 +565,7 @@ type CategorySearch struct {
 
 	ID         *int
 	Title      *string
+	Slug       *string
 	Sort       *int
 	StatusID   *int
 	IDs        []int
@@ -297,6 +582,9 @@ func (cs *CategorySearch) Apply(query *orm.Query) *orm.Query {
 	if cs.Title != nil {
 		cs.where(query, Tables.Category.Alias, Columns.Category.Title, cs.Title)
 	}
+	if cs.Slug != nil {
+		cs.where(query, Tables.Category.Alias, Columns.Category.Slug, cs.Slug)
+	}
 	if cs.Sort != nil {
 		cs.where(query, Tables.Category.Alias, Columns.Category.Sort, cs.Sort)
 	}
@@ -329,12 +617,14 @@ type NewsSearch struct {
 
 	ID              *int
 	Title           *string
+	Slug            *string
 	ShortText       *string
 	Content         *string
 	Author          *string
 	CategoryID      *int
 	PublishedAt     *time.Time
 	CreatedAt       *time.Time
+	UpdatedAt       *time.Time
 	StatusID        *int
 	IDs             []int
 	TitleILike      *string
@@ -342,7 +632,12 @@ type NewsSearch struct {
 	ContentILike    *string
 	AuthorILike     *string
 	TagID           *int
+	TagIDsAll       []int
+	TagIDsAny       []int
+	CategoryIDs     []int
 	PublishedBefore *time.Time
+	PublishedFrom   *time.Time
+	PublishedTo     *time.Time
 }
 
 func (ns *NewsSearch) Apply(query *orm.Query) *orm.Query {
@@ -355,6 +650,9 @@ func (ns *NewsSearch) Apply(query *orm.Query) *orm.Query {
 	if ns.Title != nil {
 		ns.where(query, Tables.News.Alias, Columns.News.Title, ns.Title)
 	}
+	if ns.Slug != nil {
+		ns.where(query, Tables.News.Alias, Columns.News.Slug, ns.Slug)
+	}
 	if ns.ShortText != nil {
 		ns.where(query, Tables.News.Alias, Columns.News.ShortText, ns.ShortText)
 	}
@@ -373,6 +671,9 @@ func (ns *NewsSearch) Apply(query *orm.Query) *orm.Query {
 	if ns.CreatedAt != nil {
 		ns.where(query, Tables.News.Alias, Columns.News.CreatedAt, ns.CreatedAt)
 	}
+	if ns.UpdatedAt != nil {
+		ns.where(query, Tables.News.Alias, Columns.News.UpdatedAt, ns.UpdatedAt)
+	}
 	if ns.StatusID != nil {
 		ns.where(query, Tables.News.Alias, Columns.News.StatusID, ns.StatusID)
 	}
@@ -394,9 +695,24 @@ func (ns *NewsSearch) Apply(query *orm.Query) *orm.Query {
 	if ns.TagID != nil {
 		Filter{Columns.News.TagIDs, *ns.TagID, SearchTypeArrayContains, false}.Apply(query)
 	}
+	if len(ns.TagIDsAll) > 0 {
+		Filter{Columns.News.TagIDs, ns.TagIDsAll, SearchTypeArrayContained, false}.Apply(query)
+	}
+	if len(ns.TagIDsAny) > 0 {
+		Filter{Columns.News.TagIDs, ns.TagIDsAny, SearchTypeArrayIntersect, false}.Apply(query)
+	}
+	if len(ns.CategoryIDs) > 0 {
+		Filter{Columns.News.CategoryID, ns.CategoryIDs, SearchTypeArray, false}.Apply(query)
+	}
 	if ns.PublishedBefore != nil {
 		Filter{Columns.News.PublishedAt, *ns.PublishedBefore, SearchTypeLE, false}.Apply(query)
 	}
+	if ns.PublishedFrom != nil {
+		Filter{Columns.News.PublishedAt, *ns.PublishedFrom, SearchTypeGE, false}.Apply(query)
+	}
+	if ns.PublishedTo != nil {
+		Filter{Columns.News.PublishedAt, *ns.PublishedTo, SearchTypeLE, false}.Apply(query)
+	}
 
 	ns.apply(query)
 
@@ -412,6 +728,266 @@ func (ns *NewsSearch) Q() applier {
 	}
 }
 
+type NewsRevisionSearch struct {
+	search
+
+	ID           *int
+	NewsID       *int
+	UserID       *int
+	Action       *string
+	Title        *string
+	Slug         *string
+	ShortText    *string
+	Content      *string
+	Author       *string
+	CategoryID   *int
+	PublishedAt  *time.Time
+	NewsStatusID *int
+	CreatedAt    *time.Time
+	IDs          []int
+}
+
+func (nrs *NewsRevisionSearch) Apply(query *orm.Query) *orm.Query {
+	if nrs == nil {
+		return query
+	}
+	if nrs.ID != nil {
+		nrs.where(query, Tables.NewsRevision.Alias, Columns.NewsRevision.ID, nrs.ID)
+	}
+	if nrs.NewsID != nil {
+		nrs.where(query, Tables.NewsRevision.Alias, Columns.NewsRevision.NewsID, nrs.NewsID)
+	}
+	if nrs.UserID != nil {
+		nrs.where(query, Tables.NewsRevision.Alias, Columns.NewsRevision.UserID, nrs.UserID)
+	}
+	if nrs.Action != nil {
+		nrs.where(query, Tables.NewsRevision.Alias, Columns.NewsRevision.Action, nrs.Action)
+	}
+	if nrs.Title != nil {
+		nrs.where(query, Tables.NewsRevision.Alias, Columns.NewsRevision.Title, nrs.Title)
+	}
+	if nrs.Slug != nil {
+		nrs.where(query, Tables.NewsRevision.Alias, Columns.NewsRevision.Slug, nrs.Slug)
+	}
+	if nrs.ShortText != nil {
+		nrs.where(query, Tables.NewsRevision.Alias, Columns.NewsRevision.ShortText, nrs.ShortText)
+	}
+	if nrs.Content != nil {
+		nrs.where(query, Tables.NewsRevision.Alias, Columns.NewsRevision.Content, nrs.Content)
+	}
+	if nrs.Author != nil {
+		nrs.where(query, Tables.NewsRevision.Alias, Columns.NewsRevision.Author, nrs.Author)
+	}
+	if nrs.CategoryID != nil {
+		nrs.where(query, Tables.NewsRevision.Alias, Columns.NewsRevision.CategoryID, nrs.CategoryID)
+	}
+	if nrs.PublishedAt != nil {
+		nrs.where(query, Tables.NewsRevision.Alias, Columns.NewsRevision.PublishedAt, nrs.PublishedAt)
+	}
+	if nrs.NewsStatusID != nil {
+		nrs.where(query, Tables.NewsRevision.Alias, Columns.NewsRevision.NewsStatusID, nrs.NewsStatusID)
+	}
+	if nrs.CreatedAt != nil {
+		nrs.where(query, Tables.NewsRevision.Alias, Columns.NewsRevision.CreatedAt, nrs.CreatedAt)
+	}
+	if len(nrs.IDs) > 0 {
+		Filter{Columns.NewsRevision.ID, nrs.IDs, SearchTypeArray, false}.Apply(query)
+	}
+
+	nrs.apply(query)
+
+	return query
+}
+
+func (nrs *NewsRevisionSearch) Q() applier {
+	return func(query *orm.Query) (*orm.Query, error) {
+		if nrs == nil {
+			return query, nil
+		}
+		return nrs.Apply(query), nil
+	}
+}
+
+type NewsSlugSearch struct {
+	search
+
+	ID        *int
+	Slug      *string
+	NewsID    *int
+	CreatedAt *time.Time
+	IDs       []int
+}
+
+func (nss *NewsSlugSearch) Apply(query *orm.Query) *orm.Query {
+	if nss == nil {
+		return query
+	}
+	if nss.ID != nil {
+		nss.where(query, Tables.NewsSlug.Alias, Columns.NewsSlug.ID, nss.ID)
+	}
+	if nss.Slug != nil {
+		nss.where(query, Tables.NewsSlug.Alias, Columns.NewsSlug.Slug, nss.Slug)
+	}
+	if nss.NewsID != nil {
+		nss.where(query, Tables.NewsSlug.Alias, Columns.NewsSlug.NewsID, nss.NewsID)
+	}
+	if nss.CreatedAt != nil {
+		nss.where(query, Tables.NewsSlug.Alias, Columns.NewsSlug.CreatedAt, nss.CreatedAt)
+	}
+	if len(nss.IDs) > 0 {
+		Filter{Columns.NewsSlug.ID, nss.IDs, SearchTypeArray, false}.Apply(query)
+	}
+
+	nss.apply(query)
+
+	return query
+}
+
+func (nss *NewsSlugSearch) Q() applier {
+	return func(query *orm.Query) (*orm.Query, error) {
+		if nss == nil {
+			return query, nil
+		}
+		return nss.Apply(query), nil
+	}
+}
+
+type SuggestionSearch struct {
+	search
+
+	ID                 *int
+	Title              *string
+	ShortText          *string
+	Text               *string
+	CategoryID         *int
+	IP                 *string
+	Contact            *string
+	NewsID             *int
+	CreatedAt          *time.Time
+	ModerationStatusID *int
+	IDs                []int
+	TitleILike         *string
+	CreatedAtFrom      *time.Time
+	CreatedAtTo        *time.Time
+}
+
+func (ss *SuggestionSearch) Apply(query *orm.Query) *orm.Query {
+	if ss == nil {
+		return query
+	}
+	if ss.ID != nil {
+		ss.where(query, Tables.Suggestion.Alias, Columns.Suggestion.ID, ss.ID)
+	}
+	if ss.Title != nil {
+		ss.where(query, Tables.Suggestion.Alias, Columns.Suggestion.Title, ss.Title)
+	}
+	if ss.ShortText != nil {
+		ss.where(query, Tables.Suggestion.Alias, Columns.Suggestion.ShortText, ss.ShortText)
+	}
+	if ss.Text != nil {
+		ss.where(query, Tables.Suggestion.Alias, Columns.Suggestion.Text, ss.Text)
+	}
+	if ss.CategoryID != nil {
+		ss.where(query, Tables.Suggestion.Alias, Columns.Suggestion.CategoryID, ss.CategoryID)
+	}
+	if ss.IP != nil {
+		ss.where(query, Tables.Suggestion.Alias, Columns.Suggestion.IP, ss.IP)
+	}
+	if ss.Contact != nil {
+		ss.where(query, Tables.Suggestion.Alias, Columns.Suggestion.Contact, ss.Contact)
+	}
+	if ss.NewsID != nil {
+		ss.where(query, Tables.Suggestion.Alias, Columns.Suggestion.NewsID, ss.NewsID)
+	}
+	if ss.CreatedAt != nil {
+		ss.where(query, Tables.Suggestion.Alias, Columns.Suggestion.CreatedAt, ss.CreatedAt)
+	}
+	if ss.ModerationStatusID != nil {
+		ss.where(query, Tables.Suggestion.Alias, Columns.Suggestion.ModerationStatusID, ss.ModerationStatusID)
+	}
+	if len(ss.IDs) > 0 {
+		Filter{Columns.Suggestion.ID, ss.IDs, SearchTypeArray, false}.Apply(query)
+	}
+	if ss.TitleILike != nil {
+		Filter{Columns.Suggestion.Title, *ss.TitleILike, SearchTypeILike, false}.Apply(query)
+	}
+	if ss.CreatedAtFrom != nil {
+		Filter{Columns.Suggestion.CreatedAt, *ss.CreatedAtFrom, SearchTypeGE, false}.Apply(query)
+	}
+	if ss.CreatedAtTo != nil {
+		Filter{Columns.Suggestion.CreatedAt, *ss.CreatedAtTo, SearchTypeLE, false}.Apply(query)
+	}
+
+	ss.apply(query)
+
+	return query
+}
+
+func (ss *SuggestionSearch) Q() applier {
+	return func(query *orm.Query) (*orm.Query, error) {
+		if ss == nil {
+			return query, nil
+		}
+		return ss.Apply(query), nil
+	}
+}
+
+type SuggestionDecisionSearch struct {
+	search
+
+	ID                 *int
+	SuggestionID       *int
+	UserID             *int
+	ModerationStatusID *int
+	Reason             *string
+	NewsID             *int
+	CreatedAt          *time.Time
+	IDs                []int
+}
+
+func (sds *SuggestionDecisionSearch) Apply(query *orm.Query) *orm.Query {
+	if sds == nil {
+		return query
+	}
+	if sds.ID != nil {
+		sds.where(query, Tables.SuggestionDecision.Alias, Columns.SuggestionDecision.ID, sds.ID)
+	}
+	if sds.SuggestionID != nil {
+		sds.where(query, Tables.SuggestionDecision.Alias, Columns.SuggestionDecision.SuggestionID, sds.SuggestionID)
+	}
+	if sds.UserID != nil {
+		sds.where(query, Tables.SuggestionDecision.Alias, Columns.SuggestionDecision.UserID, sds.UserID)
+	}
+	if sds.ModerationStatusID != nil {
+		sds.where(query, Tables.SuggestionDecision.Alias, Columns.SuggestionDecision.ModerationStatusID, sds.ModerationStatusID)
+	}
+	if sds.Reason != nil {
+		sds.where(query, Tables.SuggestionDecision.Alias, Columns.SuggestionDecision.Reason, sds.Reason)
+	}
+	if sds.NewsID != nil {
+		sds.where(query, Tables.SuggestionDecision.Alias, Columns.SuggestionDecision.NewsID, sds.NewsID)
+	}
+	if sds.CreatedAt != nil {
+		sds.where(query, Tables.SuggestionDecision.Alias, Columns.SuggestionDecision.CreatedAt, sds.CreatedAt)
+	}
+	if len(sds.IDs) > 0 {
+		Filter{Columns.SuggestionDecision.ID, sds.IDs, SearchTypeArray, false}.Apply(query)
+	}
+
+	sds.apply(query)
+
+	return query
+}
+
+func (sds *SuggestionDecisionSearch) Q() applier {
+	return func(query *orm.Query) (*orm.Query, error) {
+		if sds == nil {
+			return query, nil
+		}
+		return sds.Apply(query), nil
+	}
+}
+
 type TagSearch struct {
 	search
 
@@ -459,3 +1035,315 @@ func (ts *TagSearch) Q() applier {
 		return ts.Apply(query), nil
 	}
 }
+
+type WebhookSearch struct {
+	search
+
+	ID         *int
+	Title      *string
+	URL        *string
+	Secret     *string
+	CreatedAt  *time.Time
+	StatusID   *int
+	IDs        []int
+	TitleILike *string
+	URLILike   *string
+}
+
+func (ws *WebhookSearch) Apply(query *orm.Query) *orm.Query {
+	if ws == nil {
+		return query
+	}
+	if ws.ID != nil {
+		ws.where(query, Tables.Webhook.Alias, Columns.Webhook.ID, ws.ID)
+	}
+	if ws.Title != nil {
+		ws.where(query, Tables.Webhook.Alias, Columns.Webhook.Title, ws.Title)
+	}
+	if ws.URL != nil {
+		ws.where(query, Tables.Webhook.Alias, Columns.Webhook.URL, ws.URL)
+	}
+	if ws.Secret != nil {
+		ws.where(query, Tables.Webhook.Alias, Columns.Webhook.Secret, ws.Secret)
+	}
+	if ws.CreatedAt != nil {
+		ws.where(query, Tables.Webhook.Alias, Columns.Webhook.CreatedAt, ws.CreatedAt)
+	}
+	if ws.StatusID != nil {
+		ws.where(query, Tables.Webhook.Alias, Columns.Webhook.StatusID, ws.StatusID)
+	}
+	if len(ws.IDs) > 0 {
+		Filter{Columns.Webhook.ID, ws.IDs, SearchTypeArray, false}.Apply(query)
+	}
+	if ws.TitleILike != nil {
+		Filter{Columns.Webhook.Title, *ws.TitleILike, SearchTypeILike, false}.Apply(query)
+	}
+	if ws.URLILike != nil {
+		Filter{Columns.Webhook.URL, *ws.URLILike, SearchTypeILike, false}.Apply(query)
+	}
+
+	ws.apply(query)
+
+	return query
+}
+
+func (ws *WebhookSearch) Q() applier {
+	return func(query *orm.Query) (*orm.Query, error) {
+		if ws == nil {
+			return query, nil
+		}
+		return ws.Apply(query), nil
+	}
+}
+
+type WebhookDeliverySearch struct {
+	search
+
+	ID               *int
+	WebhookID        *int
+	Event            *string
+	Key              *string
+	Payload          *string
+	Attempts         *int
+	NextAttemptAt    *time.Time
+	ResponseCode     *int
+	Error            *string
+	DeliveredAt      *time.Time
+	CreatedAt        *time.Time
+	DeliveryStatusID *int
+	IDs              []int
+	CreatedAtFrom    *time.Time
+	CreatedAtTo      *time.Time
+}
+
+func (wds *WebhookDeliverySearch) Apply(query *orm.Query) *orm.Query {
+	if wds == nil {
+		return query
+	}
+	if wds.ID != nil {
+		wds.where(query, Tables.WebhookDelivery.Alias, Columns.WebhookDelivery.ID, wds.ID)
+	}
+	if wds.WebhookID != nil {
+		wds.where(query, Tables.WebhookDelivery.Alias, Columns.WebhookDelivery.WebhookID, wds.WebhookID)
+	}
+	if wds.Event != nil {
+		wds.where(query, Tables.WebhookDelivery.Alias, Columns.WebhookDelivery.Event, wds.Event)
+	}
+	if wds.Key != nil {
+		wds.where(query, Tables.WebhookDelivery.Alias, Columns.WebhookDelivery.Key, wds.Key)
+	}
+	if wds.Payload != nil {
+		wds.where(query, Tables.WebhookDelivery.Alias, Columns.WebhookDelivery.Payload, wds.Payload)
+	}
+	if wds.Attempts != nil {
+		wds.where(query, Tables.WebhookDelivery.Alias, Columns.WebhookDelivery.Attempts, wds.Attempts)
+	}
+	if wds.NextAttemptAt != nil {
+		wds.where(query, Tables.WebhookDelivery.Alias, Columns.WebhookDelivery.NextAttemptAt, wds.NextAttemptAt)
+	}
+	if wds.ResponseCode != nil {
+		wds.where(query, Tables.WebhookDelivery.Alias, Columns.WebhookDelivery.ResponseCode, wds.ResponseCode)
+	}
+	if wds.Error != nil {
+		wds.where(query, Tables.WebhookDelivery.Alias, Columns.WebhookDelivery.Error, wds.Error)
+	}
+	if wds.DeliveredAt != nil {
+		wds.where(query, Tables.WebhookDelivery.Alias, Columns.WebhookDelivery.DeliveredAt, wds.DeliveredAt)
+	}
+	if wds.CreatedAt != nil {
+		wds.where(query, Tables.WebhookDelivery.Alias, Columns.WebhookDelivery.CreatedAt, wds.CreatedAt)
+	}
+	if wds.DeliveryStatusID != nil {
+		wds.where(query, Tables.WebhookDelivery.Alias, Columns.WebhookDelivery.DeliveryStatusID, wds.DeliveryStatusID)
+	}
+	if len(wds.IDs) > 0 {
+		Filter{Columns.WebhookDelivery.ID, wds.IDs, SearchTypeArray, false}.Apply(query)
+	}
+	if wds.CreatedAtFrom != nil {
+		Filter{Columns.WebhookDelivery.CreatedAt, *wds.CreatedAtFrom, SearchTypeGE, false}.Apply(query)
+	}
+	if wds.CreatedAtTo != nil {
+		Filter{Columns.WebhookDelivery.CreatedAt, *wds.CreatedAtTo, SearchTypeLE, false}.Apply(query)
+	}
+
+	wds.apply(query)
+
+	return query
+}
+
+func (wds *WebhookDeliverySearch) Q() applier {
+	return func(query *orm.Query) (*orm.Query, error) {
+		if wds == nil {
+			return query, nil
+		}
+		return wds.Apply(query), nil
+	}
+}
+
+type WebhookCursorSearch struct {
+	search
+
+	ID    *string
+	Value *time.Time
+}
+
+func (wcs *WebhookCursorSearch) Apply(query *orm.Query) *orm.Query {
+	if wcs == nil {
+		return query
+	}
+	if wcs.ID != nil {
+		wcs.where(query, Tables.WebhookCursor.Alias, Columns.WebhookCursor.ID, wcs.ID)
+	}
+	if wcs.Value != nil {
+		wcs.where(query, Tables.WebhookCursor.Alias, Columns.WebhookCursor.Value, wcs.Value)
+	}
+
+	wcs.apply(query)
+
+	return query
+}
+
+func (wcs *WebhookCursorSearch) Q() applier {
+	return func(query *orm.Query) (*orm.Query, error) {
+		if wcs == nil {
+			return query, nil
+		}
+		return wcs.Apply(query), nil
+	}
+}
+
+type AuditLogSearch struct {
+	search
+
+	ID            *int
+	UserID        *int
+	IP            *string
+	Namespace     *string
+	Method        *string
+	EntityID      *int
+	Params        *string
+	IsSuccess     *bool
+	ErrorCode     *int
+	Duration      *int
+	CreatedAt     *time.Time
+	IDs           []int
+	CreatedAtFrom *time.Time
+	CreatedAtTo   *time.Time
+}
+
+func (als *AuditLogSearch) Apply(query *orm.Query) *orm.Query {
+	if als == nil {
+		return query
+	}
+	if als.ID != nil {
+		als.where(query, Tables.AuditLog.Alias, Columns.AuditLog.ID, als.ID)
+	}
+	if als.UserID != nil {
+		als.where(query, Tables.AuditLog.Alias, Columns.AuditLog.UserID, als.UserID)
+	}
+	if als.IP != nil {
+		als.where(query, Tables.AuditLog.Alias, Columns.AuditLog.IP, als.IP)
+	}
+	if als.Namespace != nil {
+		als.where(query, Tables.AuditLog.Alias, Columns.AuditLog.Namespace, als.Namespace)
+	}
+	if als.Method != nil {
+		als.where(query, Tables.AuditLog.Alias, Columns.AuditLog.Method, als.Method)
+	}
+	if als.EntityID != nil {
+		als.where(query, Tables.AuditLog.Alias, Columns.AuditLog.EntityID, als.EntityID)
+	}
+	if als.Params != nil {
+		als.where(query, Tables.AuditLog.Alias, Columns.AuditLog.Params, als.Params)
+	}
+	if als.IsSuccess != nil {
+		als.where(query, Tables.AuditLog.Alias, Columns.AuditLog.IsSuccess, als.IsSuccess)
+	}
+	if als.ErrorCode != nil {
+		als.where(query, Tables.AuditLog.Alias, Columns.AuditLog.ErrorCode, als.ErrorCode)
+	}
+	if als.Duration != nil {
+		als.where(query, Tables.AuditLog.Alias, Columns.AuditLog.Duration, als.Duration)
+	}
+	if als.CreatedAt != nil {
+		als.where(query, Tables.AuditLog.Alias, Columns.AuditLog.CreatedAt, als.CreatedAt)
+	}
+	if len(als.IDs) > 0 {
+		Filter{Columns.AuditLog.ID, als.IDs, SearchTypeArray, false}.Apply(query)
+	}
+	if als.CreatedAtFrom != nil {
+		Filter{Columns.AuditLog.CreatedAt, *als.CreatedAtFrom, SearchTypeGE, false}.Apply(query)
+	}
+	if als.CreatedAtTo != nil {
+		Filter{Columns.AuditLog.CreatedAt, *als.CreatedAtTo, SearchTypeLE, false}.Apply(query)
+	}
+
+	als.apply(query)
+
+	return query
+}
+
+func (als *AuditLogSearch) Q() applier {
+	return func(query *orm.Query) (*orm.Query, error) {
+		if als == nil {
+			return query, nil
+		}
+		return als.Apply(query), nil
+	}
+}
+
+type TrashItemSearch struct {
+	search
+
+	ID           *int
+	ObjectType   *string
+	ObjectID     *int
+	PrevStatusID *int
+	DeletedAt    *time.Time
+	IDs          []int
+	IDFrom       *int
+	DeletedAtTo  *time.Time
+}
+
+func (tis *TrashItemSearch) Apply(query *orm.Query) *orm.Query {
+	if tis == nil {
+		return query
+	}
+	if tis.ID != nil {
+		tis.where(query, Tables.TrashItem.Alias, Columns.TrashItem.ID, tis.ID)
+	}
+	if tis.ObjectType != nil {
+		tis.where(query, Tables.TrashItem.Alias, Columns.TrashItem.ObjectType, tis.ObjectType)
+	}
+	if tis.ObjectID != nil {
+		tis.where(query, Tables.TrashItem.Alias, Columns.TrashItem.ObjectID, tis.ObjectID)
+	}
+	if tis.PrevStatusID != nil {
+		tis.where(query, Tables.TrashItem.Alias, Columns.TrashItem.PrevStatusID, tis.PrevStatusID)
+	}
+	if tis.DeletedAt != nil {
+		tis.where(query, Tables.TrashItem.Alias, Columns.TrashItem.DeletedAt, tis.DeletedAt)
+	}
+	if len(tis.IDs) > 0 {
+		Filter{Columns.TrashItem.ID, tis.IDs, SearchTypeArray, false}.Apply(query)
+	}
+	if tis.IDFrom != nil {
+		Filter{Columns.TrashItem.ID, *tis.IDFrom, SearchTypeGE, false}.Apply(query)
+	}
+	if tis.DeletedAtTo != nil {
+		Filter{Columns.TrashItem.DeletedAt, *tis.DeletedAtTo, SearchTypeLE, false}.Apply(query)
+	}
+
+	tis.apply(query)
+
+	return query
+}
+
+func (tis *TrashItemSearch) Q() applier {
+	return func(query *orm.Query) (*orm.Query, error) {
+		if tis == nil {
+			return query, nil
+		}
+		return tis.Apply(query), nil
+	}
+}
@@ -14,6 +14,16 @@ const (
 	ErrWrongValue = "value"
 )
 
+func (r Role) Validate() (errors map[string]string, valid bool) {
+	errors = map[string]string{}
+
+	if utf8.RuneCountInString(r.Title) > 255 {
+		errors[Columns.Role.Title] = ErrMaxLength
+	}
+
+	return errors, len(errors) == 0
+}
+
 func (u User) Validate() (errors map[string]string, valid bool) {
 	errors = map[string]string{}
 
@@ -25,8 +35,74 @@ func (u User) Validate() (errors map[string]string, valid bool) {
 		errors[Columns.User.Password] = ErrMaxLength
 	}
 
-	if utf8.RuneCountInString(u.AuthKey) > 32 {
-		errors[Columns.User.AuthKey] = ErrMaxLength
+	if u.TOTPSecret != nil && utf8.RuneCountInString(*u.TOTPSecret) > 64 {
+		errors[Columns.User.TOTPSecret] = ErrMaxLength
+	}
+
+	return errors, len(errors) == 0
+}
+
+func (s Session) Validate() (errors map[string]string, valid bool) {
+	errors = map[string]string{}
+
+	if utf8.RuneCountInString(s.TokenHash) > 64 {
+		errors[Columns.Session.TokenHash] = ErrMaxLength
+	}
+
+	if utf8.RuneCountInString(s.IP) > 64 {
+		errors[Columns.Session.IP] = ErrMaxLength
+	}
+
+	if utf8.RuneCountInString(s.UserAgent) > 512 {
+		errors[Columns.Session.UserAgent] = ErrMaxLength
+	}
+
+	if utf8.RuneCountInString(s.Device) > 64 {
+		errors[Columns.Session.Device] = ErrMaxLength
+	}
+
+	return errors, len(errors) == 0
+}
+
+func (la LoginAttempt) Validate() (errors map[string]string, valid bool) {
+	errors = map[string]string{}
+
+	if utf8.RuneCountInString(la.Login) > 64 {
+		errors[Columns.LoginAttempt.Login] = ErrMaxLength
+	}
+
+	if utf8.RuneCountInString(la.IP) > 64 {
+		errors[Columns.LoginAttempt.IP] = ErrMaxLength
+	}
+
+	if utf8.RuneCountInString(la.UserAgent) > 512 {
+		errors[Columns.LoginAttempt.UserAgent] = ErrMaxLength
+	}
+
+	if la.Reason != nil && utf8.RuneCountInString(*la.Reason) > 32 {
+		errors[Columns.LoginAttempt.Reason] = ErrMaxLength
+	}
+
+	return errors, len(errors) == 0
+}
+
+func (a APIKey) Validate() (errors map[string]string, valid bool) {
+	errors = map[string]string{}
+
+	if utf8.RuneCountInString(a.Title) > 255 {
+		errors[Columns.APIKey.Title] = ErrMaxLength
+	}
+
+	if utf8.RuneCountInString(a.Prefix) > 16 {
+		errors[Columns.APIKey.Prefix] = ErrMaxLength
+	}
+
+	if utf8.RuneCountInString(a.TokenHash) > 64 {
+		errors[Columns.APIKey.TokenHash] = ErrMaxLength
+	}
+
+	if a.LastUsedIP != nil && utf8.RuneCountInString(*a.LastUsedIP) > 64 {
+		errors[Columns.APIKey.LastUsedIP] = ErrMaxLength
 	}
 
 	return errors, len(errors) == 0
@@ -67,6 +143,10 @@ func (c Category) Validate() (errors map[string]string, valid bool) {
 		errors[Columns.Category.Title] = ErrMaxLength
 	}
 
+	if utf8.RuneCountInString(c.Slug) > 255 {
+		errors[Columns.Category.Slug] = ErrMaxLength
+	}
+
 	return errors, len(errors) == 0
 }
 
@@ -77,6 +157,10 @@ func (n News) Validate() (errors map[string]string, valid bool) {
 		errors[Columns.News.Title] = ErrMaxLength
 	}
 
+	if utf8.RuneCountInString(n.Slug) > 255 {
+		errors[Columns.News.Slug] = ErrMaxLength
+	}
+
 	if utf8.RuneCountInString(n.ShortText) > 1024 {
 		errors[Columns.News.ShortText] = ErrMaxLength
 	}
@@ -88,6 +172,74 @@ func (n News) Validate() (errors map[string]string, valid bool) {
 	return errors, len(errors) == 0
 }
 
+func (nr NewsRevision) Validate() (errors map[string]string, valid bool) {
+	errors = map[string]string{}
+
+	if utf8.RuneCountInString(nr.Action) > 16 {
+		errors[Columns.NewsRevision.Action] = ErrMaxLength
+	}
+
+	if utf8.RuneCountInString(nr.Title) > 255 {
+		errors[Columns.NewsRevision.Title] = ErrMaxLength
+	}
+
+	if utf8.RuneCountInString(nr.Slug) > 255 {
+		errors[Columns.NewsRevision.Slug] = ErrMaxLength
+	}
+
+	if utf8.RuneCountInString(nr.ShortText) > 1024 {
+		errors[Columns.NewsRevision.ShortText] = ErrMaxLength
+	}
+
+	if nr.Author != nil && utf8.RuneCountInString(*nr.Author) > 255 {
+		errors[Columns.NewsRevision.Author] = ErrMaxLength
+	}
+
+	return errors, len(errors) == 0
+}
+
+func (ns NewsSlug) Validate() (errors map[string]string, valid bool) {
+	errors = map[string]string{}
+
+	if utf8.RuneCountInString(ns.Slug) > 255 {
+		errors[Columns.NewsSlug.Slug] = ErrMaxLength
+	}
+
+	return errors, len(errors) == 0
+}
+
+func (s Suggestion) Validate() (errors map[string]string, valid bool) {
+	errors = map[string]string{}
+
+	if utf8.RuneCountInString(s.Title) > 255 {
+		errors[Columns.Suggestion.Title] = ErrMaxLength
+	}
+
+	if utf8.RuneCountInString(s.ShortText) > 255 {
+		errors[Columns.Suggestion.ShortText] = ErrMaxLength
+	}
+
+	if utf8.RuneCountInString(s.IP) > 64 {
+		errors[Columns.Suggestion.IP] = ErrMaxLength
+	}
+
+	if s.Contact != nil && utf8.RuneCountInString(*s.Contact) > 255 {
+		errors[Columns.Suggestion.Contact] = ErrMaxLength
+	}
+
+	return errors, len(errors) == 0
+}
+
+func (sd SuggestionDecision) Validate() (errors map[string]string, valid bool) {
+	errors = map[string]string{}
+
+	if sd.Reason != nil && utf8.RuneCountInString(*sd.Reason) > 1024 {
+		errors[Columns.SuggestionDecision.Reason] = ErrMaxLength
+	}
+
+	return errors, len(errors) == 0
+}
+
 func (t Tag) Validate() (errors map[string]string, valid bool) {
 	errors = map[string]string{}
 
@@ -97,3 +249,67 @@ func (t Tag) Validate() (errors map[string]string, valid bool) {
 
 	return errors, len(errors) == 0
 }
+
+func (w Webhook) Validate() (errors map[string]string, valid bool) {
+	errors = map[string]string{}
+
+	if utf8.RuneCountInString(w.Title) > 255 {
+		errors[Columns.Webhook.Title] = ErrMaxLength
+	}
+
+	if utf8.RuneCountInString(w.URL) > 1024 {
+		errors[Columns.Webhook.URL] = ErrMaxLength
+	}
+
+	if utf8.RuneCountInString(w.Secret) > 64 {
+		errors[Columns.Webhook.Secret] = ErrMaxLength
+	}
+
+	return errors, len(errors) == 0
+}
+
+func (wd WebhookDelivery) Validate() (errors map[string]string, valid bool) {
+	errors = map[string]string{}
+
+	if utf8.RuneCountInString(wd.Event) > 64 {
+		errors[Columns.WebhookDelivery.Event] = ErrMaxLength
+	}
+
+	if wd.Key != nil && utf8.RuneCountInString(*wd.Key) > 255 {
+		errors[Columns.WebhookDelivery.Key] = ErrMaxLength
+	}
+
+	if wd.Error != nil && utf8.RuneCountInString(*wd.Error) > 1024 {
+		errors[Columns.WebhookDelivery.Error] = ErrMaxLength
+	}
+
+	return errors, len(errors) == 0
+}
+
+func (al AuditLog) Validate() (errors map[string]string, valid bool) {
+	errors = map[string]string{}
+
+	if utf8.RuneCountInString(al.IP) > 64 {
+		errors[Columns.AuditLog.IP] = ErrMaxLength
+	}
+
+	if utf8.RuneCountInString(al.Namespace) > 64 {
+		errors[Columns.AuditLog.Namespace] = ErrMaxLength
+	}
+
+	if utf8.RuneCountInString(al.Method) > 64 {
+		errors[Columns.AuditLog.Method] = ErrMaxLength
+	}
+
+	return errors, len(errors) == 0
+}
+
+func (ti TrashItem) Validate() (errors map[string]string, valid bool) {
+	errors = map[string]string{}
+
+	if utf8.RuneCountInString(ti.ObjectType) > 32 {
+		errors[Columns.TrashItem.ObjectType] = ErrMaxLength
+	}
+
+	return errors, len(errors) == 0
+}
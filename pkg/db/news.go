@@ -25,14 +25,22 @@ func NewNewsRepo(db orm.DB) NewsRepo {
 			Tables.Tag.Name:      {StatusFilter},
 		},
 		sort: map[string][]SortField{
-			Tables.Category.Name: {{Column: Columns.Category.Title, Direction: SortAsc}},
-			Tables.News.Name:     {{Column: Columns.News.CreatedAt, Direction: SortDesc}},
-			Tables.Tag.Name:      {{Column: Columns.Tag.ID, Direction: SortDesc}},
+			Tables.Category.Name:           {{Column: Columns.Category.Title, Direction: SortAsc}},
+			Tables.News.Name:               {{Column: Columns.News.CreatedAt, Direction: SortDesc}},
+			Tables.NewsRevision.Name:       {{Column: Columns.NewsRevision.CreatedAt, Direction: SortDesc}},
+			Tables.NewsSlug.Name:           {{Column: Columns.NewsSlug.CreatedAt, Direction: SortDesc}},
+			Tables.Suggestion.Name:         {{Column: Columns.Suggestion.CreatedAt, Direction: SortDesc}},
+			Tables.SuggestionDecision.Name: {{Column: Columns.SuggestionDecision.CreatedAt, Direction: SortDesc}},
+			Tables.Tag.Name:                {{Column: Columns.Tag.ID, Direction: SortDesc}},
 		},
 		join: map[string][]string{
-			Tables.Category.Name: {TableColumns},
-			Tables.News.Name:     {TableColumns, Columns.News.Category},
-			Tables.Tag.Name:      {TableColumns},
+			Tables.Category.Name:           {TableColumns},
+			Tables.News.Name:               {TableColumns, Columns.News.Category},
+			Tables.NewsRevision.Name:       {TableColumns, Columns.NewsRevision.News, Columns.NewsRevision.User},
+			Tables.NewsSlug.Name:           {TableColumns, Columns.NewsSlug.News},
+			Tables.Suggestion.Name:         {TableColumns, Columns.Suggestion.Category, Columns.Suggestion.News},
+			Tables.SuggestionDecision.Name: {TableColumns, Columns.SuggestionDecision.Suggestion, Columns.SuggestionDecision.User, Columns.SuggestionDecision.News},
+			Tables.Tag.Name:                {TableColumns},
 		},
 	}
 }
@@ -175,7 +183,7 @@ func (nr NewsRepo) CountNews(ctx context.Context, search *NewsSearch, ops ...OpF
 func (nr NewsRepo) AddNews(ctx context.Context, news *News, ops ...OpFunc) (*News, error) {
 	q := nr.db.ModelContext(ctx, news)
 	if len(ops) == 0 {
-		q = q.ExcludeColumn(Columns.News.CreatedAt)
+		q = q.ExcludeColumn(Columns.News.CreatedAt, Columns.News.UpdatedAt)
 	}
 	applyOps(q, ops...)
 	_, err := q.Insert()
@@ -187,7 +195,7 @@ func (nr NewsRepo) AddNews(ctx context.Context, news *News, ops ...OpFunc) (*New
 func (nr NewsRepo) UpdateNews(ctx context.Context, news *News, ops ...OpFunc) (bool, error) {
 	q := nr.db.ModelContext(ctx, news).WherePK()
 	if len(ops) == 0 {
-		q = q.ExcludeColumn(Columns.News.ID, Columns.News.CreatedAt)
+		q = q.ExcludeColumn(Columns.News.ID, Columns.News.CreatedAt, Columns.News.UpdatedAt)
 	}
 	applyOps(q, ops...)
 	res, err := q.Update()
@@ -205,6 +213,330 @@ func (nr NewsRepo) DeleteNews(ctx context.Context, id int) (deleted bool, err er
 	return nr.UpdateNews(ctx, news, WithColumns(Columns.News.StatusID))
 }
 
+/*** NewsRevision ***/
+
+// FullNewsRevision returns full joins with all columns
+func (nr NewsRepo) FullNewsRevision() OpFunc {
+	return WithColumns(nr.join[Tables.NewsRevision.Name]...)
+}
+
+// DefaultNewsRevisionSort returns default sort.
+func (nr NewsRepo) DefaultNewsRevisionSort() OpFunc {
+	return WithSort(nr.sort[Tables.NewsRevision.Name]...)
+}
+
+// NewsRevisionByID is a function that returns NewsRevision by ID(s) or nil.
+func (nr NewsRepo) NewsRevisionByID(ctx context.Context, id int, ops ...OpFunc) (*NewsRevision, error) {
+	return nr.OneNewsRevision(ctx, &NewsRevisionSearch{ID: &id}, ops...)
+}
+
+// OneNewsRevision is a function that returns one NewsRevision by filters. It could return pg.ErrMultiRows.
+func (nr NewsRepo) OneNewsRevision(ctx context.Context, search *NewsRevisionSearch, ops ...OpFunc) (*NewsRevision, error) {
+	obj := &NewsRevision{}
+	err := buildQuery(ctx, nr.db, obj, search, nr.filters[Tables.NewsRevision.Name], PagerTwo, ops...).Select()
+
+	if errors.Is(err, pg.ErrMultiRows) {
+		return nil, err
+	} else if errors.Is(err, pg.ErrNoRows) {
+		return nil, nil
+	}
+
+	return obj, err
+}
+
+// NewsRevisionsByFilters returns NewsRevision list.
+func (nr NewsRepo) NewsRevisionsByFilters(ctx context.Context, search *NewsRevisionSearch, pager Pager, ops ...OpFunc) (newsRevisions []NewsRevision, err error) {
+	err = buildQuery(ctx, nr.db, &newsRevisions, search, nr.filters[Tables.NewsRevision.Name], pager, ops...).Select()
+	return
+}
+
+// CountNewsRevisions returns count
+func (nr NewsRepo) CountNewsRevisions(ctx context.Context, search *NewsRevisionSearch, ops ...OpFunc) (int, error) {
+	return buildQuery(ctx, nr.db, &NewsRevision{}, search, nr.filters[Tables.NewsRevision.Name], PagerOne, ops...).Count()
+}
+
+// AddNewsRevision adds NewsRevision to DB.
+func (nr NewsRepo) AddNewsRevision(ctx context.Context, newsRevision *NewsRevision, ops ...OpFunc) (*NewsRevision, error) {
+	q := nr.db.ModelContext(ctx, newsRevision)
+	if len(ops) == 0 {
+		q = q.ExcludeColumn(Columns.NewsRevision.CreatedAt)
+	}
+	applyOps(q, ops...)
+	_, err := q.Insert()
+
+	return newsRevision, err
+}
+
+// UpdateNewsRevision updates NewsRevision in DB.
+func (nr NewsRepo) UpdateNewsRevision(ctx context.Context, newsRevision *NewsRevision, ops ...OpFunc) (bool, error) {
+	q := nr.db.ModelContext(ctx, newsRevision).WherePK()
+	if len(ops) == 0 {
+		q = q.ExcludeColumn(Columns.NewsRevision.ID, Columns.NewsRevision.NewsID, Columns.NewsRevision.UserID, Columns.NewsRevision.Action, Columns.NewsRevision.Title, Columns.NewsRevision.Slug, Columns.NewsRevision.ShortText, Columns.NewsRevision.Content, Columns.NewsRevision.Author, Columns.NewsRevision.CategoryID, Columns.NewsRevision.TagIDs, Columns.NewsRevision.PublishedAt, Columns.NewsRevision.NewsStatusID, Columns.NewsRevision.CreatedAt)
+	}
+	applyOps(q, ops...)
+	res, err := q.Update()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, err
+}
+
+// DeleteNewsRevision deletes NewsRevision from DB.
+func (nr NewsRepo) DeleteNewsRevision(ctx context.Context, id int) (deleted bool, err error) {
+	newsRevision := &NewsRevision{ID: id}
+
+	res, err := nr.db.ModelContext(ctx, newsRevision).WherePK().Delete()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, err
+}
+
+/*** NewsSlug ***/
+
+// FullNewsSlug returns full joins with all columns
+func (nr NewsRepo) FullNewsSlug() OpFunc {
+	return WithColumns(nr.join[Tables.NewsSlug.Name]...)
+}
+
+// DefaultNewsSlugSort returns default sort.
+func (nr NewsRepo) DefaultNewsSlugSort() OpFunc {
+	return WithSort(nr.sort[Tables.NewsSlug.Name]...)
+}
+
+// NewsSlugByID is a function that returns NewsSlug by ID(s) or nil.
+func (nr NewsRepo) NewsSlugByID(ctx context.Context, id int, ops ...OpFunc) (*NewsSlug, error) {
+	return nr.OneNewsSlug(ctx, &NewsSlugSearch{ID: &id}, ops...)
+}
+
+// OneNewsSlug is a function that returns one NewsSlug by filters. It could return pg.ErrMultiRows.
+func (nr NewsRepo) OneNewsSlug(ctx context.Context, search *NewsSlugSearch, ops ...OpFunc) (*NewsSlug, error) {
+	obj := &NewsSlug{}
+	err := buildQuery(ctx, nr.db, obj, search, nr.filters[Tables.NewsSlug.Name], PagerTwo, ops...).Select()
+
+	if errors.Is(err, pg.ErrMultiRows) {
+		return nil, err
+	} else if errors.Is(err, pg.ErrNoRows) {
+		return nil, nil
+	}
+
+	return obj, err
+}
+
+// NewsSlugsByFilters returns NewsSlug list.
+func (nr NewsRepo) NewsSlugsByFilters(ctx context.Context, search *NewsSlugSearch, pager Pager, ops ...OpFunc) (newsSlugs []NewsSlug, err error) {
+	err = buildQuery(ctx, nr.db, &newsSlugs, search, nr.filters[Tables.NewsSlug.Name], pager, ops...).Select()
+	return
+}
+
+// CountNewsSlugs returns count
+func (nr NewsRepo) CountNewsSlugs(ctx context.Context, search *NewsSlugSearch, ops ...OpFunc) (int, error) {
+	return buildQuery(ctx, nr.db, &NewsSlug{}, search, nr.filters[Tables.NewsSlug.Name], PagerOne, ops...).Count()
+}
+
+// AddNewsSlug adds NewsSlug to DB.
+func (nr NewsRepo) AddNewsSlug(ctx context.Context, newsSlug *NewsSlug, ops ...OpFunc) (*NewsSlug, error) {
+	q := nr.db.ModelContext(ctx, newsSlug)
+	if len(ops) == 0 {
+		q = q.ExcludeColumn(Columns.NewsSlug.CreatedAt)
+	}
+	applyOps(q, ops...)
+	_, err := q.Insert()
+
+	return newsSlug, err
+}
+
+// UpdateNewsSlug updates NewsSlug in DB.
+func (nr NewsRepo) UpdateNewsSlug(ctx context.Context, newsSlug *NewsSlug, ops ...OpFunc) (bool, error) {
+	q := nr.db.ModelContext(ctx, newsSlug).WherePK()
+	if len(ops) == 0 {
+		q = q.ExcludeColumn(Columns.NewsSlug.ID, Columns.NewsSlug.CreatedAt)
+	}
+	applyOps(q, ops...)
+	res, err := q.Update()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, err
+}
+
+// DeleteNewsSlug deletes NewsSlug from DB.
+func (nr NewsRepo) DeleteNewsSlug(ctx context.Context, id int) (deleted bool, err error) {
+	newsSlug := &NewsSlug{ID: id}
+
+	res, err := nr.db.ModelContext(ctx, newsSlug).WherePK().Delete()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, err
+}
+
+/*** Suggestion ***/
+
+// FullSuggestion returns full joins with all columns
+func (nr NewsRepo) FullSuggestion() OpFunc {
+	return WithColumns(nr.join[Tables.Suggestion.Name]...)
+}
+
+// DefaultSuggestionSort returns default sort.
+func (nr NewsRepo) DefaultSuggestionSort() OpFunc {
+	return WithSort(nr.sort[Tables.Suggestion.Name]...)
+}
+
+// SuggestionByID is a function that returns Suggestion by ID(s) or nil.
+func (nr NewsRepo) SuggestionByID(ctx context.Context, id int, ops ...OpFunc) (*Suggestion, error) {
+	return nr.OneSuggestion(ctx, &SuggestionSearch{ID: &id}, ops...)
+}
+
+// OneSuggestion is a function that returns one Suggestion by filters. It could return pg.ErrMultiRows.
+func (nr NewsRepo) OneSuggestion(ctx context.Context, search *SuggestionSearch, ops ...OpFunc) (*Suggestion, error) {
+	obj := &Suggestion{}
+	err := buildQuery(ctx, nr.db, obj, search, nr.filters[Tables.Suggestion.Name], PagerTwo, ops...).Select()
+
+	if errors.Is(err, pg.ErrMultiRows) {
+		return nil, err
+	} else if errors.Is(err, pg.ErrNoRows) {
+		return nil, nil
+	}
+
+	return obj, err
+}
+
+// SuggestionsByFilters returns Suggestion list.
+func (nr NewsRepo) SuggestionsByFilters(ctx context.Context, search *SuggestionSearch, pager Pager, ops ...OpFunc) (suggestions []Suggestion, err error) {
+	err = buildQuery(ctx, nr.db, &suggestions, search, nr.filters[Tables.Suggestion.Name], pager, ops...).Select()
+	return
+}
+
+// CountSuggestions returns count
+func (nr NewsRepo) CountSuggestions(ctx context.Context, search *SuggestionSearch, ops ...OpFunc) (int, error) {
+	return buildQuery(ctx, nr.db, &Suggestion{}, search, nr.filters[Tables.Suggestion.Name], PagerOne, ops...).Count()
+}
+
+// AddSuggestion adds Suggestion to DB.
+func (nr NewsRepo) AddSuggestion(ctx context.Context, suggestion *Suggestion, ops ...OpFunc) (*Suggestion, error) {
+	q := nr.db.ModelContext(ctx, suggestion)
+	if len(ops) == 0 {
+		q = q.ExcludeColumn(Columns.Suggestion.CreatedAt)
+	}
+	applyOps(q, ops...)
+	_, err := q.Insert()
+
+	return suggestion, err
+}
+
+// UpdateSuggestion updates Suggestion in DB.
+func (nr NewsRepo) UpdateSuggestion(ctx context.Context, suggestion *Suggestion, ops ...OpFunc) (bool, error) {
+	q := nr.db.ModelContext(ctx, suggestion).WherePK()
+	if len(ops) == 0 {
+		q = q.ExcludeColumn(Columns.Suggestion.ID, Columns.Suggestion.IP, Columns.Suggestion.Contact, Columns.Suggestion.CreatedAt)
+	}
+	applyOps(q, ops...)
+	res, err := q.Update()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, err
+}
+
+// DeleteSuggestion deletes Suggestion from DB.
+func (nr NewsRepo) DeleteSuggestion(ctx context.Context, id int) (deleted bool, err error) {
+	suggestion := &Suggestion{ID: id}
+
+	res, err := nr.db.ModelContext(ctx, suggestion).WherePK().Delete()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, err
+}
+
+/*** SuggestionDecision ***/
+
+// FullSuggestionDecision returns full joins with all columns
+func (nr NewsRepo) FullSuggestionDecision() OpFunc {
+	return WithColumns(nr.join[Tables.SuggestionDecision.Name]...)
+}
+
+// DefaultSuggestionDecisionSort returns default sort.
+func (nr NewsRepo) DefaultSuggestionDecisionSort() OpFunc {
+	return WithSort(nr.sort[Tables.SuggestionDecision.Name]...)
+}
+
+// SuggestionDecisionByID is a function that returns SuggestionDecision by ID(s) or nil.
+func (nr NewsRepo) SuggestionDecisionByID(ctx context.Context, id int, ops ...OpFunc) (*SuggestionDecision, error) {
+	return nr.OneSuggestionDecision(ctx, &SuggestionDecisionSearch{ID: &id}, ops...)
+}
+
+// OneSuggestionDecision is a function that returns one SuggestionDecision by filters. It could return pg.ErrMultiRows.
+func (nr NewsRepo) OneSuggestionDecision(ctx context.Context, search *SuggestionDecisionSearch, ops ...OpFunc) (*SuggestionDecision, error) {
+	obj := &SuggestionDecision{}
+	err := buildQuery(ctx, nr.db, obj, search, nr.filters[Tables.SuggestionDecision.Name], PagerTwo, ops...).Select()
+
+	if errors.Is(err, pg.ErrMultiRows) {
+		return nil, err
+	} else if errors.Is(err, pg.ErrNoRows) {
+		return nil, nil
+	}
+
+	return obj, err
+}
+
+// SuggestionDecisionsByFilters returns SuggestionDecision list.
+func (nr NewsRepo) SuggestionDecisionsByFilters(ctx context.Context, search *SuggestionDecisionSearch, pager Pager, ops ...OpFunc) (suggestionDecisions []SuggestionDecision, err error) {
+	err = buildQuery(ctx, nr.db, &suggestionDecisions, search, nr.filters[Tables.SuggestionDecision.Name], pager, ops...).Select()
+	return
+}
+
+// CountSuggestionDecisions returns count
+func (nr NewsRepo) CountSuggestionDecisions(ctx context.Context, search *SuggestionDecisionSearch, ops ...OpFunc) (int, error) {
+	return buildQuery(ctx, nr.db, &SuggestionDecision{}, search, nr.filters[Tables.SuggestionDecision.Name], PagerOne, ops...).Count()
+}
+
+// AddSuggestionDecision adds SuggestionDecision to DB.
+func (nr NewsRepo) AddSuggestionDecision(ctx context.Context, suggestionDecision *SuggestionDecision, ops ...OpFunc) (*SuggestionDecision, error) {
+	q := nr.db.ModelContext(ctx, suggestionDecision)
+	if len(ops) == 0 {
+		q = q.ExcludeColumn(Columns.SuggestionDecision.CreatedAt)
+	}
+	applyOps(q, ops...)
+	_, err := q.Insert()
+
+	return suggestionDecision, err
+}
+
+// UpdateSuggestionDecision updates SuggestionDecision in DB.
+func (nr NewsRepo) UpdateSuggestionDecision(ctx context.Context, suggestionDecision *SuggestionDecision, ops ...OpFunc) (bool, error) {
+	q := nr.db.ModelContext(ctx, suggestionDecision).WherePK()
+	if len(ops) == 0 {
+		q = q.ExcludeColumn(Columns.SuggestionDecision.ID, Columns.SuggestionDecision.SuggestionID, Columns.SuggestionDecision.UserID, Columns.SuggestionDecision.ModerationStatusID, Columns.SuggestionDecision.Reason, Columns.SuggestionDecision.NewsID, Columns.SuggestionDecision.CreatedAt)
+	}
+	applyOps(q, ops...)
+	res, err := q.Update()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, err
+}
+
+// DeleteSuggestionDecision deletes SuggestionDecision from DB.
+func (nr NewsRepo) DeleteSuggestionDecision(ctx context.Context, id int) (deleted bool, err error) {
+	suggestionDecision := &SuggestionDecision{ID: id}
+
+	res, err := nr.db.ModelContext(ctx, suggestionDecision).WherePK().Delete()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, err
+}
+
 /*** Tag ***/
 
 // FullTag returns full joins with all columns
@@ -1 +1,472 @@
 package db
+
+import (
+	"context"
+	"time"
+
+	"apisrv/pkg/slug"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+)
+
+// NewsKeyset is a position in the news list ordered by publishedAt and newsId.
+type NewsKeyset struct {
+	PublishedAt time.Time
+	ID          int
+}
+
+// WithNewsKeyset adds keyset condition to query: news older than keyset or, if backward is set, newer than keyset.
+func WithNewsKeyset(keyset NewsKeyset, backward bool) OpFunc {
+	cond := `(?.?, ?.?) < (?, ?)`
+	if backward {
+		cond = `(?.?, ?.?) > (?, ?)`
+	}
+
+	return func(query *orm.Query) {
+		query.Where(cond,
+			pg.Ident(Tables.News.Alias), pg.Ident(Columns.News.PublishedAt),
+			pg.Ident(Tables.News.Alias), pg.Ident(Columns.News.ID),
+			keyset.PublishedAt, keyset.ID,
+		)
+	}
+}
+
+// WithNewsKeysetSort adds publishedAt, newsId sorting to query. Newest first, or oldest first if backward is set.
+func WithNewsKeysetSort(backward bool) OpFunc {
+	d := SortDesc
+	if backward {
+		d = SortAsc
+	}
+
+	return WithSort(
+		SortField{Column: Columns.News.PublishedAt, Direction: d},
+		SortField{Column: Columns.News.ID, Direction: d},
+	)
+}
+
+const (
+	// TextSearchConfig is a postgres text search configuration for news full-text search.
+	// It stems cyrillic words with russian and ascii words with english snowball stemmer.
+	TextSearchConfig = "russian"
+
+	// columnNewsSearchVector is a generated tsvector column, it is not a part of News model.
+	columnNewsSearchVector = "searchVector"
+
+	newsHeadlineOptions = "StartSel=<b>, StopSel=</b>, MaxWords=35, MinWords=15, MaxFragments=2"
+)
+
+// WithQuery adds full-text search condition over news title, shortText and content.
+func (ns *NewsSearch) WithQuery(query string) *NewsSearch {
+	if query == "" {
+		return ns
+	}
+
+	ns.With(`?.? @@ websearch_to_tsquery(?, ?)`, pg.Ident(Tables.News.Alias), pg.Ident(columnNewsSearchVector), TextSearchConfig, query)
+
+	return ns
+}
+
+// WithQueryRankSort adds sorting by full-text search rank to query.
+func WithQueryRankSort(query string) OpFunc {
+	return func(q *orm.Query) {
+		q.OrderExpr(`ts_rank_cd(?.?, websearch_to_tsquery(?, ?)) DESC`, pg.Ident(Tables.News.Alias), pg.Ident(columnNewsSearchVector), TextSearchConfig, query)
+	}
+}
+
+// NewsHeadlines returns highlighted fragments of shortText and content matched by full-text query, indexed by news id.
+func (nr NewsRepo) NewsHeadlines(ctx context.Context, ids []int, query string) (map[int]string, error) {
+	if len(ids) == 0 || query == "" {
+		return map[int]string{}, nil
+	}
+
+	var rows []struct {
+		ID       int    `pg:"newsId"`
+		Headline string `pg:"headline"`
+	}
+
+	_, err := nr.db.QueryContext(ctx, &rows, `
+		SELECT ?, ts_headline(?, concat_ws(' ', ?, ?), websearch_to_tsquery(?, ?), ?) AS "headline"
+		FROM ?
+		WHERE ? IN (?)`,
+		pg.Ident(Columns.News.ID), TextSearchConfig, pg.Ident(Columns.News.ShortText), pg.Ident(Columns.News.Content), TextSearchConfig, query, newsHeadlineOptions,
+		pg.Ident(Tables.News.Name),
+		pg.Ident(Columns.News.ID), pg.In(ids),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[int]string, len(rows))
+	for _, r := range rows {
+		res[r.ID] = r.Headline
+	}
+
+	return res, nil
+}
+
+// WithRelatedTo adds condition for news related to the given one: other news with at least one common tag or from the same category.
+func (ns *NewsSearch) WithRelatedTo(id, categoryID int, tagIDs []int) *NewsSearch {
+	ns.With(`?.? <> ? AND (?.? && ?::int4[] OR ?.? = ?)`,
+		pg.Ident(Tables.News.Alias), pg.Ident(Columns.News.ID), id,
+		pg.Ident(Tables.News.Alias), pg.Ident(Columns.News.TagIDs), pg.Array(tagIDs),
+		pg.Ident(Tables.News.Alias), pg.Ident(Columns.News.CategoryID), categoryID,
+	)
+
+	return ns
+}
+
+// WithRelatedSort adds sorting by number of common tags, then same category first, then newest first.
+func WithRelatedSort(categoryID int, tagIDs []int) OpFunc {
+	return func(q *orm.Query) {
+		q.OrderExpr(`cardinality(ARRAY(SELECT unnest(?.?) INTERSECT SELECT unnest(?::int4[]))) DESC`,
+			pg.Ident(Tables.News.Alias), pg.Ident(Columns.News.TagIDs), pg.Array(tagIDs),
+		)
+		q.OrderExpr(`?.? = ? DESC`, pg.Ident(Tables.News.Alias), pg.Ident(Columns.News.CategoryID), categoryID)
+		WithNewsKeysetSort(false)(q)
+	}
+}
+
+// NewsArchivePeriod is a date_trunc precision for news archive.
+type NewsArchivePeriod string
+
+const (
+	NewsArchiveMonth NewsArchivePeriod = "month"
+	NewsArchiveDay   NewsArchivePeriod = "day"
+)
+
+// NewsArchiveItem is a number of news published within a period, which starts at Period (UTC).
+type NewsArchiveItem struct {
+	Period time.Time `pg:"period"`
+	Count  int       `pg:"count"`
+}
+
+// NewsArchive returns news counts grouped by publishedAt truncated to period, oldest period first.
+func (nr NewsRepo) NewsArchive(ctx context.Context, search *NewsSearch, period NewsArchivePeriod, ops ...OpFunc) ([]NewsArchiveItem, error) {
+	var res []NewsArchiveItem
+
+	err := buildQuery(ctx, nr.db, (*News)(nil), search, nr.filters[Tables.News.Name], PagerNoLimit, ops...).
+		ColumnExpr(`date_trunc(?, ?.? AT TIME ZONE 'UTC') AS "period"`, string(period), pg.Ident(Tables.News.Alias), pg.Ident(Columns.News.PublishedAt)).
+		ColumnExpr(`count(*) AS "count"`).
+		GroupExpr(`"period"`).
+		OrderExpr(`"period"`).
+		Select(&res)
+
+	return res, err
+}
+
+// NewsCount is a number of news by tag or category id.
+type NewsCount struct {
+	ID    int `pg:"id"`
+	Count int `pg:"count"`
+}
+
+// NewsTagCounts returns numbers of news by enabled tags, most used first. Tags with less than minCount news are skipped.
+func (nr NewsRepo) NewsTagCounts(ctx context.Context, search *NewsSearch, minCount int, pager Pager, ops ...OpFunc) ([]NewsCount, error) {
+	var res []NewsCount
+
+	err := buildQuery(ctx, nr.db, (*News)(nil), search, nr.filters[Tables.News.Name], pager, ops...).
+		Join(`CROSS JOIN LATERAL unnest(?.?) AS "tag"("id")`, pg.Ident(Tables.News.Alias), pg.Ident(Columns.News.TagIDs)).
+		Where(`"tag"."id" IN (SELECT ? FROM ? WHERE ? = ?)`, pg.Ident(Columns.Tag.ID), pg.Ident(Tables.Tag.Name), pg.Ident(Columns.Tag.StatusID), StatusEnabled).
+		ColumnExpr(`"tag"."id"`).
+		ColumnExpr(`count(*) AS "count"`).
+		GroupExpr(`"tag"."id"`).
+		Having(`count(*) >= ?`, minCount).
+		OrderExpr(`"count" DESC, "tag"."id"`).
+		Select(&res)
+
+	return res, err
+}
+
+// NewsCategoryCounts returns numbers of news by enabled categories, most used first. Categories with less than minCount news are skipped.
+func (nr NewsRepo) NewsCategoryCounts(ctx context.Context, search *NewsSearch, minCount int, ops ...OpFunc) ([]NewsCount, error) {
+	var res []NewsCount
+
+	err := buildQuery(ctx, nr.db, (*News)(nil), search, nr.filters[Tables.News.Name], PagerNoLimit, ops...).
+		Where(`?.? IN (SELECT ? FROM ? WHERE ? = ?)`, pg.Ident(Tables.News.Alias), pg.Ident(Columns.News.CategoryID),
+			pg.Ident(Columns.Category.ID), pg.Ident(Tables.Category.Name), pg.Ident(Columns.Category.StatusID), StatusEnabled).
+		ColumnExpr(`?.? AS "id"`, pg.Ident(Tables.News.Alias), pg.Ident(Columns.News.CategoryID)).
+		ColumnExpr(`count(*) AS "count"`).
+		GroupExpr(`"id"`).
+		Having(`count(*) >= ?`, minCount).
+		OrderExpr(`"count" DESC, "id"`).
+		Select(&res)
+
+	return res, err
+}
+
+// NextPublishedAt returns the nearest publishedAt of scheduled news or zero time if there are no scheduled news.
+func (nr NewsRepo) NextPublishedAt(ctx context.Context) (time.Time, error) {
+	var next time.Time
+	now := time.Now()
+
+	err := buildQuery(ctx, nr.db, (*News)(nil), &NewsSearch{PublishedFrom: &now}, nr.filters[Tables.News.Name], PagerOne).
+		ColumnExpr(`min(?.?)`, pg.Ident(Tables.News.Alias), pg.Ident(Columns.News.PublishedAt)).
+		Select(pg.Scan(&next))
+
+	return next, err
+}
+
+// LastUpdatedAt returns the latest updatedAt of news matching search with any status or zero time if there are no news.
+// Deleted and disabled news are included, so their removal from lists changes it.
+func (nr NewsRepo) LastUpdatedAt(ctx context.Context, search *NewsSearch) (time.Time, error) {
+	var last time.Time
+
+	err := buildQuery(ctx, nr.db, (*News)(nil), search, nil, PagerOne).
+		ColumnExpr(`max(?.?)`, pg.Ident(Tables.News.Alias), pg.Ident(Columns.News.UpdatedAt)).
+		Select(pg.Scan(&last))
+
+	return last, err
+}
+
+// Unique indexes of slugs, which are checked by IsNewsSlugUsed and IsCategorySlugUsed before save.
+const (
+	IndexNewsSlug     = "UQ_news_slug"
+	IndexCategorySlug = "UQ_categories_slug"
+)
+
+// IsNewsSlugUsed checks if slug is a current or an old slug of any news except newsID.
+func (nr NewsRepo) IsNewsSlugUsed(ctx context.Context, slug string, newsID int) (bool, error) {
+	var used bool
+
+	_, err := nr.db.QueryOneContext(ctx, pg.Scan(&used), `
+		SELECT EXISTS (SELECT 1 FROM ? WHERE ? = ? AND ? <> ?)
+			OR EXISTS (SELECT 1 FROM ? WHERE ? = ? AND ? <> ?)`,
+		pg.Ident(Tables.News.Name), pg.Ident(Columns.News.Slug), slug, pg.Ident(Columns.News.ID), newsID,
+		pg.Ident(Tables.NewsSlug.Name), pg.Ident(Columns.NewsSlug.Slug), slug, pg.Ident(Columns.NewsSlug.NewsID), newsID,
+	)
+
+	return used, err
+}
+
+// IsCategorySlugUsed checks if slug is used by any category except categoryID.
+func (nr NewsRepo) IsCategorySlugUsed(ctx context.Context, slug string, categoryID int) (bool, error) {
+	var used bool
+
+	_, err := nr.db.QueryOneContext(ctx, pg.Scan(&used), `SELECT EXISTS (SELECT 1 FROM ? WHERE ? = ? AND ? <> ?)`,
+		pg.Ident(Tables.Category.Name), pg.Ident(Columns.Category.Slug), slug, pg.Ident(Columns.Category.ID), categoryID,
+	)
+
+	return used, err
+}
+
+// MoveNewsSlug keeps oldSlug as an old slug of the news, so it still could be resolved. If newSlug is an old slug of the news, it is removed from old slugs.
+func (nr NewsRepo) MoveNewsSlug(ctx context.Context, newsID int, oldSlug, newSlug string) error {
+	_, err := nr.db.ModelContext(ctx, (*NewsSlug)(nil)).
+		Where(`? = ?`, pg.Ident(Columns.NewsSlug.Slug), newSlug).
+		Where(`? = ?`, pg.Ident(Columns.NewsSlug.NewsID), newsID).
+		Delete()
+	if err != nil {
+		return err
+	}
+
+	_, err = nr.AddNewsSlug(ctx,
+		&NewsSlug{Slug: oldSlug, NewsID: newsID, CreatedAt: time.Now()},
+		OnConflict(`(?) DO UPDATE SET ? = EXCLUDED.?, ? = EXCLUDED.?`,
+			pg.Ident(Columns.NewsSlug.Slug),
+			pg.Ident(Columns.NewsSlug.NewsID), pg.Ident(Columns.NewsSlug.NewsID),
+			pg.Ident(Columns.NewsSlug.CreatedAt), pg.Ident(Columns.NewsSlug.CreatedAt),
+		),
+	)
+
+	return err
+}
+
+// UniqueNewsSlug returns slug made of title, which is not used by any news except newsID.
+func (nr NewsRepo) UniqueNewsSlug(ctx context.Context, title string, newsID int) (string, error) {
+	base := slug.Make(title)
+	if base == "" {
+		base = "news"
+	}
+
+	return slug.Unique(base, func(s string) (bool, error) {
+		return nr.IsNewsSlugUsed(ctx, s, newsID)
+	})
+}
+
+// UniqueCategorySlug returns slug made of title, which is not used by any category except categoryID.
+func (nr NewsRepo) UniqueCategorySlug(ctx context.Context, title string, categoryID int) (string, error) {
+	base := slug.Make(title)
+	if base == "" {
+		base = "category"
+	}
+
+	return slug.Unique(base, func(s string) (bool, error) {
+		return nr.IsCategorySlugUsed(ctx, s, categoryID)
+	})
+}
+
+const tableNewsViews = "newsViews"
+
+// NewsViews is a number of news views per day.
+type NewsViews struct {
+	NewsID int
+	Date   time.Time
+	Count  int
+}
+
+// AddNewsViews increments daily news views counters. Views of missing (purged) news are skipped.
+func (nr NewsRepo) AddNewsViews(ctx context.Context, views []NewsViews) error {
+	if len(views) == 0 {
+		return nil
+	}
+
+	ids, dates, counts := make([]int, len(views)), make([]string, len(views)), make([]int, len(views))
+	for i, v := range views {
+		ids[i], dates[i], counts[i] = v.NewsID, v.Date.Format(time.DateOnly), v.Count
+	}
+
+	_, err := nr.db.ExecContext(ctx, `
+		INSERT INTO ? ("newsId", "date", "count")
+		SELECT u."newsId", u."date", u."count" FROM unnest(?::int4[], ?::date[], ?::int4[]) AS u("newsId", "date", "count")
+		JOIN ? n ON n.? = u."newsId"
+		ON CONFLICT ("newsId", "date") DO UPDATE SET "count" = ?."count" + EXCLUDED."count"`,
+		pg.Ident(tableNewsViews), pg.Array(ids), pg.Array(dates), pg.Array(counts),
+		pg.Ident(Tables.News.Name), pg.Ident(Columns.News.ID), pg.Ident(tableNewsViews),
+	)
+
+	return err
+}
+
+// MostViewedNews returns numbers of news views since the date, most viewed first.
+func (nr NewsRepo) MostViewedNews(ctx context.Context, search *NewsSearch, since time.Time, pager Pager, ops ...OpFunc) ([]NewsCount, error) {
+	var res []NewsCount
+
+	err := buildQuery(ctx, nr.db, (*News)(nil), search, nr.filters[Tables.News.Name], pager, ops...).
+		Join(`JOIN (SELECT "newsId", sum("count") AS "count" FROM ? WHERE "date" >= ? GROUP BY "newsId") AS "v" ON "v"."newsId" = ?.?`,
+			pg.Ident(tableNewsViews), since.Format(time.DateOnly), pg.Ident(Tables.News.Alias), pg.Ident(Columns.News.ID)).
+		ColumnExpr(`?.? AS "id"`, pg.Ident(Tables.News.Alias), pg.Ident(Columns.News.ID)).
+		ColumnExpr(`"v"."count"`).
+		OrderExpr(`"v"."count" DESC, ?.? DESC`, pg.Ident(Tables.News.Alias), pg.Ident(Columns.News.ID)).
+		Select(&res)
+
+	return res, err
+}
+
+const (
+	// suggestion moderation statuses
+	ModerationPending  = 1
+	ModerationApproved = 2
+	ModerationRejected = 3
+)
+
+// SetSuggestionModerationStatus sets moderation status and news of the suggestion, if its current moderation status is one of fromStatusIDs.
+// It returns false if suggestion is not found or its moderation status is not one of fromStatusIDs.
+func (nr NewsRepo) SetSuggestionModerationStatus(ctx context.Context, id, statusID int, newsID *int, fromStatusIDs ...int) (bool, error) {
+	res, err := nr.db.ModelContext(ctx, (*Suggestion)(nil)).
+		Set(`? = ?`, pg.Ident(Columns.Suggestion.ModerationStatusID), statusID).
+		Set(`? = ?`, pg.Ident(Columns.Suggestion.NewsID), newsID).
+		Where(`? = ?`, pg.Ident(Columns.Suggestion.ID), id).
+		Where(`? IN (?)`, pg.Ident(Columns.Suggestion.ModerationStatusID), pg.In(fromStatusIDs)).
+		Update()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, nil
+}
+
+const (
+	// news revision actions
+	RevisionAdd     = "add"
+	RevisionUpdate  = "update"
+	RevisionDelete  = "delete"
+	RevisionRestore = "restore"
+	// RevisionInitial is a snapshot of news created before revisions were introduced, saved on its first change.
+	RevisionInitial = "initial"
+)
+
+// NewNewsRevision returns a snapshot of the news made by action of the user.
+func NewNewsRevision(news *News, action string, userID *int) *NewsRevision {
+	return &NewsRevision{
+		NewsID:       news.ID,
+		UserID:       userID,
+		Action:       action,
+		Title:        news.Title,
+		Slug:         news.Slug,
+		ShortText:    news.ShortText,
+		Content:      news.Content,
+		Author:       news.Author,
+		CategoryID:   news.CategoryID,
+		TagIDs:       news.TagIDs,
+		PublishedAt:  news.PublishedAt,
+		NewsStatusID: news.StatusID,
+	}
+}
+
+// ReplaceNewsTags replaces source tags with the target one in tagIds of all news, including deleted ones.
+// Order of tags is kept, target tag is not duplicated. It returns ids of changed news.
+func (nr NewsRepo) ReplaceNewsTags(ctx context.Context, sourceIDs []int, targetID int) ([]int, error) {
+	var ids []int
+
+	_, err := nr.db.QueryContext(ctx, pg.Scan(&ids), `
+		UPDATE ? SET ? = ARRAY(
+			SELECT "t"."id" FROM (
+				SELECT CASE WHEN "u"."id" = ANY(?::int4[]) THEN ? ELSE "u"."id" END AS "id", min("u"."pos") AS "pos"
+				FROM unnest(?) WITH ORDINALITY AS "u"("id", "pos")
+				GROUP BY 1
+			) AS "t"
+			ORDER BY "t"."pos"
+		)
+		WHERE ? && ?::int4[]
+		RETURNING ?`,
+		pg.Ident(Tables.News.Name), pg.Ident(Columns.News.TagIDs),
+		pg.Array(sourceIDs), targetID,
+		pg.Ident(Columns.News.TagIDs),
+		pg.Ident(Columns.News.TagIDs), pg.Array(sourceIDs),
+		pg.Ident(Columns.News.ID),
+	)
+
+	return ids, err
+}
+
+// RemoveNewsTags removes tags from tagIds of all news, including deleted ones. It returns ids of changed news.
+func (nr NewsRepo) RemoveNewsTags(ctx context.Context, tagIDs []int) ([]int, error) {
+	var ids []int
+
+	_, err := nr.db.QueryContext(ctx, pg.Scan(&ids), `
+		UPDATE ? SET ? = ARRAY(
+			SELECT "u"."id" FROM unnest(?) WITH ORDINALITY AS "u"("id", "pos")
+			WHERE "u"."id" <> ALL(?::int4[])
+			ORDER BY "u"."pos"
+		)
+		WHERE ? && ?::int4[]
+		RETURNING ?`,
+		pg.Ident(Tables.News.Name), pg.Ident(Columns.News.TagIDs),
+		pg.Ident(Columns.News.TagIDs),
+		pg.Array(tagIDs),
+		pg.Ident(Columns.News.TagIDs), pg.Array(tagIDs),
+		pg.Ident(Columns.News.ID),
+	)
+
+	return ids, err
+}
+
+// OrphanTag is a tag id used in news tagIds, which tag is missing.
+type OrphanTag struct {
+	ID        int `pg:"id"`
+	NewsCount int `pg:"newsCount"`
+}
+
+// OrphanTags returns missing tags used in tagIds of all news, including deleted ones.
+// Deleted tags are not orphans: they are kept in news while they are in trash and could be restored.
+func (nr NewsRepo) OrphanTags(ctx context.Context) ([]OrphanTag, error) {
+	var res []OrphanTag
+
+	_, err := nr.db.QueryContext(ctx, &res, `
+		SELECT "u"."id", count(DISTINCT "n".?) AS "newsCount"
+		FROM ? AS "n"
+		CROSS JOIN LATERAL unnest("n".?) AS "u"("id")
+		LEFT JOIN ? AS "t" ON "t".? = "u"."id"
+		WHERE "t".? IS NULL
+		GROUP BY "u"."id"
+		ORDER BY "u"."id"`,
+		pg.Ident(Columns.News.ID),
+		pg.Ident(Tables.News.Name),
+		pg.Ident(Columns.News.TagIDs),
+		pg.Ident(Tables.Tag.Name), pg.Ident(Columns.Tag.ID),
+		pg.Ident(Columns.Tag.ID),
+	)
+
+	return res, err
+}
@@ -0,0 +1,130 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+)
+
+type TrashRepo struct {
+	db      orm.DB
+	filters map[string][]Filter
+	sort    map[string][]SortField
+	join    map[string][]string
+}
+
+// NewTrashRepo returns new repository
+func NewTrashRepo(db orm.DB) TrashRepo {
+	return TrashRepo{
+		db:      db,
+		filters: map[string][]Filter{},
+		sort: map[string][]SortField{
+			Tables.TrashItem.Name: {{Column: Columns.TrashItem.ID, Direction: SortDesc}},
+		},
+		join: map[string][]string{
+			Tables.TrashItem.Name: {TableColumns},
+		},
+	}
+}
+
+// WithTransaction is a function that wraps TrashRepo with pg.Tx transaction.
+func (tr TrashRepo) WithTransaction(tx *pg.Tx) TrashRepo {
+	tr.db = tx
+	return tr
+}
+
+// WithEnabledOnly is a function that adds "statusId"=1 as base filter.
+func (tr TrashRepo) WithEnabledOnly() TrashRepo {
+	f := make(map[string][]Filter, len(tr.filters))
+	for i := range tr.filters {
+		f[i] = make([]Filter, len(tr.filters[i]))
+		copy(f[i], tr.filters[i])
+		f[i] = append(f[i], StatusEnabledFilter)
+	}
+	tr.filters = f
+
+	return tr
+}
+
+/*** TrashItem ***/
+
+// FullTrashItem returns full joins with all columns
+func (tr TrashRepo) FullTrashItem() OpFunc {
+	return WithColumns(tr.join[Tables.TrashItem.Name]...)
+}
+
+// DefaultTrashItemSort returns default sort.
+func (tr TrashRepo) DefaultTrashItemSort() OpFunc {
+	return WithSort(tr.sort[Tables.TrashItem.Name]...)
+}
+
+// TrashItemByID is a function that returns TrashItem by ID(s) or nil.
+func (tr TrashRepo) TrashItemByID(ctx context.Context, id int, ops ...OpFunc) (*TrashItem, error) {
+	return tr.OneTrashItem(ctx, &TrashItemSearch{ID: &id}, ops...)
+}
+
+// OneTrashItem is a function that returns one TrashItem by filters. It could return pg.ErrMultiRows.
+func (tr TrashRepo) OneTrashItem(ctx context.Context, search *TrashItemSearch, ops ...OpFunc) (*TrashItem, error) {
+	obj := &TrashItem{}
+	err := buildQuery(ctx, tr.db, obj, search, tr.filters[Tables.TrashItem.Name], PagerTwo, ops...).Select()
+
+	if errors.Is(err, pg.ErrMultiRows) {
+		return nil, err
+	} else if errors.Is(err, pg.ErrNoRows) {
+		return nil, nil
+	}
+
+	return obj, err
+}
+
+// TrashItemsByFilters returns TrashItem list.
+func (tr TrashRepo) TrashItemsByFilters(ctx context.Context, search *TrashItemSearch, pager Pager, ops ...OpFunc) (trashItems []TrashItem, err error) {
+	err = buildQuery(ctx, tr.db, &trashItems, search, tr.filters[Tables.TrashItem.Name], pager, ops...).Select()
+	return
+}
+
+// CountTrashItems returns count
+func (tr TrashRepo) CountTrashItems(ctx context.Context, search *TrashItemSearch, ops ...OpFunc) (int, error) {
+	return buildQuery(ctx, tr.db, &TrashItem{}, search, tr.filters[Tables.TrashItem.Name], PagerOne, ops...).Count()
+}
+
+// AddTrashItem adds TrashItem to DB.
+func (tr TrashRepo) AddTrashItem(ctx context.Context, trashItem *TrashItem, ops ...OpFunc) (*TrashItem, error) {
+	q := tr.db.ModelContext(ctx, trashItem)
+	if len(ops) == 0 {
+		q = q.ExcludeColumn(Columns.TrashItem.DeletedAt)
+	}
+	applyOps(q, ops...)
+	_, err := q.Insert()
+
+	return trashItem, err
+}
+
+// UpdateTrashItem updates TrashItem in DB.
+func (tr TrashRepo) UpdateTrashItem(ctx context.Context, trashItem *TrashItem, ops ...OpFunc) (bool, error) {
+	q := tr.db.ModelContext(ctx, trashItem).WherePK()
+	if len(ops) == 0 {
+		q = q.ExcludeColumn(Columns.TrashItem.ID, Columns.TrashItem.ObjectType, Columns.TrashItem.ObjectID, Columns.TrashItem.PrevStatusID, Columns.TrashItem.DeletedAt)
+	}
+	applyOps(q, ops...)
+	res, err := q.Update()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, err
+}
+
+// DeleteTrashItem deletes TrashItem from DB.
+func (tr TrashRepo) DeleteTrashItem(ctx context.Context, id int) (deleted bool, err error) {
+	trashItem := &TrashItem{ID: id}
+
+	res, err := tr.db.ModelContext(ctx, trashItem).WherePK().Delete()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, err
+}
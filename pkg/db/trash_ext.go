@@ -0,0 +1,197 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+)
+
+// trash object types, they are equal to table names tracked by "trackTrashItem" trigger.
+const (
+	TrashNews       = "news"
+	TrashCategories = "categories"
+	TrashTags       = "tags"
+	TrashUsers      = "users"
+	TrashVfsFiles   = "vfsFiles"
+)
+
+// TrashTypes is a list of object types, which could be restored or purged from trash.
+var TrashTypes = []string{TrashNews, TrashCategories, TrashTags, TrashUsers, TrashVfsFiles}
+
+// trashDependency is a table, which rows prevent object purge. Condition has one placeholder for object id.
+type trashDependency struct {
+	table, cond string
+}
+
+// trashType describes a table of soft-deleted objects.
+type trashType struct {
+	id, title string
+	deps      []trashDependency
+}
+
+var trashTypes = map[string]trashType{
+	TrashNews: {id: Columns.News.ID, title: Columns.News.Title},
+	TrashCategories: {id: Columns.Category.ID, title: Columns.Category.Title, deps: []trashDependency{
+		{table: Tables.News.Name, cond: `"categoryId" = ?`},
+		{table: Tables.Suggestion.Name, cond: `"categoryId" = ?`},
+	}},
+	TrashTags: {id: Columns.Tag.ID, title: Columns.Tag.Name, deps: []trashDependency{
+		{table: Tables.News.Name, cond: `"tagIds" @> ARRAY[?]::int4[]`},
+	}},
+	TrashUsers: {id: Columns.User.ID, title: Columns.User.Login, deps: []trashDependency{
+		{table: Tables.SuggestionDecision.Name, cond: `"userId" = ?`},
+	}},
+	TrashVfsFiles: {id: Columns.VfsFile.ID, title: Columns.VfsFile.Title},
+}
+
+// IsTrashType checks that objects of the type could be restored or purged from trash.
+func IsTrashType(objectType string) bool {
+	_, ok := trashTypes[objectType]
+	return ok
+}
+
+// TrashObject is a soft-deleted object. PrevStatusID and DeletedAt are empty, if object was deleted before trash tracking.
+type TrashObject struct {
+	ID           int        `pg:"id"`
+	Title        string     `pg:"title"`
+	PrevStatusID *int       `pg:"prevStatusId"`
+	DeletedAt    *time.Time `pg:"deletedAt"`
+}
+
+// TrashDependency is a number of rows in the table, which reference the object.
+type TrashDependency struct {
+	Table string
+	Count int
+}
+
+// trashTypeOf returns type description or error, if the type is not supported.
+func trashTypeOf(objectType string) (trashType, error) {
+	t, ok := trashTypes[objectType]
+	if !ok {
+		return t, fmt.Errorf("unsupported trash object type %q", objectType)
+	}
+	return t, nil
+}
+
+// trashObjectsQuery selects soft-deleted objects with their trash items.
+const trashObjectsQuery = `
+	SELECT o.? AS "id", o.? AS "title", ti.? AS "prevStatusId", ti.? AS "deletedAt"
+	FROM ? o
+	LEFT JOIN ? ti ON ti.? = ? AND ti.? = o.?
+	WHERE o."statusId" = ?`
+
+// trashObjectsParams returns trashObjectsQuery params for the type.
+func trashObjectsParams(t trashType, objectType string) []interface{} {
+	return []interface{}{
+		pg.Ident(t.id), pg.Ident(t.title), pg.Ident(Columns.TrashItem.PrevStatusID), pg.Ident(Columns.TrashItem.DeletedAt),
+		pg.Ident(objectType),
+		pg.Ident(Tables.TrashItem.Name), pg.Ident(Columns.TrashItem.ObjectType), objectType, pg.Ident(Columns.TrashItem.ObjectID), pg.Ident(t.id),
+		StatusDeleted,
+	}
+}
+
+// CountTrashObjects returns a number of soft-deleted objects of the type.
+func (tr TrashRepo) CountTrashObjects(ctx context.Context, objectType string) (int, error) {
+	if _, err := trashTypeOf(objectType); err != nil {
+		return 0, err
+	}
+
+	var count int
+	_, err := tr.db.QueryOneContext(ctx, pg.Scan(&count), `SELECT count(*) FROM ? WHERE "statusId" = ?`, pg.Ident(objectType), StatusDeleted)
+
+	return count, err
+}
+
+// TrashObjects returns soft-deleted objects of the type, recently deleted first.
+func (tr TrashRepo) TrashObjects(ctx context.Context, objectType string, pager Pager) ([]TrashObject, error) {
+	t, err := trashTypeOf(objectType)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []TrashObject
+	params := append(trashObjectsParams(t, objectType), pg.Safe(pager.String()))
+	_, err = tr.db.QueryContext(ctx, &res, trashObjectsQuery+` ORDER BY "deletedAt" DESC NULLS LAST, "id" DESC ?`, params...)
+
+	return res, err
+}
+
+// TrashObjectByID returns soft-deleted object of the type by id or nil.
+func (tr TrashRepo) TrashObjectByID(ctx context.Context, objectType string, id int) (*TrashObject, error) {
+	t, err := trashTypeOf(objectType)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &TrashObject{}
+	params := append(trashObjectsParams(t, objectType), pg.Ident(t.id), id)
+	_, err = tr.db.QueryOneContext(ctx, res, trashObjectsQuery+` AND o.? = ?`, params...)
+	if errors.Is(err, pg.ErrNoRows) {
+		return nil, nil
+	}
+
+	return res, err
+}
+
+// TrashDependencies returns tables, which rows reference the object and prevent its purge.
+func (tr TrashRepo) TrashDependencies(ctx context.Context, objectType string, id int) ([]TrashDependency, error) {
+	t, err := trashTypeOf(objectType)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []TrashDependency{}
+	for _, d := range t.deps {
+		var count int
+		if _, err = tr.db.QueryOneContext(ctx, pg.Scan(&count), `SELECT count(*) FROM ? WHERE `+d.cond, pg.Ident(d.table), id); err != nil {
+			return nil, fmt.Errorf("count %s: %w", d.table, err)
+		}
+
+		if count > 0 {
+			res = append(res, TrashDependency{Table: d.table, Count: count})
+		}
+	}
+
+	return res, nil
+}
+
+// RestoreTrashObject sets status of soft-deleted object. Its trash item is deleted by trigger.
+func (tr TrashRepo) RestoreTrashObject(ctx context.Context, objectType string, id, statusID int) (bool, error) {
+	t, err := trashTypeOf(objectType)
+	if err != nil {
+		return false, err
+	}
+
+	res, err := tr.db.ExecContext(ctx, `UPDATE ? SET "statusId" = ? WHERE ? = ? AND "statusId" = ?`,
+		pg.Ident(objectType), statusID, pg.Ident(t.id), id, StatusDeleted)
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, nil
+}
+
+// PurgeTrashObject permanently deletes soft-deleted object and its trash item.
+func (tr TrashRepo) PurgeTrashObject(ctx context.Context, objectType string, id int) (bool, error) {
+	t, err := trashTypeOf(objectType)
+	if err != nil {
+		return false, err
+	}
+
+	res, err := tr.db.ExecContext(ctx, `DELETE FROM ? WHERE ? = ? AND "statusId" = ?`, pg.Ident(objectType), pg.Ident(t.id), id, StatusDeleted)
+	if err != nil {
+		return false, err
+	} else if res.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	_, err = tr.db.ModelContext(ctx, (*TrashItem)(nil)).
+		Where(`? = ?`, pg.Ident(Columns.TrashItem.ObjectType), objectType).
+		Where(`? = ?`, pg.Ident(Columns.TrashItem.ObjectID), id).
+		Delete()
+
+	return err == nil, err
+}
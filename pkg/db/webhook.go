@@ -0,0 +1,290 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+)
+
+type WebhookRepo struct {
+	db      orm.DB
+	filters map[string][]Filter
+	sort    map[string][]SortField
+	join    map[string][]string
+}
+
+// NewWebhookRepo returns new repository
+func NewWebhookRepo(db orm.DB) WebhookRepo {
+	return WebhookRepo{
+		db: db,
+		filters: map[string][]Filter{
+			Tables.Webhook.Name: {StatusFilter},
+		},
+		sort: map[string][]SortField{
+			Tables.Webhook.Name:         {{Column: Columns.Webhook.CreatedAt, Direction: SortDesc}},
+			Tables.WebhookDelivery.Name: {{Column: Columns.WebhookDelivery.CreatedAt, Direction: SortDesc}},
+			Tables.WebhookCursor.Name:   {{Column: Columns.WebhookCursor.ID, Direction: SortDesc}},
+		},
+		join: map[string][]string{
+			Tables.Webhook.Name:         {TableColumns},
+			Tables.WebhookDelivery.Name: {TableColumns, Columns.WebhookDelivery.Webhook},
+			Tables.WebhookCursor.Name:   {TableColumns},
+		},
+	}
+}
+
+// WithTransaction is a function that wraps WebhookRepo with pg.Tx transaction.
+func (wr WebhookRepo) WithTransaction(tx *pg.Tx) WebhookRepo {
+	wr.db = tx
+	return wr
+}
+
+// WithEnabledOnly is a function that adds "statusId"=1 as base filter.
+func (wr WebhookRepo) WithEnabledOnly() WebhookRepo {
+	f := make(map[string][]Filter, len(wr.filters))
+	for i := range wr.filters {
+		f[i] = make([]Filter, len(wr.filters[i]))
+		copy(f[i], wr.filters[i])
+		f[i] = append(f[i], StatusEnabledFilter)
+	}
+	wr.filters = f
+
+	return wr
+}
+
+/*** Webhook ***/
+
+// FullWebhook returns full joins with all columns
+func (wr WebhookRepo) FullWebhook() OpFunc {
+	return WithColumns(wr.join[Tables.Webhook.Name]...)
+}
+
+// DefaultWebhookSort returns default sort.
+func (wr WebhookRepo) DefaultWebhookSort() OpFunc {
+	return WithSort(wr.sort[Tables.Webhook.Name]...)
+}
+
+// WebhookByID is a function that returns Webhook by ID(s) or nil.
+func (wr WebhookRepo) WebhookByID(ctx context.Context, id int, ops ...OpFunc) (*Webhook, error) {
+	return wr.OneWebhook(ctx, &WebhookSearch{ID: &id}, ops...)
+}
+
+// OneWebhook is a function that returns one Webhook by filters. It could return pg.ErrMultiRows.
+func (wr WebhookRepo) OneWebhook(ctx context.Context, search *WebhookSearch, ops ...OpFunc) (*Webhook, error) {
+	obj := &Webhook{}
+	err := buildQuery(ctx, wr.db, obj, search, wr.filters[Tables.Webhook.Name], PagerTwo, ops...).Select()
+
+	if errors.Is(err, pg.ErrMultiRows) {
+		return nil, err
+	} else if errors.Is(err, pg.ErrNoRows) {
+		return nil, nil
+	}
+
+	return obj, err
+}
+
+// WebhooksByFilters returns Webhook list.
+func (wr WebhookRepo) WebhooksByFilters(ctx context.Context, search *WebhookSearch, pager Pager, ops ...OpFunc) (webhooks []Webhook, err error) {
+	err = buildQuery(ctx, wr.db, &webhooks, search, wr.filters[Tables.Webhook.Name], pager, ops...).Select()
+	return
+}
+
+// CountWebhooks returns count
+func (wr WebhookRepo) CountWebhooks(ctx context.Context, search *WebhookSearch, ops ...OpFunc) (int, error) {
+	return buildQuery(ctx, wr.db, &Webhook{}, search, wr.filters[Tables.Webhook.Name], PagerOne, ops...).Count()
+}
+
+// AddWebhook adds Webhook to DB.
+func (wr WebhookRepo) AddWebhook(ctx context.Context, webhook *Webhook, ops ...OpFunc) (*Webhook, error) {
+	q := wr.db.ModelContext(ctx, webhook)
+	if len(ops) == 0 {
+		q = q.ExcludeColumn(Columns.Webhook.CreatedAt)
+	}
+	applyOps(q, ops...)
+	_, err := q.Insert()
+
+	return webhook, err
+}
+
+// UpdateWebhook updates Webhook in DB.
+func (wr WebhookRepo) UpdateWebhook(ctx context.Context, webhook *Webhook, ops ...OpFunc) (bool, error) {
+	q := wr.db.ModelContext(ctx, webhook).WherePK()
+	if len(ops) == 0 {
+		q = q.ExcludeColumn(Columns.Webhook.ID, Columns.Webhook.CreatedAt)
+	}
+	applyOps(q, ops...)
+	res, err := q.Update()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, err
+}
+
+// DeleteWebhook set statusId to deleted in DB.
+func (wr WebhookRepo) DeleteWebhook(ctx context.Context, id int) (deleted bool, err error) {
+	webhook := &Webhook{ID: id, StatusID: StatusDeleted}
+
+	return wr.UpdateWebhook(ctx, webhook, WithColumns(Columns.Webhook.StatusID))
+}
+
+/*** WebhookDelivery ***/
+
+// FullWebhookDelivery returns full joins with all columns
+func (wr WebhookRepo) FullWebhookDelivery() OpFunc {
+	return WithColumns(wr.join[Tables.WebhookDelivery.Name]...)
+}
+
+// DefaultWebhookDeliverySort returns default sort.
+func (wr WebhookRepo) DefaultWebhookDeliverySort() OpFunc {
+	return WithSort(wr.sort[Tables.WebhookDelivery.Name]...)
+}
+
+// WebhookDeliveryByID is a function that returns WebhookDelivery by ID(s) or nil.
+func (wr WebhookRepo) WebhookDeliveryByID(ctx context.Context, id int, ops ...OpFunc) (*WebhookDelivery, error) {
+	return wr.OneWebhookDelivery(ctx, &WebhookDeliverySearch{ID: &id}, ops...)
+}
+
+// OneWebhookDelivery is a function that returns one WebhookDelivery by filters. It could return pg.ErrMultiRows.
+func (wr WebhookRepo) OneWebhookDelivery(ctx context.Context, search *WebhookDeliverySearch, ops ...OpFunc) (*WebhookDelivery, error) {
+	obj := &WebhookDelivery{}
+	err := buildQuery(ctx, wr.db, obj, search, wr.filters[Tables.WebhookDelivery.Name], PagerTwo, ops...).Select()
+
+	if errors.Is(err, pg.ErrMultiRows) {
+		return nil, err
+	} else if errors.Is(err, pg.ErrNoRows) {
+		return nil, nil
+	}
+
+	return obj, err
+}
+
+// WebhookDeliveriesByFilters returns WebhookDelivery list.
+func (wr WebhookRepo) WebhookDeliveriesByFilters(ctx context.Context, search *WebhookDeliverySearch, pager Pager, ops ...OpFunc) (webhookDeliveries []WebhookDelivery, err error) {
+	err = buildQuery(ctx, wr.db, &webhookDeliveries, search, wr.filters[Tables.WebhookDelivery.Name], pager, ops...).Select()
+	return
+}
+
+// CountWebhookDeliveries returns count
+func (wr WebhookRepo) CountWebhookDeliveries(ctx context.Context, search *WebhookDeliverySearch, ops ...OpFunc) (int, error) {
+	return buildQuery(ctx, wr.db, &WebhookDelivery{}, search, wr.filters[Tables.WebhookDelivery.Name], PagerOne, ops...).Count()
+}
+
+// AddWebhookDelivery adds WebhookDelivery to DB.
+func (wr WebhookRepo) AddWebhookDelivery(ctx context.Context, webhookDelivery *WebhookDelivery, ops ...OpFunc) (*WebhookDelivery, error) {
+	q := wr.db.ModelContext(ctx, webhookDelivery)
+	if len(ops) == 0 {
+		q = q.ExcludeColumn(Columns.WebhookDelivery.CreatedAt)
+	}
+	applyOps(q, ops...)
+	_, err := q.Insert()
+
+	return webhookDelivery, err
+}
+
+// UpdateWebhookDelivery updates WebhookDelivery in DB.
+func (wr WebhookRepo) UpdateWebhookDelivery(ctx context.Context, webhookDelivery *WebhookDelivery, ops ...OpFunc) (bool, error) {
+	q := wr.db.ModelContext(ctx, webhookDelivery).WherePK()
+	if len(ops) == 0 {
+		q = q.ExcludeColumn(Columns.WebhookDelivery.ID, Columns.WebhookDelivery.WebhookID, Columns.WebhookDelivery.Event, Columns.WebhookDelivery.Key, Columns.WebhookDelivery.Payload, Columns.WebhookDelivery.CreatedAt)
+	}
+	applyOps(q, ops...)
+	res, err := q.Update()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, err
+}
+
+// DeleteWebhookDelivery deletes WebhookDelivery from DB.
+func (wr WebhookRepo) DeleteWebhookDelivery(ctx context.Context, id int) (deleted bool, err error) {
+	webhookDelivery := &WebhookDelivery{ID: id}
+
+	res, err := wr.db.ModelContext(ctx, webhookDelivery).WherePK().Delete()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, err
+}
+
+/*** WebhookCursor ***/
+
+// FullWebhookCursor returns full joins with all columns
+func (wr WebhookRepo) FullWebhookCursor() OpFunc {
+	return WithColumns(wr.join[Tables.WebhookCursor.Name]...)
+}
+
+// DefaultWebhookCursorSort returns default sort.
+func (wr WebhookRepo) DefaultWebhookCursorSort() OpFunc {
+	return WithSort(wr.sort[Tables.WebhookCursor.Name]...)
+}
+
+// WebhookCursorByID is a function that returns WebhookCursor by ID(s) or nil.
+func (wr WebhookRepo) WebhookCursorByID(ctx context.Context, id string, ops ...OpFunc) (*WebhookCursor, error) {
+	return wr.OneWebhookCursor(ctx, &WebhookCursorSearch{ID: &id}, ops...)
+}
+
+// OneWebhookCursor is a function that returns one WebhookCursor by filters. It could return pg.ErrMultiRows.
+func (wr WebhookRepo) OneWebhookCursor(ctx context.Context, search *WebhookCursorSearch, ops ...OpFunc) (*WebhookCursor, error) {
+	obj := &WebhookCursor{}
+	err := buildQuery(ctx, wr.db, obj, search, wr.filters[Tables.WebhookCursor.Name], PagerTwo, ops...).Select()
+
+	if errors.Is(err, pg.ErrMultiRows) {
+		return nil, err
+	} else if errors.Is(err, pg.ErrNoRows) {
+		return nil, nil
+	}
+
+	return obj, err
+}
+
+// WebhookCursorsByFilters returns WebhookCursor list.
+func (wr WebhookRepo) WebhookCursorsByFilters(ctx context.Context, search *WebhookCursorSearch, pager Pager, ops ...OpFunc) (webhookCursors []WebhookCursor, err error) {
+	err = buildQuery(ctx, wr.db, &webhookCursors, search, wr.filters[Tables.WebhookCursor.Name], pager, ops...).Select()
+	return
+}
+
+// CountWebhookCursors returns count
+func (wr WebhookRepo) CountWebhookCursors(ctx context.Context, search *WebhookCursorSearch, ops ...OpFunc) (int, error) {
+	return buildQuery(ctx, wr.db, &WebhookCursor{}, search, wr.filters[Tables.WebhookCursor.Name], PagerOne, ops...).Count()
+}
+
+// AddWebhookCursor adds WebhookCursor to DB.
+func (wr WebhookRepo) AddWebhookCursor(ctx context.Context, webhookCursor *WebhookCursor, ops ...OpFunc) (*WebhookCursor, error) {
+	q := wr.db.ModelContext(ctx, webhookCursor)
+	applyOps(q, ops...)
+	_, err := q.Insert()
+
+	return webhookCursor, err
+}
+
+// UpdateWebhookCursor updates WebhookCursor in DB.
+func (wr WebhookRepo) UpdateWebhookCursor(ctx context.Context, webhookCursor *WebhookCursor, ops ...OpFunc) (bool, error) {
+	q := wr.db.ModelContext(ctx, webhookCursor).WherePK()
+	if len(ops) == 0 {
+		q = q.ExcludeColumn(Columns.WebhookCursor.ID)
+	}
+	applyOps(q, ops...)
+	res, err := q.Update()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, err
+}
+
+// DeleteWebhookCursor deletes WebhookCursor from DB.
+func (wr WebhookRepo) DeleteWebhookCursor(ctx context.Context, id string) (deleted bool, err error) {
+	webhookCursor := &WebhookCursor{ID: id}
+
+	res, err := wr.db.ModelContext(ctx, webhookCursor).WherePK().Delete()
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, err
+}
@@ -0,0 +1,71 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+)
+
+const (
+	// webhook delivery statuses
+	DeliveryPending   = 1
+	DeliveryDelivered = 2
+	DeliveryFailed    = 3
+
+	// CursorNewsPublished is a WebhookCursor of news.published events check.
+	CursorNewsPublished = "news.published"
+)
+
+// SetWebhookCursor moves cursor forward to value, cursor is added if it doesn't exist.
+func (wr WebhookRepo) SetWebhookCursor(ctx context.Context, id string, value time.Time) error {
+	_, err := wr.db.ExecContext(ctx, `
+		INSERT INTO ? (?, ?) VALUES (?, ?)
+		ON CONFLICT (?) DO UPDATE SET ? = GREATEST(?.?, EXCLUDED.?)`,
+		pg.Ident(Tables.WebhookCursor.Name), pg.Ident(Columns.WebhookCursor.ID), pg.Ident(Columns.WebhookCursor.Value), id, value,
+		pg.Ident(Columns.WebhookCursor.ID),
+		pg.Ident(Columns.WebhookCursor.Value), pg.Ident(Tables.WebhookCursor.Name), pg.Ident(Columns.WebhookCursor.Value), pg.Ident(Columns.WebhookCursor.Value),
+	)
+
+	return err
+}
+
+// AddWebhookDeliveries adds deliveries to DB. Deliveries with the same webhook and key as existing ones are skipped.
+func (wr WebhookRepo) AddWebhookDeliveries(ctx context.Context, deliveries []WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	_, err := wr.db.ModelContext(ctx, &deliveries).
+		ExcludeColumn(Columns.WebhookDelivery.CreatedAt).
+		OnConflict(`(?, ?) DO NOTHING`, pg.Ident(Columns.WebhookDelivery.WebhookID), pg.Ident(Columns.WebhookDelivery.Key)).
+		Insert()
+
+	return err
+}
+
+// ClaimWebhookDeliveries returns up to limit pending deliveries, which next attempt time has come.
+// Next attempt of claimed deliveries is postponed for lease, so they are not claimed by other dispatchers.
+func (wr WebhookRepo) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]WebhookDelivery, error) {
+	var res []WebhookDelivery
+
+	_, err := wr.db.QueryContext(ctx, &res, `
+		UPDATE ? SET ? = now() + ? * interval '1 second'
+		WHERE ? IN (
+			SELECT ? FROM ?
+			WHERE ? = ? AND ? <= now()
+			ORDER BY ?
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		pg.Ident(Tables.WebhookDelivery.Name), pg.Ident(Columns.WebhookDelivery.NextAttemptAt), int(lease.Seconds()),
+		pg.Ident(Columns.WebhookDelivery.ID),
+		pg.Ident(Columns.WebhookDelivery.ID), pg.Ident(Tables.WebhookDelivery.Name),
+		pg.Ident(Columns.WebhookDelivery.DeliveryStatusID), DeliveryPending, pg.Ident(Columns.WebhookDelivery.NextAttemptAt),
+		pg.Ident(Columns.WebhookDelivery.NextAttemptAt),
+		limit,
+	)
+
+	return res, err
+}
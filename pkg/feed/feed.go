@@ -0,0 +1,89 @@
+package feed
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"time"
+
+	"apisrv/pkg/newsportal"
+)
+
+// Feed is a format independent syndication feed.
+type Feed struct {
+	Title       string
+	Description string
+	Link        string // site page url
+	SelfLink    string // feed url
+	Updated     time.Time
+	Items       []Item
+}
+
+// Item is a single feed entry.
+type Item struct {
+	ID         int
+	Title      string
+	Link       string
+	Summary    string
+	Content    string
+	Author     string
+	Published  time.Time
+	Updated    time.Time // time of the last news change
+	Categories []string
+}
+
+// NewItem converts news to feed item.
+func NewItem(in newsportal.News, link string) Item {
+	item := Item{
+		ID:        in.ID,
+		Title:     in.Title,
+		Link:      link,
+		Summary:   in.ShortText,
+		Published: in.PublishedAt,
+		Updated:   in.UpdatedAt,
+	}
+
+	if in.Content != nil {
+		item.Content = *in.Content
+	}
+
+	if in.Author != nil {
+		item.Author = *in.Author
+	}
+
+	if in.Category != nil {
+		item.Categories = append(item.Categories, in.Category.Title)
+	}
+
+	for _, tag := range in.Tags {
+		item.Categories = append(item.Categories, tag.Name)
+	}
+
+	return item
+}
+
+// LastModified returns the latest item change time or feed updated time if there are no items.
+func (f Feed) LastModified() time.Time {
+	res := f.Updated
+	for _, item := range f.Items {
+		if t := item.modified(); t.After(res) {
+			res = t
+		}
+	}
+
+	return res.UTC().Truncate(time.Second)
+}
+
+// modified returns item change time, publication time is used for items without it.
+func (i Item) modified() time.Time {
+	if i.Updated.After(i.Published) {
+		return i.Updated
+	}
+
+	return i.Published
+}
+
+// ETag returns entity tag of the encoded feed body.
+func ETag(body []byte) string {
+	sum := sha1.Sum(body)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
@@ -0,0 +1,131 @@
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func testFeed() Feed {
+	return Feed{
+		Title:    "NewsPortal",
+		Link:     "https://example.com/",
+		SelfLink: "https://example.com/feed/rss.xml",
+		Items: []Item{
+			{
+				ID:         1,
+				Title:      "Drunk cat",
+				Link:       "https://example.com/news/1",
+				Summary:    "Breaking news",
+				Content:    "<p>Long story</p>",
+				Author:     "Bob the Cat",
+				Published:  time.Date(2025, 9, 15, 0, 0, 0, 0, time.UTC),
+				Updated:    time.Date(2025, 9, 16, 12, 0, 0, 0, time.UTC),
+				Categories: []string{"Accidents", "Mascots"},
+			},
+		},
+	}
+}
+
+func TestEncoders(t *testing.T) {
+	Convey("Test feed encoders", t, func() {
+		fd := testFeed()
+
+		Convey("RSS", func() {
+			b, err := RSS(fd)
+			So(err, ShouldBeNil)
+
+			var doc struct {
+				Items []struct {
+					Title   string `xml:"title"`
+					PubDate string `xml:"pubDate"`
+				} `xml:"channel>item"`
+			}
+			So(xml.Unmarshal(b, &doc), ShouldBeNil)
+			So(doc.Items, ShouldHaveLength, 1)
+			So(doc.Items[0].Title, ShouldEqual, "Drunk cat")
+			So(doc.Items[0].PubDate, ShouldEqual, "Mon, 15 Sep 2025 00:00:00 +0000")
+		})
+
+		Convey("Atom", func() {
+			b, err := Atom(fd)
+			So(err, ShouldBeNil)
+
+			var doc struct {
+				Updated string `xml:"updated"`
+				Entries []struct {
+					ID        string `xml:"id"`
+					Published string `xml:"published"`
+					Updated   string `xml:"updated"`
+				} `xml:"entry"`
+			}
+			So(xml.Unmarshal(b, &doc), ShouldBeNil)
+			So(doc.Updated, ShouldEqual, "2025-09-16T12:00:00Z")
+			So(doc.Entries, ShouldHaveLength, 1)
+			So(doc.Entries[0].ID, ShouldEqual, "https://example.com/news/1")
+			So(doc.Entries[0].Published, ShouldEqual, "2025-09-15T00:00:00Z")
+			So(doc.Entries[0].Updated, ShouldEqual, "2025-09-16T12:00:00Z")
+		})
+
+		Convey("JSON Feed", func() {
+			b, err := JSON(fd)
+			So(err, ShouldBeNil)
+
+			var doc jsonFeed
+			So(json.Unmarshal(b, &doc), ShouldBeNil)
+			So(doc.Items, ShouldHaveLength, 1)
+			So(doc.Items[0].ContentHTML, ShouldEqual, "<p>Long story</p>")
+			So(doc.Items[0].Authors, ShouldHaveLength, 1)
+		})
+	})
+}
+
+func TestNotModified(t *testing.T) {
+	Convey("Test conditional GET", t, func() {
+		fd := testFeed()
+		b, err := RSS(fd)
+		So(err, ShouldBeNil)
+		etag, lastModified := ETag(b), fd.LastModified()
+
+		newRequest := func(header, value string) *http.Request {
+			req := httptest.NewRequest(http.MethodGet, "/feed/rss.xml", nil)
+			if header != "" {
+				req.Header.Set(header, value)
+			}
+			return req
+		}
+
+		So(lastModified, ShouldEqual, fd.Items[0].Updated)
+
+		// any rendered field changes etag
+		changed := testFeed()
+		changed.Items[0].Summary = "Fixed typo"
+		b, err = RSS(changed)
+		So(err, ShouldBeNil)
+		So(etag, ShouldNotEqual, ETag(b))
+
+		So(notModified(newRequest("", ""), etag, lastModified), ShouldBeFalse)
+		So(notModified(newRequest(headerIfNoneMatch, etag), etag, lastModified), ShouldBeTrue)
+		So(notModified(newRequest(headerIfNoneMatch, `"other", `+etag), etag, lastModified), ShouldBeTrue)
+		So(notModified(newRequest(headerIfNoneMatch, `"other"`), etag, lastModified), ShouldBeFalse)
+		So(notModified(newRequest("If-Modified-Since", lastModified.Format(http.TimeFormat)), etag, lastModified), ShouldBeTrue)
+		So(notModified(newRequest("If-Modified-Since", lastModified.Add(-time.Hour).Format(http.TimeFormat)), etag, lastModified), ShouldBeFalse)
+
+		Convey("Deleted item moves last modified forward", func() {
+			// feed updated time is the latest updatedAt in scope, deleted news included
+			deleted := testFeed()
+			deleted.Items = nil
+			deleted.Updated = fd.Items[0].Updated.Add(time.Hour)
+			b, err := RSS(deleted)
+			So(err, ShouldBeNil)
+
+			So(deleted.LastModified(), ShouldEqual, deleted.Updated)
+			So(notModified(newRequest("If-Modified-Since", lastModified.Format(http.TimeFormat)), ETag(b), deleted.LastModified()), ShouldBeFalse)
+		})
+	})
+}
@@ -0,0 +1,198 @@
+package feed
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"apisrv/pkg/newsportal"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultLimit = 50
+	// maxLimit is a max page size of news list by cursor.
+	maxLimit    = 100
+	cacheMaxAge = 5 * time.Minute
+
+	headerETag        = "ETag"
+	headerIfNoneMatch = "If-None-Match"
+)
+
+// Config is a feeds configuration.
+type Config struct {
+	Title       string // feed title, site name by default
+	Description string // feed description
+	Limit       int    // max items in feed, default is 50, max is 100
+}
+
+type format struct {
+	name        string
+	file        string
+	contentType string
+	encode      func(Feed) ([]byte, error)
+}
+
+var formats = []format{
+	{name: "rss", file: "rss.xml", contentType: "application/rss+xml; charset=utf-8", encode: RSS},
+	{name: "atom", file: "atom.xml", contentType: "application/atom+xml; charset=utf-8", encode: Atom},
+	{name: "json", file: "feed.json", contentType: "application/feed+json; charset=utf-8", encode: JSON},
+}
+
+// Handler serves published news as RSS 2.0, Atom and JSON Feed documents.
+type Handler struct {
+	cfg     Config
+	baseURL string
+	siteURL string
+	news    *newsportal.Service
+}
+
+// NewHandler returns feeds handler. Feed self links are built from public baseURL of the api server,
+// site and news links are built from public siteURL of the news site.
+func NewHandler(news *newsportal.Service, cfg Config, baseURL, siteURL string) *Handler {
+	if cfg.Limit <= 0 {
+		cfg.Limit = defaultLimit
+	} else if cfg.Limit > maxLimit {
+		cfg.Limit = maxLimit
+	}
+
+	return &Handler{cfg: cfg, baseURL: strings.TrimRight(baseURL, "/"), siteURL: strings.TrimRight(siteURL, "/"), news: news}
+}
+
+// Register adds site-wide, per category and per tag feeds in every format to echo group.
+func (h *Handler) Register(g *echo.Group) {
+	for _, f := range formats {
+		g.GET("/"+f.file, h.siteFeed(f))
+		g.GET("/category/:id/"+f.file, h.categoryFeed(f))
+		g.GET("/tag/:id/"+f.file, h.tagFeed(f))
+	}
+}
+
+func (h *Handler) siteFeed(f format) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return h.serve(c, f, h.cfg.Title, newsportal.NewsesFilter{})
+	}
+}
+
+func (h *Handler) categoryFeed(f format) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil || id <= 0 {
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		category, err := h.news.GetCategory(c.Request().Context(), id)
+		if errors.Is(err, newsportal.ErrNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound)
+		} else if err != nil {
+			return err
+		}
+
+		return h.serve(c, f, h.title(category.Title), newsportal.NewsesFilter{CategoryID: id})
+	}
+}
+
+func (h *Handler) tagFeed(f format) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil || id <= 0 {
+			return echo.NewHTTPError(http.StatusNotFound)
+		}
+
+		tag, err := h.news.GetTag(c.Request().Context(), id)
+		if errors.Is(err, newsportal.ErrNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound)
+		} else if err != nil {
+			return err
+		}
+
+		return h.serve(c, f, h.title(tag.Name), newsportal.NewsesFilter{TagID: id})
+	}
+}
+
+// serve writes feed with conditional GET support.
+func (h *Handler) serve(c echo.Context, f format, title string, filter newsportal.NewsesFilter) error {
+	req := c.Request()
+
+	// first page by cursor is the latest published news
+	page, err := h.news.GetListByCursor(req.Context(), filter, nil, h.cfg.Limit, nil)
+	if err != nil {
+		return err
+	}
+
+	// removal of news from feed changes its last updated time
+	updated, err := h.news.LastUpdatedAt(req.Context(), filter)
+	if err != nil {
+		return err
+	}
+
+	fd := Feed{
+		Updated:     updated,
+		Title:       title,
+		Description: h.cfg.Description,
+		Link:        h.siteURL + "/",
+		SelfLink:    h.baseURL + req.URL.Path,
+		Items:       make([]Item, 0, len(page.Items)),
+	}
+
+	for _, news := range page.Items {
+		fd.Items = append(fd.Items, NewItem(news, h.newsLink(news)))
+	}
+
+	b, err := f.encode(fd)
+	if err != nil {
+		return err
+	}
+
+	etag, lastModified := ETag(b), fd.LastModified()
+
+	header := c.Response().Header()
+	header.Set(headerETag, etag)
+	header.Set(echo.HeaderCacheControl, fmt.Sprintf("public, max-age=%d", int(cacheMaxAge.Seconds())))
+	if !lastModified.IsZero() {
+		header.Set(echo.HeaderLastModified, lastModified.Format(http.TimeFormat))
+	}
+
+	if notModified(req, etag, lastModified) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	return c.Blob(http.StatusOK, f.contentType, b)
+}
+
+func (h *Handler) title(s string) string {
+	if h.cfg.Title == "" {
+		return s
+	}
+
+	return h.cfg.Title + " — " + s
+}
+
+func (h *Handler) newsLink(news newsportal.News) string {
+	return h.siteURL + newsportal.NewsPath(news.Slug)
+}
+
+// notModified checks If-None-Match and If-Modified-Since request headers, see RFC 9110 section 13.2.2.
+func notModified(req *http.Request, etag string, lastModified time.Time) bool {
+	if inm := req.Header.Get(headerIfNoneMatch); inm != "" {
+		for _, v := range strings.Split(inm, ",") {
+			v = strings.TrimSpace(v)
+			if v == "*" || strings.TrimPrefix(v, "W/") == strings.TrimPrefix(etag, "W/") {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if ims := req.Header.Get(echo.HeaderIfModifiedSince); ims != "" && !lastModified.IsZero() {
+		t, err := http.ParseTime(ims)
+		return err == nil && !lastModified.After(t)
+	}
+
+	return false
+}
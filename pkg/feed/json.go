@@ -0,0 +1,68 @@
+package feed
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type jsonFeed struct {
+	Version     string         `json:"version"`
+	Title       string         `json:"title"`
+	HomePageURL string         `json:"home_page_url,omitempty"`
+	FeedURL     string         `json:"feed_url,omitempty"`
+	Description string         `json:"description,omitempty"`
+	Items       []jsonFeedItem `json:"items"`
+}
+
+type jsonFeedItem struct {
+	ID            string           `json:"id"`
+	URL           string           `json:"url"`
+	Title         string           `json:"title"`
+	Summary       string           `json:"summary,omitempty"`
+	ContentHTML   string           `json:"content_html,omitempty"`
+	ContentText   string           `json:"content_text,omitempty"`
+	DatePublished string           `json:"date_published"`
+	Authors       []jsonFeedAuthor `json:"authors,omitempty"`
+	Tags          []string         `json:"tags,omitempty"`
+}
+
+type jsonFeedAuthor struct {
+	Name string `json:"name"`
+}
+
+// JSON encodes feed as JSON Feed 1.1 document.
+func JSON(f Feed) ([]byte, error) {
+	doc := jsonFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       f.Title,
+		HomePageURL: f.Link,
+		FeedURL:     f.SelfLink,
+		Description: f.Description,
+		Items:       make([]jsonFeedItem, 0, len(f.Items)),
+	}
+
+	for _, item := range f.Items {
+		ji := jsonFeedItem{
+			ID:            item.Link,
+			URL:           item.Link,
+			Title:         item.Title,
+			Summary:       item.Summary,
+			ContentHTML:   item.Content,
+			DatePublished: item.Published.UTC().Format(time.RFC3339),
+			Tags:          item.Categories,
+		}
+
+		// content_html or content_text is required
+		if ji.ContentHTML == "" {
+			ji.ContentText = item.Summary
+		}
+
+		if item.Author != "" {
+			ji.Authors = []jsonFeedAuthor{{Name: item.Author}}
+		}
+
+		doc.Items = append(doc.Items, ji)
+	}
+
+	return json.MarshalIndent(doc, "", "  ")
+}
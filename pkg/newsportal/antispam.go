@@ -0,0 +1,326 @@
+package newsportal
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode"
+
+	"apisrv/pkg/db"
+)
+
+const (
+	defaultSpamIPLimit            = 5
+	defaultSpamIPWindow           = time.Hour
+	defaultSpamGlobalLimit        = 100
+	defaultSpamGlobalWindow       = time.Hour
+	defaultSpamDuplicateWindow    = 24 * time.Hour
+	defaultSpamDuplicateThreshold = 0.8
+	defaultChallengeTTL           = 10 * time.Minute
+
+	// maxDuplicateCandidates is a max number of recent suggestions compared with a new one.
+	maxDuplicateCandidates = 1000
+	// spamPruneInterval is an interval of expired rate limit and challenge entries removal.
+	spamPruneInterval = time.Minute
+)
+
+// AntiSpamConfig is a configuration of news suggestions spam protection.
+type AntiSpamConfig struct {
+	Enabled bool
+	// IPLimit is a max number of suggestions from one IP within IPWindow, default is 5 per 1h.
+	IPLimit  int
+	IPWindow time.Duration
+	// GlobalLimit is a max number of suggestions from all IPs within GlobalWindow, default is 100 per 1h.
+	GlobalLimit  int
+	GlobalWindow time.Duration
+	// DuplicateWindow is a period of recent suggestions checked for near-duplicates, default is 24h.
+	DuplicateWindow time.Duration
+	// DuplicateThreshold is a min text similarity from 0 to 1 of near-duplicates, default is 0.8.
+	DuplicateThreshold float64
+	// Honeypot rejects suggestions with filled honeypot field.
+	Honeypot bool
+	// ChallengeDifficulty is a number of leading zero bits of proof-of-work hash, 0 disables challenge check.
+	ChallengeDifficulty int
+	// ChallengeSecret is a key of challenge token signature. Random secret is generated, if it is empty,
+	// then tokens are valid only for the app instance, which issued them, so it must be set for several instances.
+	ChallengeSecret string
+	// ChallengeTTL is a lifetime of challenge token, default is 10m.
+	ChallengeTTL time.Duration
+}
+
+// SpamStats is a number of rejected suggestions by reason.
+type SpamStats struct {
+	RateLimited uint64
+	Duplicate   uint64
+	Honeypot    uint64
+	Challenge   uint64
+}
+
+// SuggestionChallenge is a proof-of-work task: find a nonce, such that sha256(token + ":" + nonce) has Difficulty leading zero bits.
+type SuggestionChallenge struct {
+	Token      string
+	Difficulty int
+}
+
+// SpamGuard protects news suggestions from spam with rate limits, near-duplicate detection, honeypot and proof-of-work challenge.
+// Its state is kept in memory, so limits are applied per app instance.
+type SpamGuard struct {
+	cfg AntiSpamConfig
+
+	mu      sync.Mutex
+	ipHits  map[string][]time.Time
+	hits    []time.Time
+	used    map[string]time.Time
+	pruneAt time.Time
+
+	rateLimited, duplicate, honeypot, challenge atomic.Uint64
+}
+
+func NewSpamGuard(cfg AntiSpamConfig) *SpamGuard {
+	if cfg.IPLimit <= 0 {
+		cfg.IPLimit = defaultSpamIPLimit
+	}
+	if cfg.IPWindow <= 0 {
+		cfg.IPWindow = defaultSpamIPWindow
+	}
+	if cfg.GlobalLimit <= 0 {
+		cfg.GlobalLimit = defaultSpamGlobalLimit
+	}
+	if cfg.GlobalWindow <= 0 {
+		cfg.GlobalWindow = defaultSpamGlobalWindow
+	}
+	if cfg.DuplicateWindow <= 0 {
+		cfg.DuplicateWindow = defaultSpamDuplicateWindow
+	}
+	if cfg.DuplicateThreshold <= 0 || cfg.DuplicateThreshold > 1 {
+		cfg.DuplicateThreshold = defaultSpamDuplicateThreshold
+	}
+	if cfg.ChallengeTTL <= 0 {
+		cfg.ChallengeTTL = defaultChallengeTTL
+	}
+	// empty key allows anyone to sign challenge tokens
+	if cfg.ChallengeSecret == "" {
+		cfg.ChallengeSecret = rand.Text()
+	}
+
+	return &SpamGuard{
+		cfg:    cfg,
+		ipHits: make(map[string][]time.Time),
+		used:   make(map[string]time.Time),
+	}
+}
+
+// Stats returns rejected suggestions counters.
+func (g *SpamGuard) Stats() SpamStats {
+	return SpamStats{
+		RateLimited: g.rateLimited.Load(),
+		Duplicate:   g.duplicate.Load(),
+		Honeypot:    g.honeypot.Load(),
+		Challenge:   g.challenge.Load(),
+	}
+}
+
+// NewChallenge returns new signed proof-of-work challenge or nil if challenge check is disabled.
+func (g *SpamGuard) NewChallenge(now time.Time) *SuggestionChallenge {
+	if g == nil || g.cfg.ChallengeDifficulty <= 0 {
+		return nil
+	}
+
+	payload := strconv.FormatInt(now.Add(g.cfg.ChallengeTTL).Unix(), 10) + "." + rand.Text()
+
+	return &SuggestionChallenge{
+		Token:      payload + "." + g.sign(payload),
+		Difficulty: g.cfg.ChallengeDifficulty,
+	}
+}
+
+// check verifies honeypot, challenge and rate limits of the suggestion from ip.
+func (g *SpamGuard) check(suggestion NewsSuggestion, ip string, now time.Time) error {
+	if g == nil {
+		return nil
+	}
+
+	if g.cfg.Honeypot && suggestion.Website != "" {
+		g.honeypot.Add(1)
+		return fmt.Errorf("%w: honeypot field is filled", ErrSpam)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.prune(now)
+
+	if err := g.verifyChallenge(suggestion.Challenge, suggestion.Nonce, now); err != nil {
+		g.challenge.Add(1)
+		return fmt.Errorf("%w: %w", ErrSpam, err)
+	}
+
+	ipHits := within(g.ipHits[ip], now.Add(-g.cfg.IPWindow))
+	g.hits = within(g.hits, now.Add(-g.cfg.GlobalWindow))
+
+	switch {
+	case len(ipHits) >= g.cfg.IPLimit:
+		g.rateLimited.Add(1)
+		return fmt.Errorf("%w: ip limit exceeded", ErrTooManyRequests)
+	case len(g.hits) >= g.cfg.GlobalLimit:
+		g.rateLimited.Add(1)
+		return fmt.Errorf("%w: global limit exceeded", ErrTooManyRequests)
+	}
+
+	g.ipHits[ip] = append(ipHits, now)
+	g.hits = append(g.hits, now)
+	if g.cfg.ChallengeDifficulty > 0 {
+		g.used[suggestion.Challenge] = now.Add(g.cfg.ChallengeTTL)
+	}
+
+	return nil
+}
+
+// verifyChallenge checks challenge token signature, expiration, reuse and proof-of-work nonce.
+func (g *SpamGuard) verifyChallenge(token, nonce string, now time.Time) error {
+	if g.cfg.ChallengeDifficulty <= 0 {
+		return nil
+	}
+
+	i := strings.LastIndexByte(token, '.')
+	if i < 0 || !hmac.Equal([]byte(token[i+1:]), []byte(g.sign(token[:i]))) {
+		return errors.New("invalid challenge")
+	}
+
+	expiresAt, err := strconv.ParseInt(token[:strings.IndexByte(token, '.')], 10, 64)
+	if err != nil || now.Unix() > expiresAt {
+		return errors.New("challenge expired")
+	}
+
+	if _, ok := g.used[token]; ok {
+		return errors.New("challenge already used")
+	}
+
+	if leadingZeroBits(sha256.Sum256([]byte(token+":"+nonce))) < g.cfg.ChallengeDifficulty {
+		return errors.New("invalid challenge nonce")
+	}
+
+	return nil
+}
+
+func (g *SpamGuard) sign(payload string) string {
+	mac := hmac.New(sha256.New, []byte(g.cfg.ChallengeSecret))
+	mac.Write([]byte(payload))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// prune removes expired rate limit hits and used challenges once per spamPruneInterval.
+func (g *SpamGuard) prune(now time.Time) {
+	if now.Before(g.pruneAt) {
+		return
+	}
+	g.pruneAt = now.Add(spamPruneInterval)
+
+	for ip, hits := range g.ipHits {
+		if hits = within(hits, now.Add(-g.cfg.IPWindow)); len(hits) == 0 {
+			delete(g.ipHits, ip)
+		} else {
+			g.ipHits[ip] = hits
+		}
+	}
+
+	for token, expiresAt := range g.used {
+		if now.After(expiresAt) {
+			delete(g.used, token)
+		}
+	}
+}
+
+// checkDuplicate rejects suggestion similar to one of the recent suggestions.
+func (g *SpamGuard) checkDuplicate(ctx context.Context, repo db.NewsRepo, suggestion NewsSuggestion, now time.Time) error {
+	if g == nil {
+		return nil
+	}
+
+	from := now.Add(-g.cfg.DuplicateWindow)
+	recent, err := repo.SuggestionsByFilters(ctx, &db.SuggestionSearch{CreatedAtFrom: &from}, db.Pager{PageSize: maxDuplicateCandidates},
+		db.WithColumns(db.Columns.Suggestion.Title, db.Columns.Suggestion.ShortText, db.Columns.Suggestion.Text),
+		repo.DefaultSuggestionSort(),
+	)
+	if err != nil {
+		return fmt.Errorf("read recent suggestions: %w", err)
+	}
+
+	shingles := textShingles(suggestion.Title, suggestion.ShortText, suggestion.Text)
+	for i := range recent {
+		if similarity(shingles, textShingles(recent[i].Title, recent[i].ShortText, recent[i].Text)) >= g.cfg.DuplicateThreshold {
+			g.duplicate.Add(1)
+			return fmt.Errorf("%w: duplicate of recent suggestion", ErrSpam)
+		}
+	}
+
+	return nil
+}
+
+// within returns times after from. Times must be sorted.
+func within(times []time.Time, from time.Time) []time.Time {
+	for i, t := range times {
+		if t.After(from) {
+			return times[i:]
+		}
+	}
+
+	return nil
+}
+
+func leadingZeroBits(hash [sha256.Size]byte) int {
+	var n int
+	for _, b := range hash {
+		n += bits.LeadingZeros8(b)
+		if b != 0 {
+			break
+		}
+	}
+
+	return n
+}
+
+// textShingles returns a set of adjacent word pairs of lowercased texts. A single word text is a set of one word.
+func textShingles(texts ...string) map[string]struct{} {
+	words := strings.FieldsFunc(strings.ToLower(strings.Join(texts, " ")), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	res := make(map[string]struct{}, len(words))
+	if len(words) == 1 {
+		res[words[0]] = struct{}{}
+	}
+
+	for i := 1; i < len(words); i++ {
+		res[words[i-1]+" "+words[i]] = struct{}{}
+	}
+
+	return res
+}
+
+// similarity returns Jaccard index of two shingle sets.
+func similarity(a, b map[string]struct{}) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	var common int
+	for s := range a {
+		if _, ok := b[s]; ok {
+			common++
+		}
+	}
+
+	return float64(common) / float64(len(a)+len(b)-common)
+}
@@ -0,0 +1,79 @@
+package newsportal
+
+import (
+	"crypto/sha256"
+	"strconv"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func solveChallenge(c *SuggestionChallenge) string {
+	for i := 0; ; i++ {
+		nonce := strconv.Itoa(i)
+		if leadingZeroBits(sha256.Sum256([]byte(c.Token+":"+nonce))) >= c.Difficulty {
+			return nonce
+		}
+	}
+}
+
+func TestSpamGuard_check(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	Convey("Test SpamGuard rate limits", t, func() {
+		g := NewSpamGuard(AntiSpamConfig{IPLimit: 2, GlobalLimit: 3})
+
+		So(g.check(NewsSuggestion{}, "10.0.0.1", now), ShouldBeNil)
+		So(g.check(NewsSuggestion{}, "10.0.0.1", now), ShouldBeNil)
+		So(g.check(NewsSuggestion{}, "10.0.0.1", now), ShouldWrap, ErrTooManyRequests)
+		So(g.check(NewsSuggestion{}, "10.0.0.2", now), ShouldBeNil)
+		So(g.check(NewsSuggestion{}, "10.0.0.3", now), ShouldWrap, ErrTooManyRequests)
+		So(g.check(NewsSuggestion{}, "10.0.0.1", now.Add(time.Hour)), ShouldBeNil)
+		So(g.Stats().RateLimited, ShouldEqual, 2)
+	})
+
+	Convey("Test SpamGuard honeypot", t, func() {
+		g := NewSpamGuard(AntiSpamConfig{Honeypot: true})
+
+		So(g.check(NewsSuggestion{Website: "http://spam"}, "10.0.0.1", now), ShouldWrap, ErrSpam)
+		So(g.check(NewsSuggestion{}, "10.0.0.1", now), ShouldBeNil)
+		So(g.Stats().Honeypot, ShouldEqual, 1)
+	})
+
+	Convey("Test SpamGuard challenge", t, func() {
+		g := NewSpamGuard(AntiSpamConfig{ChallengeDifficulty: 8, ChallengeSecret: "secret"})
+		c := g.NewChallenge(now)
+		So(c, ShouldNotBeNil)
+		nonce := solveChallenge(c)
+
+		So(g.check(NewsSuggestion{}, "10.0.0.1", now), ShouldWrap, ErrSpam)
+		So(g.check(NewsSuggestion{Challenge: c.Token + "0", Nonce: nonce}, "10.0.0.1", now), ShouldWrap, ErrSpam)
+		So(g.check(NewsSuggestion{Challenge: c.Token, Nonce: nonce}, "10.0.0.1", now.Add(time.Hour)), ShouldWrap, ErrSpam)
+		So(g.check(NewsSuggestion{Challenge: c.Token, Nonce: nonce}, "10.0.0.1", now), ShouldBeNil)
+		So(g.check(NewsSuggestion{Challenge: c.Token, Nonce: nonce}, "10.0.0.1", now), ShouldWrap, ErrSpam)
+		So(g.Stats().Challenge, ShouldEqual, 4)
+	})
+
+	Convey("Test SpamGuard empty challenge secret", t, func() {
+		g := NewSpamGuard(AntiSpamConfig{ChallengeDifficulty: 8})
+		So(g.cfg.ChallengeSecret, ShouldNotBeEmpty)
+
+		// token signed with empty key is rejected
+		forged := &SuggestionChallenge{Difficulty: 8}
+		payload := strconv.FormatInt(now.Add(time.Minute).Unix(), 10) + ".forged"
+		forged.Token = payload + "." + (&SpamGuard{}).sign(payload)
+		So(g.check(NewsSuggestion{Challenge: forged.Token, Nonce: solveChallenge(forged)}, "10.0.0.1", now), ShouldWrap, ErrSpam)
+	})
+}
+
+func TestSimilarity(t *testing.T) {
+	Convey("Test text similarity", t, func() {
+		a := textShingles("Cat in LA", "Drunk cat was found in Los Angeles")
+
+		So(similarity(a, textShingles("cat in la!", "Drunk cat was found in Los Angeles.")), ShouldEqual, 1)
+		So(similarity(a, textShingles("Cat in LA", "Drunk cat was found in Los Angeles yesterday")), ShouldBeGreaterThan, 0.8)
+		So(similarity(a, textShingles("Dog in NY", "Sober dog was lost in New York")), ShouldBeLessThan, 0.2)
+		So(similarity(a, textShingles()), ShouldEqual, 0)
+	})
+}
@@ -0,0 +1,74 @@
+package newsportal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"apisrv/pkg/db"
+)
+
+// ArchiveItem is a number of published news within a month or, if Day is set, within a day.
+type ArchiveItem struct {
+	Year  int
+	Month int
+	Day   int
+	Count int
+}
+
+func newArchiveItem(in db.NewsArchiveItem, period db.NewsArchivePeriod) ArchiveItem {
+	item := ArchiveItem{
+		Year:  in.Period.Year(),
+		Month: int(in.Period.Month()),
+		Count: in.Count,
+	}
+
+	if period == db.NewsArchiveDay {
+		item.Day = in.Period.Day()
+	}
+
+	return item
+}
+
+// archiveRange returns [from, to] range of the year or the month of the year in UTC.
+func archiveRange(year, month int) (from, to time.Time) {
+	if month == 0 {
+		from = time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		return from, from.AddDate(1, 0, 0).Add(-time.Microsecond)
+	}
+
+	from = time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+
+	return from, from.AddDate(0, 1, 0).Add(-time.Microsecond)
+}
+
+// GetArchive returns published news counts grouped by month, oldest first.
+// If year is set, only news of the year are counted. If month is set too, counts are grouped by day of the month.
+func (s *Service) GetArchive(ctx context.Context, filter NewsesFilter, year, month int) ([]ArchiveItem, error) {
+	if year < 0 || month < 0 || month > 12 || (month > 0 && year == 0) {
+		return nil, fmt.Errorf("%w: invalid archive period", ErrBadRequest)
+	}
+
+	period := db.NewsArchiveMonth
+	if month > 0 {
+		period = db.NewsArchiveDay
+	}
+
+	if year > 0 {
+		from, to := archiveRange(year, month)
+		filter = filter.withinRange(from, to)
+	}
+
+	items, err := s.repo.NewsArchive(ctx, filter.toDBSearch(), period, db.AlreadyPublished())
+	if err != nil {
+		return nil, fmt.Errorf("read news archive: %w", err)
+	}
+
+	res := make([]ArchiveItem, len(items))
+	for i := range items {
+		res[i] = newArchiveItem(items[i], period)
+	}
+
+	return res, nil
+}
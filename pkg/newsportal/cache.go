@@ -0,0 +1,227 @@
+package newsportal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/golang-lru/simplelru"
+)
+
+const (
+	defaultCacheTTL  = time.Minute
+	defaultCacheSize = 1000
+)
+
+// CacheConfig is a configuration of the news service response cache.
+type CacheConfig struct {
+	Enabled bool
+	// TTL is a lifetime of cached responses, default is 1m.
+	TTL time.Duration
+	// Size is a max number of cached responses, default is 1000.
+	Size int
+}
+
+// CacheStats is a cache hit/miss counters.
+type CacheStats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// errCallPanicked is returned to callers waiting for the call, which panicked.
+var errCallPanicked = errors.New("cached call panicked")
+
+type cacheEntry struct {
+	value     any
+	expiresAt time.Time
+}
+
+type cacheCall struct {
+	wg    sync.WaitGroup
+	value any
+	err   error
+}
+
+// Cache is an in-process LRU cache for Service responses.
+// Concurrent misses for the same key are served by a single call, so an expired entry doesn't cause a stampede.
+// All entries are dropped on Invalidate and when the nearest scheduled news gets published.
+type Cache struct {
+	ttl time.Duration
+
+	mu    sync.Mutex
+	lru   *simplelru.LRU
+	calls map[string]*cacheCall
+	// gen is incremented on each invalidation, responses of calls started before invalidation are not stored.
+	gen uint64
+	// flushAt is a publishedAt of the nearest scheduled news, zero if there are no scheduled news.
+	flushAt     time.Time
+	flushAtRead bool
+
+	hits, misses atomic.Uint64
+}
+
+func NewCache(cfg CacheConfig) *Cache {
+	if cfg.TTL <= 0 {
+		cfg.TTL = defaultCacheTTL
+	}
+
+	if cfg.Size <= 0 {
+		cfg.Size = defaultCacheSize
+	}
+
+	// error is returned only for non-positive size
+	l, _ := simplelru.NewLRU(cfg.Size, nil)
+
+	return &Cache{
+		ttl:   cfg.TTL,
+		lru:   l,
+		calls: make(map[string]*cacheCall),
+	}
+}
+
+// Invalidate drops all cached responses.
+func (c *Cache) Invalidate() {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.invalidate()
+}
+
+// Stats returns cache hit/miss counters.
+func (c *Cache) Stats() CacheStats {
+	return CacheStats{
+		Hits:   c.hits.Load(),
+		Misses: c.misses.Load(),
+	}
+}
+
+// Len returns number of cached responses.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lru.Len()
+}
+
+func (c *Cache) invalidate() {
+	c.lru.Purge()
+	c.gen++
+	c.flushAt, c.flushAtRead = time.Time{}, false
+}
+
+// lookup returns cached value or in-flight call for the key. If there is neither, it registers a new call.
+func (c *Cache) lookup(key string, now time.Time) (value any, call *cacheCall, leader bool, gen uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.flushAtRead && !c.flushAt.IsZero() && !now.Before(c.flushAt) {
+		c.invalidate()
+	}
+
+	if v, ok := c.lru.Get(key); ok {
+		entry := v.(cacheEntry)
+		if now.Before(entry.expiresAt) {
+			return entry.value, nil, false, c.gen
+		}
+		c.lru.Remove(key)
+	}
+
+	if call, ok := c.calls[key]; ok {
+		return nil, call, false, c.gen
+	}
+
+	call = &cacheCall{}
+	call.wg.Add(1)
+	c.calls[key] = call
+
+	return nil, call, true, c.gen
+}
+
+// store saves call result and releases waiting callers. Result is cached only if there was no invalidation since gen.
+func (c *Cache) store(key string, call *cacheCall, gen uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.calls, key)
+	if call.err == nil && gen == c.gen {
+		c.lru.Add(key, cacheEntry{value: call.value, expiresAt: time.Now().Add(c.ttl)})
+	}
+
+	call.wg.Done()
+}
+
+// setFlushAt sets the nearest scheduled news publishedAt, if there was no invalidation since gen.
+func (c *Cache) setFlushAt(at time.Time, gen uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if gen == c.gen {
+		c.flushAt, c.flushAtRead = at, true
+	}
+}
+
+func (c *Cache) needFlushAt() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return !c.flushAtRead
+}
+
+// cached returns cached response of fn for name and args or calls fn. Nil cache always calls fn.
+// Cached responses are shared, so callers get their deep copies made by clone.
+func cached[T any](ctx context.Context, s *Service, name string, args any, fn func() (T, error), clone func(T) T) (T, error) {
+	c := s.cache
+	if c == nil {
+		return fn()
+	}
+
+	key := name
+	if args != nil {
+		b, _ := json.Marshal(args)
+		key += ":" + string(b)
+	}
+
+	value, call, leader, gen := c.lookup(key, time.Now())
+	switch {
+	case call == nil:
+		c.hits.Add(1)
+
+		return clone(value.(T)), nil
+	case !leader:
+		c.hits.Add(1)
+		call.wg.Wait()
+		if call.err != nil {
+			var zero T
+			return zero, call.err
+		}
+
+		return clone(call.value.(T)), nil
+	}
+
+	c.misses.Add(1)
+
+	// waiting callers are released even if fn panics, they get errCallPanicked
+	call.err = errCallPanicked
+	defer c.store(key, call, gen)
+
+	if c.needFlushAt() {
+		if at, err := s.repo.NextPublishedAt(ctx); err == nil {
+			c.setFlushAt(at, gen)
+		}
+	}
+
+	res, err := fn()
+	if err == nil {
+		call.value = clone(res)
+	}
+	call.err = err
+
+	return res, err
+}
@@ -0,0 +1,147 @@
+package newsportal
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func newTestCachedService(ttl time.Duration) *Service {
+	cache := NewCache(CacheConfig{Enabled: true, TTL: ttl, Size: 10})
+	// skip scheduled news lookup, there is no db
+	cache.flushAtRead = true
+
+	return (&Service{}).WithCache(cache)
+}
+
+// same returns v as is, int values need no copy.
+func same(v int) int { return v }
+
+func TestCache(t *testing.T) {
+	Convey("Test Cache", t, func() {
+		ctx := context.Background()
+		s := newTestCachedService(time.Minute)
+
+		var calls atomic.Int32
+		fn := func() (int, error) {
+			calls.Add(1)
+			time.Sleep(10 * time.Millisecond)
+
+			return 42, nil
+		}
+
+		Convey("Concurrent calls are served once", func() {
+			var wg sync.WaitGroup
+			res := make([]int, 10)
+			for i := range res {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					res[i], _ = cached(ctx, s, "test", 1, fn, same)
+				}()
+			}
+			wg.Wait()
+
+			So(res, ShouldResemble, []int{42, 42, 42, 42, 42, 42, 42, 42, 42, 42})
+			So(calls.Load(), ShouldEqual, 1)
+			So(s.cache.Stats(), ShouldResemble, CacheStats{Hits: 9, Misses: 1})
+		})
+
+		Convey("Different args are cached separately", func() {
+			_, _ = cached(ctx, s, "test", 1, fn, same)
+			_, _ = cached(ctx, s, "test", 2, fn, same)
+			_, _ = cached(ctx, s, "test", 1, fn, same)
+
+			So(calls.Load(), ShouldEqual, 2)
+		})
+
+		Convey("Invalidate drops entries", func() {
+			_, _ = cached(ctx, s, "test", 1, fn, same)
+			s.cache.Invalidate()
+			// skip scheduled news lookup again
+			s.cache.setFlushAt(time.Time{}, s.cache.gen)
+			_, _ = cached(ctx, s, "test", 1, fn, same)
+
+			So(calls.Load(), ShouldEqual, 2)
+		})
+
+		Convey("Errors are not cached", func() {
+			errFn := func() (int, error) {
+				calls.Add(1)
+				return 0, errors.New("db is down")
+			}
+
+			_, err := cached(ctx, s, "test", 1, errFn, same)
+			So(err, ShouldBeError)
+			_, err = cached(ctx, s, "test", 1, errFn, same)
+			So(err, ShouldBeError)
+
+			So(calls.Load(), ShouldEqual, 2)
+		})
+
+		Convey("Panic releases waiting calls", func() {
+			started, release := make(chan struct{}), make(chan struct{})
+			panicFn := func() (int, error) {
+				close(started)
+				<-release
+				panic("boom")
+			}
+
+			go func() {
+				defer func() { _ = recover() }()
+				_, _ = cached(ctx, s, "test", 1, panicFn, same)
+			}()
+
+			<-started
+			errs := make(chan error, 1)
+			go func() {
+				_, err := cached(ctx, s, "test", 1, fn, same)
+				errs <- err
+			}()
+
+			// wait until the second call joins the first one
+			for s.cache.Stats().Hits == 0 {
+				time.Sleep(time.Millisecond)
+			}
+			close(release)
+
+			So(<-errs, ShouldEqual, errCallPanicked)
+
+			// failed call is not cached
+			res, err := cached(ctx, s, "test", 1, fn, same)
+			So(err, ShouldBeNil)
+			So(res, ShouldEqual, 42)
+		})
+
+		Convey("Cached values are copies", func() {
+			newsFn := func() (*News, error) {
+				return &News{ID: 1, TagIDs: []int{1, 2}, Category: &Category{ID: 1, Title: "Cats"}}, nil
+			}
+
+			news, err := cached(ctx, s, "news", 1, newsFn, (*News).clone)
+			So(err, ShouldBeNil)
+			news.TagIDs[0], news.Category.Title = 3, "Dogs"
+
+			news, err = cached(ctx, s, "news", 1, newsFn, (*News).clone)
+			So(err, ShouldBeNil)
+			So(news.TagIDs, ShouldResemble, []int{1, 2})
+			So(news.Category.Title, ShouldEqual, "Cats")
+			So(s.cache.Stats().Hits, ShouldEqual, 1)
+		})
+
+		Convey("Scheduled news publishing drops entries", func() {
+			_, _ = cached(ctx, s, "test", 1, fn, same)
+			s.cache.setFlushAt(time.Now().Add(-time.Second), s.cache.gen)
+
+			_, call, leader, _ := s.cache.lookup("test:1", time.Now())
+			So(call, ShouldNotBeNil)
+			So(leader, ShouldBeTrue)
+			So(s.cache.needFlushAt(), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,92 @@
+package newsportal
+
+import (
+	"context"
+	"fmt"
+
+	"apisrv/pkg/db"
+)
+
+const maxTagCloudLimit = 500
+
+// TagCount is a tag with number of published news using it.
+type TagCount struct {
+	Tag   Tag
+	Count int
+}
+
+// CategoryCount is a category with number of published news in it.
+type CategoryCount struct {
+	Category Category
+	Count    int
+}
+
+// GetTagCloud returns enabled tags with numbers of published news matched by filter, most used first.
+// Tags with less than minCount news are skipped, limit restricts number of returned tags (0 - no limit).
+func (s *Service) GetTagCloud(ctx context.Context, filter NewsesFilter, minCount, limit int) ([]TagCount, error) {
+	if limit <= 0 || limit > maxTagCloudLimit {
+		limit = maxTagCloudLimit
+	}
+
+	counts, err := s.repo.NewsTagCounts(ctx, filter.toDBSearch(), max(minCount, 1), db.Pager{PageSize: limit}, db.AlreadyPublished())
+	if err != nil {
+		return nil, fmt.Errorf("read tag counts: %w", err)
+	}
+
+	if len(counts) == 0 {
+		return []TagCount{}, nil
+	}
+
+	ids := make([]int, len(counts))
+	for i := range counts {
+		ids[i] = counts[i].ID
+	}
+
+	tags, err := s.repo.TagsByFilters(ctx, &db.TagSearch{IDs: ids}, db.PagerNoLimit)
+	if err != nil {
+		return nil, fmt.Errorf("read tags: %w", err)
+	}
+
+	index := NewTags(tags).Index()
+	res := make([]TagCount, 0, len(counts))
+	for _, c := range counts {
+		if tag, ok := index[c.ID]; ok {
+			res = append(res, TagCount{Tag: tag, Count: c.Count})
+		}
+	}
+
+	return res, nil
+}
+
+// GetCategoryCounts returns enabled categories with numbers of published news matched by filter, most used first.
+// Categories with less than minCount news are skipped.
+func (s *Service) GetCategoryCounts(ctx context.Context, filter NewsesFilter, minCount int) ([]CategoryCount, error) {
+	counts, err := s.repo.NewsCategoryCounts(ctx, filter.toDBSearch(), max(minCount, 1), db.AlreadyPublished())
+	if err != nil {
+		return nil, fmt.Errorf("read category counts: %w", err)
+	}
+
+	if len(counts) == 0 {
+		return []CategoryCount{}, nil
+	}
+
+	ids := make([]int, len(counts))
+	for i := range counts {
+		ids[i] = counts[i].ID
+	}
+
+	categories, err := s.repo.CategoriesByFilters(ctx, &db.CategorySearch{IDs: ids}, db.PagerNoLimit)
+	if err != nil {
+		return nil, fmt.Errorf("read categories: %w", err)
+	}
+
+	index := NewCategories(categories).Index()
+	res := make([]CategoryCount, 0, len(counts))
+	for _, c := range counts {
+		if category, ok := index[c.ID]; ok {
+			res = append(res, CategoryCount{Category: category, Count: c.Count})
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,81 @@
+package newsportal
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"apisrv/pkg/db"
+)
+
+// NewsCursor is an opaque position in the news list ordered by publishedAt desc, newsId desc.
+type NewsCursor struct {
+	PublishedAt time.Time
+	ID          int
+	// Backward is set for cursors pointing to the previous (newer) page.
+	Backward bool
+}
+
+type newsCursorPayload struct {
+	PublishedAt int64 `json:"p"`
+	ID          int   `json:"i"`
+	Backward    bool  `json:"b,omitempty"`
+}
+
+func newNextCursor(news News) *NewsCursor {
+	return &NewsCursor{PublishedAt: news.PublishedAt, ID: news.ID}
+}
+
+func newPrevCursor(news News) *NewsCursor {
+	return &NewsCursor{PublishedAt: news.PublishedAt, ID: news.ID, Backward: true}
+}
+
+// ParseNewsCursor decodes cursor string. Empty string is decoded to nil cursor.
+func ParseNewsCursor(s string) (*NewsCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid cursor", ErrBadRequest)
+	}
+
+	var p newsCursorPayload
+	if err = json.Unmarshal(b, &p); err != nil || p.ID <= 0 {
+		return nil, fmt.Errorf("%w: invalid cursor", ErrBadRequest)
+	}
+
+	return &NewsCursor{
+		PublishedAt: time.UnixMicro(p.PublishedAt).UTC(),
+		ID:          p.ID,
+		Backward:    p.Backward,
+	}, nil
+}
+
+// String encodes cursor to opaque string. Nil cursor is encoded to empty string.
+func (c *NewsCursor) String() string {
+	if c == nil {
+		return ""
+	}
+
+	b, _ := json.Marshal(newsCursorPayload{
+		PublishedAt: c.PublishedAt.UnixMicro(),
+		ID:          c.ID,
+		Backward:    c.Backward,
+	})
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func (c *NewsCursor) toDB() db.NewsKeyset {
+	return db.NewsKeyset{PublishedAt: c.PublishedAt, ID: c.ID}
+}
+
+// NewsPage is a keyset paginated slice of news list.
+type NewsPage struct {
+	Items      NewsList
+	NextCursor *NewsCursor
+	PrevCursor *NewsCursor
+}
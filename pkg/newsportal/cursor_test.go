@@ -0,0 +1,42 @@
+package newsportal
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestNewsCursor(t *testing.T) {
+	Convey("Test NewsCursor", t, func() {
+		Convey("Encode and parse", func() {
+			in := &NewsCursor{
+				PublishedAt: time.Date(2025, 9, 15, 10, 20, 30, 123456000, time.UTC),
+				ID:          42,
+				Backward:    true,
+			}
+
+			out, err := ParseNewsCursor(in.String())
+			So(err, ShouldBeNil)
+			So(out, ShouldResemble, in)
+		})
+
+		Convey("Empty cursor", func() {
+			var in *NewsCursor
+			So(in.String(), ShouldBeEmpty)
+
+			out, err := ParseNewsCursor("")
+			So(err, ShouldBeNil)
+			So(out, ShouldBeNil)
+		})
+
+		Convey("Invalid cursor", func() {
+			for _, s := range []string{"***", "bm90IGpzb24", "eyJwIjoxLCJpIjowfQ"} {
+				out, err := ParseNewsCursor(s)
+				So(errors.Is(err, ErrBadRequest), ShouldBeTrue)
+				So(out, ShouldBeNil)
+			}
+		})
+	})
+}
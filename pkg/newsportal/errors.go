@@ -6,5 +6,7 @@ var (
 	ErrNotFound   = errors.New("not found")
 	ErrBadRequest = errors.New("bad request")
 
-	errNotInTx = errors.New("not in transaction")
+	// ErrTooManyRequests and ErrSpam are returned for suggestions rejected by SpamGuard.
+	ErrTooManyRequests = errors.New("too many requests")
+	ErrSpam            = errors.New("rejected as spam")
 )
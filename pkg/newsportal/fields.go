@@ -0,0 +1,94 @@
+package newsportal
+
+import (
+	"fmt"
+
+	"apisrv/pkg/db"
+)
+
+// NewsField is a news list item field, which could be selected by client.
+type NewsField string
+
+const (
+	NewsFieldTitle       NewsField = "title"
+	NewsFieldShortText   NewsField = "shortText"
+	NewsFieldContent     NewsField = "content"
+	NewsFieldAuthor      NewsField = "author"
+	NewsFieldPublishedAt NewsField = "publishedAt"
+	NewsFieldCategory    NewsField = "category"
+	NewsFieldTags        NewsField = "tags"
+)
+
+// NewsFields is a set of selected news fields. Empty set means all fields.
+type NewsFields []NewsField
+
+// NewsSummaryFields are all news fields except content.
+var NewsSummaryFields = NewsFields{
+	NewsFieldTitle,
+	NewsFieldShortText,
+	NewsFieldAuthor,
+	NewsFieldPublishedAt,
+	NewsFieldCategory,
+	NewsFieldTags,
+}
+
+var newsFieldColumns = map[NewsField][]string{
+	NewsFieldTitle:       {db.Columns.News.Title},
+	NewsFieldShortText:   {db.Columns.News.ShortText},
+	NewsFieldContent:     {db.Columns.News.Content},
+	NewsFieldAuthor:      {db.Columns.News.Author},
+	NewsFieldPublishedAt: {db.Columns.News.PublishedAt},
+	NewsFieldCategory:    {db.Columns.News.CategoryID, db.Columns.News.Category},
+	NewsFieldTags:        {db.Columns.News.TagIDs},
+}
+
+// ParseNewsFields converts field names to NewsFields. Unknown field names cause ErrBadRequest.
+func ParseNewsFields(names []string) (NewsFields, error) {
+	res := make(NewsFields, 0, len(names))
+	for _, name := range names {
+		f := NewsField(name)
+		if _, ok := newsFieldColumns[f]; !ok {
+			return nil, fmt.Errorf("%w: unknown field %q", ErrBadRequest, name)
+		}
+
+		if !res.Has(f) {
+			res = append(res, f)
+		}
+	}
+
+	return res, nil
+}
+
+// Has checks if field is selected.
+func (ff NewsFields) Has(f NewsField) bool {
+	if len(ff) == 0 {
+		return true
+	}
+
+	for _, v := range ff {
+		if v == f {
+			return true
+		}
+	}
+
+	return false
+}
+
+// columns returns db select ops for selected fields. ID and slug are always selected, publishedAt is used for sorting,
+// updatedAt is used for Last-Modified of feeds.
+func (ff NewsFields) columns() db.OpFunc {
+	if len(ff) == 0 {
+		return db.WithColumns(db.Columns.News.Category)
+	}
+
+	cols := []string{db.Columns.News.ID, db.Columns.News.Slug, db.Columns.News.PublishedAt, db.Columns.News.UpdatedAt}
+	for _, f := range ff {
+		if f == NewsFieldPublishedAt {
+			continue
+		}
+
+		cols = append(cols, newsFieldColumns[f]...)
+	}
+
+	return db.WithColumns(cols...)
+}
@@ -1,10 +1,34 @@
 package newsportal
 
-import "apisrv/pkg/db"
+import (
+	"time"
+
+	"apisrv/pkg/db"
+)
+
+// TagMatch is a match mode for multiple tags filter.
+type TagMatch string
+
+const (
+	// TagMatchAny matches news tagged with any of the tags.
+	TagMatchAny TagMatch = "any"
+	// TagMatchAll matches news tagged with all of the tags.
+	TagMatchAll TagMatch = "all"
+)
 
 type NewsesFilter struct {
 	CategoryID int
 	TagID      int
+	// CategoryIDs matches news from any of the categories.
+	CategoryIDs []int
+	// TagIDs matches news by tags according to TagMatch, default is TagMatchAny.
+	TagIDs   []int
+	TagMatch TagMatch
+	// Query is a full-text search query in websearch syntax.
+	Query string
+	// PublishedFrom and PublishedTo are inclusive publishedAt range bounds.
+	PublishedFrom *time.Time
+	PublishedTo   *time.Time
 }
 
 func NewNewsFilter(categoryID int, tagID int) NewsesFilter {
@@ -25,5 +49,38 @@ func (f NewsesFilter) toDBSearch() *db.NewsSearch {
 		search.TagID = &f.TagID
 	}
 
-	return search
+	if len(f.CategoryIDs) > 0 {
+		search.CategoryIDs = f.CategoryIDs
+	}
+
+	if len(f.TagIDs) > 0 {
+		if f.TagMatch == TagMatchAll {
+			search.TagIDsAll = f.TagIDs
+		} else {
+			search.TagIDsAny = f.TagIDs
+		}
+	}
+
+	if f.PublishedFrom != nil {
+		search.PublishedFrom = f.PublishedFrom
+	}
+
+	if f.PublishedTo != nil {
+		search.PublishedTo = f.PublishedTo
+	}
+
+	return search.WithQuery(f.Query)
+}
+
+// withinRange narrows filter publishedAt range to [from, to].
+func (f NewsesFilter) withinRange(from, to time.Time) NewsesFilter {
+	if f.PublishedFrom == nil || f.PublishedFrom.Before(from) {
+		f.PublishedFrom = &from
+	}
+
+	if f.PublishedTo == nil || f.PublishedTo.After(to) {
+		f.PublishedTo = &to
+	}
+
+	return f
 }
@@ -0,0 +1,22 @@
+package newsportal
+
+import (
+	"strconv"
+)
+
+// Site page paths, they are shared by feeds, sitemaps and frontend, so canonical urls are the same everywhere.
+
+// NewsPath returns canonical site path of the news page.
+func NewsPath(slug string) string {
+	return "/news/" + slug
+}
+
+// CategoryPath returns canonical site path of the category page.
+func CategoryPath(slug string) string {
+	return "/category/" + slug
+}
+
+// TagPath returns canonical site path of the tag page.
+func TagPath(id int) string {
+	return "/tag/" + strconv.Itoa(id)
+}
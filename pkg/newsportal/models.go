@@ -1,6 +1,7 @@
 package newsportal
 
 import (
+	"slices"
 	"time"
 
 	"apisrv/pkg/db"
@@ -47,10 +48,32 @@ func (tag *Tag) ToDB() *db.Tag {
 type Category struct {
 	ID       int
 	Title    string
+	Slug     string
 	Sort     *int
 	StatusID int
 }
 
+// clone returns a deep copy of the category.
+func (category *Category) clone() *Category {
+	if category == nil {
+		return nil
+	}
+
+	c := *category
+	c.Sort = clonePtr(category.Sort)
+
+	return &c
+}
+
+// cloneCategories returns a deep copy of the list.
+func cloneCategories(list []Category) []Category {
+	if list == nil {
+		return nil
+	}
+
+	return MapP(list, (*Category).clone)
+}
+
 func NewCategory(in *db.Category) *Category {
 	if in == nil {
 		return nil
@@ -59,6 +82,7 @@ func NewCategory(in *db.Category) *Category {
 	return &Category{
 		ID:       in.ID,
 		Title:    in.Title,
+		Slug:     in.Slug,
 		Sort:     in.Sort,
 		StatusID: in.StatusID,
 	}
@@ -67,6 +91,7 @@ func NewCategory(in *db.Category) *Category {
 type News struct {
 	ID          int
 	Title       string
+	Slug        string
 	ShortText   string
 	Content     *string
 	Author      *string
@@ -74,9 +99,36 @@ type News struct {
 	TagIDs      []int
 	PublishedAt time.Time
 	CreatedAt   time.Time
+	UpdatedAt   time.Time
 	StatusID    int
 	Category    *Category
 	Tags        Tags
+	// Snippet is a highlighted fragment of text matched by full-text search query.
+	Snippet *string
+}
+
+// clone returns a deep copy of the news.
+func (news *News) clone() *News {
+	if news == nil {
+		return nil
+	}
+
+	c := *news
+	c.Content, c.Author, c.Snippet = clonePtr(news.Content), clonePtr(news.Author), clonePtr(news.Snippet)
+	c.TagIDs = slices.Clone(news.TagIDs)
+	c.Category = news.Category.clone()
+	c.Tags = slices.Clone(news.Tags)
+
+	return &c
+}
+
+// cloneNewsList returns a deep copy of the list.
+func cloneNewsList(list []News) []News {
+	if list == nil {
+		return nil
+	}
+
+	return MapP(list, (*News).clone)
 }
 
 func (news *News) SetTags(tags Tags) {
@@ -104,6 +156,7 @@ func NewNews(in *db.News) *News {
 	return &News{
 		ID:          in.ID,
 		Title:       in.Title,
+		Slug:        in.Slug,
 		ShortText:   in.ShortText,
 		Content:     in.Content,
 		Author:      in.Author,
@@ -111,11 +164,20 @@ func NewNews(in *db.News) *News {
 		TagIDs:      in.TagIDs,
 		PublishedAt: in.PublishedAt,
 		CreatedAt:   in.CreatedAt,
+		UpdatedAt:   in.UpdatedAt,
 		StatusID:    in.StatusID,
 		Category:    NewCategory(in.Category),
 	}
 }
 
+func (list NewsList) SetSnippets(snippets map[int]string) {
+	for i := range list {
+		if snippet, ok := snippets[list[i].ID]; ok {
+			list[i].Snippet = &snippet
+		}
+	}
+}
+
 func (list NewsList) SetTags(tags Tags) {
 	index := tags.Index()
 
@@ -137,22 +199,53 @@ type NewsSuggestion struct {
 	Title      string   `validate:"required,min=3,max=255" json:"title"`
 	Text       string   `validate:"required" json:"text"`
 	ShortText  string   `validate:"required,max=255" json:"shortText"`
-	Tags       []string `validate:"required,dive,alphanumunicode" json:"tags"`
+	Tags       []string `validate:"required,dive,alphanumunicode,max=64" json:"tags"`
 	CategoryID int      `validate:"required" json:"categoryId"`
+	Contact    string   `validate:"max=255" json:"contact"`
+
+	// Website is a honeypot field, it is hidden from users and must be empty.
+	Website string `json:"website"`
+	// Challenge and Nonce are a SuggestionChallenge token and its proof-of-work solution.
+	Challenge string `json:"challenge"`
+	Nonce     string `json:"nonce"`
 }
 
-func (ns *NewsSuggestion) ToDB(tagIDs ...int) *db.News {
+func (ns *NewsSuggestion) ToDB(ip string) *db.Suggestion {
 	if ns == nil {
 		return nil
 	}
 
-	return &db.News{
-		Title:      ns.Title,
-		ShortText:  ns.ShortText,
-		Content:    &ns.Text,
-		CategoryID: ns.CategoryID,
-		TagIDs:     tagIDs,
-		StatusID:   db.StatusDisabled,
+	suggestion := &db.Suggestion{
+		Title:              ns.Title,
+		ShortText:          ns.ShortText,
+		Text:               ns.Text,
+		CategoryID:         ns.CategoryID,
+		Tags:               ns.Tags,
+		IP:                 ip,
+		ModerationStatusID: db.ModerationPending,
+	}
+
+	if ns.Contact != "" {
+		suggestion.Contact = &ns.Contact
+	}
+
+	return suggestion
+}
+
+// Suggestion is a news suggestion added to moderation queue.
+type Suggestion struct {
+	ID    int
+	Title string
+}
+
+func NewSuggestion(in *db.Suggestion) *Suggestion {
+	if in == nil {
+		return nil
+	}
+
+	return &Suggestion{
+		ID:    in.ID,
+		Title: in.Title,
 	}
 }
 
@@ -4,18 +4,31 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"apisrv/pkg/db"
+	"apisrv/pkg/webhook"
 	"github.com/go-pg/pg/v10"
 
 	"github.com/go-playground/validator/v10"
 )
 
+const (
+	defaultCursorLimit  = 25
+	maxCursorLimit      = 100
+	defaultRelatedLimit = 5
+	maxRelatedLimit     = 20
+)
+
 type Service struct {
 	db        db.DB
 	repo      db.NewsRepo
 	validator *validator.Validate
-	activeTx  *pg.Tx
+	cache     *Cache
+	views     *ViewCounter
+	spam      *SpamGuard
+	hooks     webhook.Emitter
 }
 
 func NewNewsService(dbo db.DB) *Service {
@@ -26,39 +39,161 @@ func NewNewsService(dbo db.DB) *Service {
 		db:        dbo,
 		repo:      repo,
 		validator: validate,
+		hooks:     webhook.NewEmitter(dbo),
 	}
 }
 
-func (s *Service) WithinLock(ctx context.Context, lockName string, fn func(*Service) error) error {
-	return s.db.RunInLock(ctx, lockName, func(tx *pg.Tx) error {
-		locked := NewNewsService(s.db)
-		locked.repo = locked.repo.WithTransaction(tx)
-		locked.activeTx = tx
+// WithCache returns service copy, which caches GetList, GetNews, GetCategories and GetTags responses.
+func (s *Service) WithCache(cache *Cache) *Service {
+	cached := *s
+	cached.cache = cache
 
-		return fn(locked)
-	})
+	return &cached
+}
+
+// WithViewCounter returns service copy, which counts news views with vc.
+func (s *Service) WithViewCounter(vc *ViewCounter) *Service {
+	counted := *s
+	counted.views = vc
+
+	return &counted
 }
 
+// WithSpamGuard returns service copy, which checks suggestions with guard.
+func (s *Service) WithSpamGuard(guard *SpamGuard) *Service {
+	guarded := *s
+	guarded.spam = guard
+
+	return &guarded
+}
+
+// GetList returns news list page. Only selected fields are read from db, nil fields means all fields.
 func (s *Service) GetList(
 	ctx context.Context,
 	filter NewsesFilter,
 	page, perPage int,
+	fields NewsFields,
 ) ([]News, error) {
-	items, err := s.repo.NewsByFilters(
-		ctx,
-		filter.toDBSearch(),
-		db.NewPager(page, perPage),
+	args := []any{filter, page, perPage, fields}
+
+	return cached(ctx, s, "GetList", args, func() ([]News, error) {
+		return s.getList(ctx, filter, page, perPage, fields)
+	}, cloneNewsList)
+}
+
+func (s *Service) getList(ctx context.Context, filter NewsesFilter, page, perPage int, fields NewsFields) ([]News, error) {
+	ops := []db.OpFunc{
 		db.AlreadyPublished(),
-		db.WithColumns(db.Columns.News.Category),
-	)
+		fields.columns(),
+	}
+	if filter.Query != "" {
+		ops = append(ops, db.WithQueryRankSort(filter.Query))
+	}
+
+	items, err := s.repo.NewsByFilters(ctx, filter.toDBSearch(), db.NewPager(page, perPage), ops...)
 	if err != nil {
 		return nil, fmt.Errorf("read news list: %w", err)
 	}
 
-	return s.enrichNewsesWithTags(ctx, NewNewsList(items))
+	list, err := s.enrichNewsesWithTags(ctx, NewNewsList(items))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.enrichNewsesWithSnippets(ctx, list, filter.Query)
+}
+
+// LastUpdatedAt returns the latest change time of news matching filter, including deleted and disabled news.
+func (s *Service) LastUpdatedAt(ctx context.Context, filter NewsesFilter) (time.Time, error) {
+	last, err := s.repo.LastUpdatedAt(ctx, filter.toDBSearch())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("read news last updated time: %w", err)
+	}
+
+	return last, nil
+}
+
+// GetListByCursor returns news list page after (or before) the cursor.
+// Unlike GetList it is stable against news published between page loads.
+// Items are always sorted by publishedAt, full-text query (if any) is used as a filter only.
+func (s *Service) GetListByCursor(
+	ctx context.Context,
+	filter NewsesFilter,
+	cursor *NewsCursor,
+	limit int,
+	fields NewsFields,
+) (*NewsPage, error) {
+	if limit <= 0 || limit > maxCursorLimit {
+		limit = defaultCursorLimit
+	}
+
+	backward := cursor != nil && cursor.Backward
+	ops := []db.OpFunc{
+		db.AlreadyPublished(),
+		fields.columns(),
+		db.WithNewsKeysetSort(backward),
+	}
+	if cursor != nil {
+		ops = append(ops, db.WithNewsKeyset(cursor.toDB(), backward))
+	}
+
+	// fetch one extra item to find out if there is one more page
+	items, err := s.repo.NewsByFilters(ctx, filter.toDBSearch(), db.Pager{PageSize: limit + 1}, ops...)
+	if err != nil {
+		return nil, fmt.Errorf("read news list: %w", err)
+	}
+
+	hasMore := len(items) > limit
+	if hasMore {
+		items = items[:limit]
+	}
+
+	if backward {
+		slices.Reverse(items)
+	}
+
+	list, err := s.enrichNewsesWithTags(ctx, NewNewsList(items))
+	if err != nil {
+		return nil, err
+	}
+
+	if list, err = s.enrichNewsesWithSnippets(ctx, list, filter.Query); err != nil {
+		return nil, err
+	}
+
+	page := &NewsPage{Items: list}
+	if len(list) == 0 {
+		return page, nil
+	}
+
+	first, last := list[0], list[len(list)-1]
+	switch {
+	case cursor == nil:
+		if hasMore {
+			page.NextCursor = newNextCursor(last)
+		}
+	case backward:
+		page.NextCursor = newNextCursor(last)
+		if hasMore {
+			page.PrevCursor = newPrevCursor(first)
+		}
+	default:
+		page.PrevCursor = newPrevCursor(first)
+		if hasMore {
+			page.NextCursor = newNextCursor(last)
+		}
+	}
+
+	return page, nil
 }
 
 func (s *Service) GetNews(ctx context.Context, id int) (*News, error) {
+	return cached(ctx, s, "GetNews", id, func() (*News, error) {
+		return s.getNews(ctx, id)
+	}, (*News).clone)
+}
+
+func (s *Service) getNews(ctx context.Context, id int) (*News, error) {
 	dto, err := s.repo.OneNews(
 		ctx,
 		&db.NewsSearch{ID: &id},
@@ -77,6 +212,66 @@ func (s *Service) GetNews(ctx context.Context, id int) (*News, error) {
 	return s.enrichNewsWithTags(ctx, NewNews(dto))
 }
 
+// GetRelated returns published news related to the given one.
+// News with more common tags go first, then news from the same category, then the newest ones.
+func (s *Service) GetRelated(ctx context.Context, id, limit int) (NewsList, error) {
+	if limit <= 0 || limit > maxRelatedLimit {
+		limit = defaultRelatedLimit
+	}
+
+	news, err := s.GetNews(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.repo.NewsByFilters(ctx,
+		(&db.NewsSearch{}).WithRelatedTo(news.ID, news.CategoryID, news.TagIDs),
+		db.Pager{PageSize: limit},
+		db.AlreadyPublished(),
+		NewsSummaryFields.columns(),
+		db.WithRelatedSort(news.CategoryID, news.TagIDs),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("read related news: %w", err)
+	}
+
+	return s.enrichNewsesWithTags(ctx, NewNewsList(items))
+}
+
+// GetNewsBySlug returns published news by its current or old slug. Moved is set if the slug is an old one.
+func (s *Service) GetNewsBySlug(ctx context.Context, slug string) (news *News, moved bool, err error) {
+	id, moved, err := s.newsIDBySlug(ctx, slug)
+	if err != nil {
+		return nil, false, err
+	}
+
+	news, err = s.GetNews(ctx, id)
+
+	return news, moved, err
+}
+
+func (s *Service) newsIDBySlug(ctx context.Context, slug string) (id int, moved bool, err error) {
+	dto, err := s.repo.OneNews(ctx, &db.NewsSearch{Slug: &slug}, db.WithColumns(db.Columns.News.ID))
+	if err != nil {
+		return 0, false, fmt.Errorf("read news by slug: %w", err)
+	}
+
+	if dto != nil {
+		return dto.ID, false, nil
+	}
+
+	old, err := s.repo.OneNewsSlug(ctx, &db.NewsSlugSearch{Slug: &slug})
+	if err != nil {
+		return 0, false, fmt.Errorf("read old news slug: %w", err)
+	}
+
+	if old == nil {
+		return 0, false, ErrNotFound
+	}
+
+	return old.NewsID, true, nil
+}
+
 func (s *Service) GetCount(ctx context.Context, filter NewsesFilter) (int, error) {
 	count, err := s.repo.CountNews(ctx, filter.toDBSearch())
 	if err != nil {
@@ -86,36 +281,60 @@ func (s *Service) GetCount(ctx context.Context, filter NewsesFilter) (int, error
 	return count, nil
 }
 
-func (s *Service) GetCategories(ctx context.Context) ([]Category, error) {
-	categories, err := s.repo.CategoriesByFilters(ctx, nil, db.PagerNoLimit)
+func (s *Service) GetCategory(ctx context.Context, id int) (*Category, error) {
+	dto, err := s.repo.CategoryByID(ctx, id)
 	if err != nil {
-		return nil, fmt.Errorf("read categories from repo: %w", err)
+		return nil, fmt.Errorf("read category: %w", err)
 	}
 
-	return NewCategories(categories), nil
+	if dto == nil {
+		return nil, ErrNotFound
+	}
+
+	return NewCategory(dto), nil
+}
+
+func (s *Service) GetCategories(ctx context.Context) ([]Category, error) {
+	return cached(ctx, s, "GetCategories", nil, func() ([]Category, error) {
+		categories, err := s.repo.CategoriesByFilters(ctx, nil, db.PagerNoLimit)
+		if err != nil {
+			return nil, fmt.Errorf("read categories from repo: %w", err)
+		}
+
+		return NewCategories(categories), nil
+	}, cloneCategories)
 }
 
 func (s *Service) GetTags(ctx context.Context) ([]Tag, error) {
-	tags, err := s.repo.TagsByFilters(ctx, nil, db.PagerNoLimit)
-	if err != nil {
-		return nil, fmt.Errorf("read tags from repo: %w", err)
-	}
+	return cached(ctx, s, "GetTags", nil, func() ([]Tag, error) {
+		tags, err := s.repo.TagsByFilters(ctx, nil, db.PagerNoLimit)
+		if err != nil {
+			return nil, fmt.Errorf("read tags from repo: %w", err)
+		}
 
-	return NewTags(tags), nil
+		return NewTags(tags), nil
+	}, slices.Clone[[]Tag])
 }
 
-func (s *Service) ValidateSuggestion(ctx context.Context, req NewsSuggestion) (ValidationErrors, error) {
-	errs, _, err := s.validateSuggestion(ctx, req)
+func (s *Service) GetTag(ctx context.Context, id int) (*Tag, error) {
+	dto, err := s.repo.TagByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("read tag: %w", err)
+	}
+
+	if dto == nil {
+		return nil, ErrNotFound
+	}
 
-	return errs, err
+	return NewTag(dto), nil
 }
 
-func (s *Service) validateSuggestion(ctx context.Context, req NewsSuggestion) (ValidationErrors, *Category, error) {
+func (s *Service) ValidateSuggestion(ctx context.Context, req NewsSuggestion) (ValidationErrors, error) {
 	var res ValidationErrors
 
 	catDTO, err := s.repo.CategoryByID(ctx, req.CategoryID)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 	if catDTO == nil {
 		res = append(res, ValidationError{
@@ -123,94 +342,60 @@ func (s *Service) validateSuggestion(ctx context.Context, req NewsSuggestion) (V
 			Error: "category does not exist",
 		})
 	}
-	category := NewCategory(catDTO)
 
 	err = s.validator.StructCtx(ctx, req)
 	if err == nil {
-		return res, category, nil
+		return res, nil
 	}
 
 	var errs validator.ValidationErrors
 	if !errors.As(err, &errs) {
-		return nil, nil, err
+		return nil, err
 	}
 
 	res = append(res, NewValidationErrors(errs)...)
 
-	return res, category, nil
+	return res, nil
 }
 
-func (s *Service) Suggest(ctx context.Context, suggestion NewsSuggestion) (*News, error) {
-	var news *News
-
-	err := s.WithinLock(ctx, "news.Suggest", func(s *Service) error {
-		vErrs, category, err := s.validateSuggestion(ctx, suggestion)
-		if err != nil {
-			return err
-		}
-		if len(vErrs) > 0 {
-			return ErrBadRequest
-		}
-
-		tags, err := s.txCreateNonExistentTags(ctx, suggestion.Tags)
-		if err != nil {
-			return err
-		}
-
-		dto, err := s.repo.AddNews(ctx, suggestion.ToDB(tags.IDs()...))
-		if err != nil {
-			return err
-		}
-
-		news = NewNews(dto)
-		news.SetTags(tags)
-		news.Category = category
+// SuggestionChallenge returns proof-of-work challenge for Suggest or nil if it is not required.
+func (s *Service) SuggestionChallenge() *SuggestionChallenge {
+	return s.spam.NewChallenge(time.Now())
+}
 
-		return nil
-	})
+// Suggest adds news suggestion from ip to moderation queue.
+// Suggestions rejected by spam guard return ErrTooManyRequests or ErrSpam.
+func (s *Service) Suggest(ctx context.Context, suggestion NewsSuggestion, ip string) (*Suggestion, error) {
+	// invalid suggestions don't use rate limits and challenges
+	vErrs, err := s.ValidateSuggestion(ctx, suggestion)
 	if err != nil {
 		return nil, err
 	}
-
-	return news, nil
-}
-
-func (s *Service) txCreateNonExistentTags(ctx context.Context, names []string) (Tags, error) {
-	if err := s.requireTx(); err != nil {
-		return nil, err
+	if len(vErrs) > 0 {
+		return nil, ErrBadRequest
 	}
 
-	if len(names) == 0 {
-		return nil, nil
+	now := time.Now()
+	if err = s.spam.check(suggestion, ip, now); err != nil {
+		return nil, err
 	}
 
-	tags, err := s.repo.TagsByFilters(ctx, &db.TagSearch{NameIn: names}, db.PagerNoLimit)
-	if err != nil && !errors.Is(err, pg.ErrNoRows) {
+	if err = s.spam.checkDuplicate(ctx, s.repo, suggestion, now); err != nil {
 		return nil, err
 	}
 
-	index := NewTags(tags).IndexByName()
-	res := make(Tags, 0, len(names))
-
-	for _, name := range names {
-		if tag, ok := index[name]; ok {
-			res = append(res, tag)
-
-			continue
-		}
-
-		dto, err := s.repo.AddTag(ctx, &db.Tag{
-			Name:     name,
-			StatusID: db.StatusEnabled,
-		})
-		if err != nil {
-			return nil, err
+	var dto *db.Suggestion
+	err = s.db.RunInTransaction(ctx, func(tx *pg.Tx) (err error) {
+		if dto, err = s.repo.WithTransaction(tx).AddSuggestion(ctx, suggestion.ToDB(ip)); err != nil {
+			return err
 		}
-
-		res = append(res, *(NewTag(dto)))
+		return s.hooks.WithTransaction(tx).Emit(ctx, webhook.EventSuggestionCreated, webhook.NewSuggestion(dto))
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return res, nil
+	return NewSuggestion(dto), nil
 }
 
 func (s *Service) enrichNewsWithTags(ctx context.Context, news *News) (*News, error) {
@@ -242,10 +427,17 @@ func (s *Service) enrichNewsesWithTags(ctx context.Context, newses NewsList) (Ne
 	return newses, nil
 }
 
-func (s *Service) requireTx() error {
-	if s.activeTx == nil {
-		return errNotInTx
+func (s *Service) enrichNewsesWithSnippets(ctx context.Context, newses NewsList, query string) (NewsList, error) {
+	if query == "" || len(newses) == 0 {
+		return newses, nil
+	}
+
+	snippets, err := s.repo.NewsHeadlines(ctx, newses.IDs(), query)
+	if err != nil {
+		return nil, fmt.Errorf("read news snippets: %w", err)
 	}
 
-	return nil
+	newses.SetSnippets(snippets)
+
+	return newses, nil
 }
@@ -19,3 +19,13 @@ func Map[T, M any](a []T, f func(T) M) []M {
 
 	return n
 }
+
+// clonePtr returns a pointer to a copy of the value or nil.
+func clonePtr[T any](v *T) *T {
+	if v == nil {
+		return nil
+	}
+
+	c := *v
+	return &c
+}
@@ -0,0 +1,264 @@
+package newsportal
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"apisrv/pkg/db"
+
+	"github.com/hashicorp/golang-lru/simplelru"
+	"github.com/vmkteam/embedlog"
+)
+
+const (
+	defaultViewsFlushInterval = 30 * time.Second
+	defaultViewsDedupWindow   = time.Hour
+	defaultViewsDedupSize     = 100_000
+	defaultMostReadLimit      = 10
+	maxMostReadLimit          = 50
+)
+
+// ViewsPeriod is a period of most read news ranking.
+type ViewsPeriod string
+
+const (
+	ViewsPeriodDay   ViewsPeriod = "day"
+	ViewsPeriodWeek  ViewsPeriod = "week"
+	ViewsPeriodMonth ViewsPeriod = "month"
+)
+
+// since returns the first day of the period ending today.
+func (p ViewsPeriod) since(now time.Time) (time.Time, error) {
+	switch p {
+	case ViewsPeriodDay:
+		return now, nil
+	case ViewsPeriodWeek:
+		return now.AddDate(0, 0, -6), nil
+	case ViewsPeriodMonth:
+		return now.AddDate(0, -1, 1), nil
+	}
+
+	return time.Time{}, fmt.Errorf("%w: unknown period %q", ErrBadRequest, p)
+}
+
+// ViewCounterConfig is a configuration of news views counter.
+type ViewCounterConfig struct {
+	// FlushInterval is an interval of writing buffered views to db, default is 30s.
+	FlushInterval time.Duration
+	// DedupWindow is a period, during which repeated views of the same news from the same IP are not counted, default is 1h.
+	DedupWindow time.Duration
+	// DedupSize is a max number of remembered news and IP pairs, the oldest ones are forgotten first, default is 100000.
+	DedupSize int
+}
+
+type viewKey struct {
+	newsID int
+	ip     string
+}
+
+type viewDay struct {
+	newsID int
+	date   string
+}
+
+// ViewCounter counts news views deduplicated by client IP. Client IP is taken from X-Real-IP header only behind trusted proxies,
+// so it could not be forged to inflate views. Views are buffered in memory and written to db by Run.
+type ViewCounter struct {
+	embedlog.Logger
+	repo db.NewsRepo
+	cfg  ViewCounterConfig
+
+	mu sync.Mutex
+	// seen is a time of the last counted view by viewKey, least recently counted first.
+	seen   *simplelru.LRU
+	buffer map[viewDay]int
+}
+
+func NewViewCounter(dbo db.DB, logger embedlog.Logger, cfg ViewCounterConfig) *ViewCounter {
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = defaultViewsFlushInterval
+	}
+
+	if cfg.DedupWindow <= 0 {
+		cfg.DedupWindow = defaultViewsDedupWindow
+	}
+
+	if cfg.DedupSize <= 0 {
+		cfg.DedupSize = defaultViewsDedupSize
+	}
+
+	// error is returned only for non-positive size
+	seen, _ := simplelru.NewLRU(cfg.DedupSize, nil)
+
+	return &ViewCounter{
+		Logger: logger,
+		repo:   db.NewNewsRepo(dbo),
+		cfg:    cfg,
+		seen:   seen,
+		buffer: make(map[viewDay]int),
+	}
+}
+
+// Track counts news view, if there were no views of the news from ip within dedup window. It returns true if view is counted.
+func (vc *ViewCounter) Track(newsID int, ip string, now time.Time) bool {
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+
+	key := viewKey{newsID: newsID, ip: ip}
+	if at, ok := vc.seen.Peek(key); ok && now.Sub(at.(time.Time)) < vc.cfg.DedupWindow {
+		return false
+	}
+
+	vc.seen.Add(key, now)
+	vc.buffer[viewDay{newsID: newsID, date: now.Format(time.DateOnly)}]++
+
+	return true
+}
+
+// Run flushes buffered views every flush interval until ctx is done.
+func (vc *ViewCounter) Run(ctx context.Context) {
+	ticker := time.NewTicker(vc.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := vc.Flush(ctx); err != nil {
+				vc.Error(ctx, "flush news views", "err", err)
+			}
+		}
+	}
+}
+
+// Flush writes buffered views to db. On error views are returned to buffer.
+func (vc *ViewCounter) Flush(ctx context.Context) error {
+	buffer := vc.takeBuffer(time.Now())
+	if len(buffer) == 0 {
+		return nil
+	}
+
+	views := make([]db.NewsViews, 0, len(buffer))
+	for day, count := range buffer {
+		date, _ := time.Parse(time.DateOnly, day.date)
+		views = append(views, db.NewsViews{NewsID: day.newsID, Date: date, Count: count})
+	}
+
+	if err := vc.repo.AddNewsViews(ctx, views); err != nil {
+		vc.restoreBuffer(buffer)
+		return fmt.Errorf("write news views: %w", err)
+	}
+
+	return nil
+}
+
+// takeBuffer returns buffered views and resets buffer. Expired dedup entries are removed.
+func (vc *ViewCounter) takeBuffer(now time.Time) map[viewDay]int {
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+
+	for {
+		_, at, ok := vc.seen.GetOldest()
+		if !ok || now.Sub(at.(time.Time)) < vc.cfg.DedupWindow {
+			break
+		}
+		vc.seen.RemoveOldest()
+	}
+
+	buffer := vc.buffer
+	vc.buffer = make(map[viewDay]int, len(buffer))
+
+	return buffer
+}
+
+func (vc *ViewCounter) restoreBuffer(buffer map[viewDay]int) {
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+
+	for day, count := range buffer {
+		vc.buffer[day] += count
+	}
+}
+
+// NewsViews is a news with number of views.
+type NewsViews struct {
+	News  News
+	Views int
+}
+
+// cloneNewsViews returns a deep copy of the list.
+func cloneNewsViews(list []NewsViews) []NewsViews {
+	return Map(list, func(nv NewsViews) NewsViews {
+		return NewsViews{News: *nv.News.clone(), Views: nv.Views}
+	})
+}
+
+// TrackView counts published news view from ip. Views without ip are not counted.
+func (s *Service) TrackView(ctx context.Context, id int, ip string) (bool, error) {
+	if _, err := s.GetNews(ctx, id); err != nil {
+		return false, err
+	}
+
+	if s.views == nil || ip == "" {
+		return false, nil
+	}
+
+	return s.views.Track(id, ip, time.Now()), nil
+}
+
+// GetMostRead returns published news with the most views for the period, most viewed first.
+func (s *Service) GetMostRead(ctx context.Context, period ViewsPeriod, limit int) ([]NewsViews, error) {
+	if limit <= 0 || limit > maxMostReadLimit {
+		limit = defaultMostReadLimit
+	}
+
+	since, err := period.since(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	args := []any{period, limit}
+
+	return cached(ctx, s, "GetMostRead", args, func() ([]NewsViews, error) {
+		return s.getMostRead(ctx, since, limit)
+	}, cloneNewsViews)
+}
+
+func (s *Service) getMostRead(ctx context.Context, since time.Time, limit int) ([]NewsViews, error) {
+	counts, err := s.repo.MostViewedNews(ctx, nil, since, db.Pager{PageSize: limit}, db.AlreadyPublished())
+	if err != nil {
+		return nil, fmt.Errorf("read news views: %w", err)
+	}
+
+	if len(counts) == 0 {
+		return []NewsViews{}, nil
+	}
+
+	ids := make([]int, len(counts))
+	for i := range counts {
+		ids[i] = counts[i].ID
+	}
+
+	items, err := s.repo.NewsByFilters(ctx, &db.NewsSearch{IDs: ids}, db.PagerNoLimit, NewsSummaryFields.columns())
+	if err != nil {
+		return nil, fmt.Errorf("read news list: %w", err)
+	}
+
+	list, err := s.enrichNewsesWithTags(ctx, NewNewsList(items))
+	if err != nil {
+		return nil, err
+	}
+
+	index := list.Index()
+	res := make([]NewsViews, 0, len(counts))
+	for _, c := range counts {
+		if news, ok := index[c.ID]; ok {
+			res = append(res, NewsViews{News: news, Views: c.Count})
+		}
+	}
+
+	return res, nil
+}
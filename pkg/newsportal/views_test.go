@@ -0,0 +1,59 @@
+package newsportal
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"apisrv/pkg/db"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/vmkteam/embedlog"
+)
+
+func TestViewCounter_Track(t *testing.T) {
+	Convey("Test ViewCounter Track", t, func() {
+		vc := NewViewCounter(db.DB{}, embedlog.Logger{}, ViewCounterConfig{DedupWindow: time.Hour, DedupSize: 3})
+		now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+
+		So(vc.Track(1, "10.0.0.1", now), ShouldBeTrue)
+		So(vc.Track(1, "10.0.0.1", now.Add(time.Minute)), ShouldBeFalse)
+		So(vc.Track(1, "10.0.0.2", now), ShouldBeTrue)
+		So(vc.Track(2, "10.0.0.1", now), ShouldBeTrue)
+		So(vc.Track(1, "10.0.0.1", now.Add(time.Hour)), ShouldBeTrue)
+
+		buffer := vc.takeBuffer(now.Add(90 * time.Minute))
+		So(buffer, ShouldResemble, map[viewDay]int{
+			{newsID: 1, date: "2024-05-10"}: 3,
+			{newsID: 2, date: "2024-05-10"}: 1,
+		})
+		So(vc.buffer, ShouldBeEmpty)
+		So(vc.seen.Len(), ShouldEqual, 1)
+
+		vc.restoreBuffer(buffer)
+		So(vc.buffer, ShouldResemble, buffer)
+
+		// the oldest views are forgotten, when dedup size is reached
+		for i := range 4 {
+			So(vc.Track(3, "10.0.1."+strconv.Itoa(i), now), ShouldBeTrue)
+		}
+		So(vc.seen.Len(), ShouldEqual, 3)
+		So(vc.Track(3, "10.0.1.0", now), ShouldBeTrue)
+		So(vc.Track(3, "10.0.1.3", now), ShouldBeFalse)
+	})
+
+	Convey("Test ViewsPeriod", t, func() {
+		now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+
+		since, err := ViewsPeriodWeek.since(now)
+		So(err, ShouldBeNil)
+		So(since.Format(time.DateOnly), ShouldEqual, "2024-05-04")
+
+		since, err = ViewsPeriodMonth.since(now)
+		So(err, ShouldBeNil)
+		So(since.Format(time.DateOnly), ShouldEqual, "2024-04-11")
+
+		_, err = ViewsPeriod("year").since(now)
+		So(err, ShouldWrap, ErrBadRequest)
+	})
+}
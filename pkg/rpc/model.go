@@ -1,23 +1,94 @@
 package rpc
 
 import (
+	"strings"
 	"time"
 
 	"apisrv/pkg/newsportal"
 )
 
 //go:generate go tool colgen -imports=apisrv/pkg/newsportal
-//colgen:News,Category,Tag,ValidationError
+//colgen:News,NewsSummary,Category,Tag,ValidationError,ArchiveItem,TagCount,CategoryCount
 //colgen:News:MapP(newsportal.News)
+//colgen:NewsSummary:MapP(newsportal.News)
 //colgen:Category:MapP(newsportal.Category)
 //colgen:Tag:MapP(newsportal.Tag)
 //colgen:ValidationError:MapP(newsportal.ValidationError)
+//colgen:ArchiveItem:MapP(newsportal.ArchiveItem)
+//colgen:TagCount:MapP(newsportal.TagCount)
+//colgen:CategoryCount:MapP(newsportal.CategoryCount)
 
 type NewsListReq struct {
 	CategoryID int `json:"categoryId"`
 	TagID      int `json:"tagId"`
 	Page       int `json:"page"`
 	PerPage    int `json:"perPage"`
+	// CategoryIDs matches news from any of the categories.
+	CategoryIDs []int `json:"categoryIds"`
+	// TagIDs matches news tagged with any (default) or all of the tags, see TagMatch.
+	TagIDs []int `json:"tagIds"`
+	// TagMatch is a TagIDs match mode: any or all.
+	TagMatch string `json:"tagMatch"`
+	// PublishedFrom and PublishedTo are inclusive publishedAt range bounds.
+	PublishedFrom *time.Time `json:"publishedFrom"`
+	PublishedTo   *time.Time `json:"publishedTo"`
+	// Cursor is an opaque nextCursor/prevCursor value from NewsPage, used by GetByCursor only.
+	Cursor string `json:"cursor"`
+	// Query is a full-text search query over title, shortText and content.
+	// Supports "quoted phrases", OR and -exclusions.
+	Query string `json:"query"`
+	// Fields is a list of returned fields: title, shortText, content, author, publishedAt, category, tags.
+	// Default is all fields except content. ID, slug and publishedAt are always returned.
+	Fields []string `json:"fields"`
+}
+
+func (r NewsListReq) ToDomain() newsportal.NewsesFilter {
+	filter := newsportal.NewNewsFilter(r.CategoryID, r.TagID)
+	filter.Query = strings.TrimSpace(r.Query)
+	filter.CategoryIDs = r.CategoryIDs
+	filter.TagIDs = r.TagIDs
+	filter.TagMatch = newsportal.TagMatch(r.TagMatch)
+	filter.PublishedFrom = r.PublishedFrom
+	filter.PublishedTo = r.PublishedTo
+
+	return filter
+}
+
+// NewsFields returns selected news fields or NewsSummaryFields if no fields selected.
+func (r NewsListReq) NewsFields() (newsportal.NewsFields, error) {
+	if len(r.Fields) == 0 {
+		return newsportal.NewsSummaryFields, nil
+	}
+
+	return newsportal.ParseNewsFields(r.Fields)
+}
+
+type NewsPage struct {
+	List       NewsSummaries `json:"list"`
+	NextCursor *string       `json:"nextCursor"`
+	PrevCursor *string       `json:"prevCursor"`
+}
+
+func NewNewsPage(in *newsportal.NewsPage) *NewsPage {
+	if in == nil {
+		return nil
+	}
+
+	return &NewsPage{
+		List:       NewNewsSummaries(in.Items),
+		NextCursor: cursorString(in.NextCursor),
+		PrevCursor: cursorString(in.PrevCursor),
+	}
+}
+
+func cursorString(in *newsportal.NewsCursor) *string {
+	if in == nil {
+		return nil
+	}
+
+	s := in.String()
+
+	return &s
 }
 
 type NewsCountResponse struct {
@@ -31,6 +102,7 @@ type APIError struct {
 type News struct {
 	ID          int       `json:"id"`
 	Title       string    `json:"title"`
+	Slug        string    `json:"slug"`
 	ShortText   string    `json:"shortText"`
 	Content     *string   `json:"content"`
 	Author      *string   `json:"author"`
@@ -38,6 +110,8 @@ type News struct {
 
 	Category *Category `json:"category"`
 	Tags     Tags      `json:"tags"`
+	// Snippet is a highlighted with <b> tag text fragment, set for full-text search results only.
+	Snippet *string `json:"snippet"`
 }
 
 func NewNews(in *newsportal.News) *News {
@@ -48,13 +122,104 @@ func NewNews(in *newsportal.News) *News {
 	return &News{
 		ID:          in.ID,
 		Title:       in.Title,
+		Slug:        in.Slug,
 		ShortText:   in.ShortText,
 		Content:     in.Content,
 		Author:      in.Author,
 		PublishedAt: in.PublishedAt,
 		Category:    NewCategory(in.Category),
 		Tags:        NewTags(in.Tags),
+		Snippet:     in.Snippet,
+	}
+}
+
+// NewsBySlug is a news found by slug. Moved is set if the slug is an old one, news.slug is the current one.
+type NewsBySlug struct {
+	News  *News `json:"news"`
+	Moved bool  `json:"moved"`
+}
+
+// NewsSummary is a news list item. Unselected fields are omitted.
+type NewsSummary struct {
+	ID          int        `json:"id"`
+	Slug        string     `json:"slug"`
+	Title       string     `json:"title,omitempty"`
+	ShortText   string     `json:"shortText,omitempty"`
+	Content     *string    `json:"content,omitempty"`
+	Author      *string    `json:"author,omitempty"`
+	PublishedAt *time.Time `json:"publishedAt,omitempty"`
+
+	Category *Category `json:"category,omitempty"`
+	Tags     Tags      `json:"tags,omitempty"`
+	// Snippet is a highlighted with <b> tag text fragment, set for full-text search results only.
+	Snippet *string `json:"snippet,omitempty"`
+}
+
+func NewNewsSummary(in *newsportal.News) *NewsSummary {
+	if in == nil {
+		return nil
+	}
+
+	news := &NewsSummary{
+		ID:        in.ID,
+		Slug:      in.Slug,
+		Title:     in.Title,
+		ShortText: in.ShortText,
+		Content:   in.Content,
+		Author:    in.Author,
+		Category:  NewCategory(in.Category),
+		Tags:      NewTags(in.Tags),
+		Snippet:   in.Snippet,
+	}
+
+	if !in.PublishedAt.IsZero() {
+		news.PublishedAt = &in.PublishedAt
+	}
+
+	return news
+}
+
+// NewsViews is a news with number of views for the period.
+type NewsViews struct {
+	News  *NewsSummary `json:"news"`
+	Views int          `json:"views"`
+}
+
+func NewNewsViews(in *newsportal.NewsViews) *NewsViews {
+	if in == nil {
+		return nil
+	}
+
+	return &NewsViews{
+		News:  NewNewsSummary(&in.News),
+		Views: in.Views,
+	}
+}
+
+// ArchiveItem is a number of published news within a month or, if day is set, within a day.
+type ArchiveItem struct {
+	Year  int  `json:"year"`
+	Month int  `json:"month"`
+	Day   *int `json:"day"`
+	Count int  `json:"count"`
+}
+
+func NewArchiveItem(in *newsportal.ArchiveItem) *ArchiveItem {
+	if in == nil {
+		return nil
+	}
+
+	item := &ArchiveItem{
+		Year:  in.Year,
+		Month: in.Month,
+		Count: in.Count,
+	}
+
+	if in.Day > 0 {
+		item.Day = &in.Day
 	}
+
+	return item
 }
 
 type NewsSuggestion struct {
@@ -63,6 +228,13 @@ type NewsSuggestion struct {
 	ShortText  string
 	CategoryID int
 	Tags       []string
+	// Contact is an optional submitter contact: email, phone, etc.
+	Contact string
+	// Website is a honeypot field, it should be hidden from users and left empty.
+	Website string
+	// Challenge is a SuggestChallenge token and Nonce is its solution, required if challenge is enabled.
+	Challenge string
+	Nonce     string
 }
 
 func (ns NewsSuggestion) ToDomain() newsportal.NewsSuggestion {
@@ -72,12 +244,51 @@ func (ns NewsSuggestion) ToDomain() newsportal.NewsSuggestion {
 		ShortText:  ns.ShortText,
 		Tags:       ns.Tags,
 		CategoryID: ns.CategoryID,
+		Contact:    ns.Contact,
+		Website:    ns.Website,
+		Challenge:  ns.Challenge,
+		Nonce:      ns.Nonce,
+	}
+}
+
+// SuggestionChallenge is a proof-of-work task for Suggest: find a nonce, such that sha256(token + ":" + nonce) has difficulty leading zero bits.
+type SuggestionChallenge struct {
+	Token      string `json:"token"`
+	Difficulty int    `json:"difficulty"`
+}
+
+func NewSuggestionChallenge(in *newsportal.SuggestionChallenge) *SuggestionChallenge {
+	if in == nil {
+		return nil
+	}
+
+	return &SuggestionChallenge{
+		Token:      in.Token,
+		Difficulty: in.Difficulty,
+	}
+}
+
+// Suggestion is a news suggestion added to moderation queue.
+type Suggestion struct {
+	ID    int    `json:"id"`
+	Title string `json:"title"`
+}
+
+func NewSuggestion(in *newsportal.Suggestion) *Suggestion {
+	if in == nil {
+		return nil
+	}
+
+	return &Suggestion{
+		ID:    in.ID,
+		Title: in.Title,
 	}
 }
 
 type Category struct {
 	ID    int    `json:"id"`
 	Title string `json:"title"`
+	Slug  string `json:"slug"`
 }
 
 func NewCategory(in *newsportal.Category) *Category {
@@ -88,6 +299,7 @@ func NewCategory(in *newsportal.Category) *Category {
 	return &Category{
 		ID:    in.ID,
 		Title: in.Title,
+		Slug:  in.Slug,
 	}
 }
 
@@ -109,6 +321,46 @@ func NewTag(in *newsportal.Tag) *Tag {
 	}
 }
 
+// TagCount is a tag with number of published news using it.
+type TagCount struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+func NewTagCount(in *newsportal.TagCount) *TagCount {
+	if in == nil {
+		return nil
+	}
+
+	return &TagCount{
+		ID:    in.Tag.ID,
+		Name:  in.Tag.Name,
+		Count: in.Count,
+	}
+}
+
+// CategoryCount is a category with number of published news in it.
+type CategoryCount struct {
+	ID    int    `json:"id"`
+	Title string `json:"title"`
+	Slug  string `json:"slug"`
+	Count int    `json:"count"`
+}
+
+func NewCategoryCount(in *newsportal.CategoryCount) *CategoryCount {
+	if in == nil {
+		return nil
+	}
+
+	return &CategoryCount{
+		ID:    in.Category.ID,
+		Title: in.Category.Title,
+		Slug:  in.Category.Slug,
+		Count: in.Count,
+	}
+}
+
 type ValidationError struct {
 	Field      string `json:"field"`
 	Error      string `json:"error"`
@@ -5,6 +5,10 @@ import (
 	"apisrv/pkg/newsportal"
 )
 
+type ArchiveItems []ArchiveItem
+
+func NewArchiveItems(in []newsportal.ArchiveItem) ArchiveItems { return MapP(in, NewArchiveItem) }
+
 type Categories []Category
 
 func (ll Categories) IDs() []int {
@@ -25,6 +29,28 @@ func (ll Categories) Index() map[int]Category {
 
 func NewCategories(in []newsportal.Category) Categories { return MapP(in, NewCategory) }
 
+type CategoryCounts []CategoryCount
+
+func (ll CategoryCounts) IDs() []int {
+	r := make([]int, len(ll))
+	for i := range ll {
+		r[i] = ll[i].ID
+	}
+	return r
+}
+
+func (ll CategoryCounts) Index() map[int]CategoryCount {
+	r := make(map[int]CategoryCount, len(ll))
+	for i := range ll {
+		r[ll[i].ID] = ll[i]
+	}
+	return r
+}
+
+func NewCategoryCounts(in []newsportal.CategoryCount) CategoryCounts {
+	return MapP(in, NewCategoryCount)
+}
+
 type NewsList []News
 
 func (ll NewsList) IDs() []int {
@@ -45,6 +71,26 @@ func (ll NewsList) Index() map[int]News {
 
 func NewNewsList(in []newsportal.News) NewsList { return MapP(in, NewNews) }
 
+type NewsSummaries []NewsSummary
+
+func (ll NewsSummaries) IDs() []int {
+	r := make([]int, len(ll))
+	for i := range ll {
+		r[i] = ll[i].ID
+	}
+	return r
+}
+
+func (ll NewsSummaries) Index() map[int]NewsSummary {
+	r := make(map[int]NewsSummary, len(ll))
+	for i := range ll {
+		r[ll[i].ID] = ll[i]
+	}
+	return r
+}
+
+func NewNewsSummaries(in []newsportal.News) NewsSummaries { return MapP(in, NewNewsSummary) }
+
 type Tags []Tag
 
 func (ll Tags) IDs() []int {
@@ -65,6 +111,26 @@ func (ll Tags) Index() map[int]Tag {
 
 func NewTags(in []newsportal.Tag) Tags { return MapP(in, NewTag) }
 
+type TagCounts []TagCount
+
+func (ll TagCounts) IDs() []int {
+	r := make([]int, len(ll))
+	for i := range ll {
+		r[i] = ll[i].ID
+	}
+	return r
+}
+
+func (ll TagCounts) Index() map[int]TagCount {
+	r := make(map[int]TagCount, len(ll))
+	for i := range ll {
+		r[ll[i].ID] = ll[i]
+	}
+	return r
+}
+
+func NewTagCounts(in []newsportal.TagCount) TagCounts { return MapP(in, NewTagCount) }
+
 type ValidationErrors []ValidationError
 
 func NewValidationErrors(in []newsportal.ValidationError) ValidationErrors {
@@ -5,6 +5,8 @@ import (
 	"errors"
 
 	"apisrv/pkg/newsportal"
+
+	zm "github.com/vmkteam/zenrpc-middleware"
 	"github.com/vmkteam/zenrpc/v2"
 )
 
@@ -22,23 +24,56 @@ func NewNewsService(service *newsportal.Service) *NewsService {
 	}
 }
 
-func (ctrl NewsService) Get(ctx context.Context, req NewsListReq) ([]News, error) {
+// Get returns news list page. Use fields to select only rendered fields, content is not returned by default.
+func (ctrl NewsService) Get(ctx context.Context, req NewsListReq) ([]NewsSummary, error) {
+	fields, err := req.NewsFields()
+	if err != nil {
+		return nil, newBadRequestError(err)
+	}
+
 	items, err := ctrl.service.GetList(
 		ctx,
-		newsportal.NewNewsFilter(req.CategoryID, req.TagID),
+		req.ToDomain(),
 		req.Page,
 		req.PerPage,
+		fields,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	// TODO: switch to summary
-	resp := NewNewsList(items)
+	resp := NewNewsSummaries(items)
 
 	return resp, nil
 }
 
+// GetByCursor returns news list page using keyset pagination. Page field of the request is ignored,
+// pass nextCursor or prevCursor of the previous response to load adjacent page.
+func (ctrl NewsService) GetByCursor(ctx context.Context, req NewsListReq) (*NewsPage, error) {
+	cursor, err := newsportal.ParseNewsCursor(req.Cursor)
+	if err != nil {
+		return nil, newBadRequestError(err)
+	}
+
+	fields, err := req.NewsFields()
+	if err != nil {
+		return nil, newBadRequestError(err)
+	}
+
+	page, err := ctrl.service.GetListByCursor(
+		ctx,
+		req.ToDomain(),
+		cursor,
+		req.PerPage,
+		fields,
+	)
+	if err != nil {
+		return nil, newInternalError(err)
+	}
+
+	return NewNewsPage(page), nil
+}
+
 func (ctrl NewsService) GetByID(ctx context.Context, id int) (*News, error) {
 	item, err := ctrl.service.GetNews(ctx, id)
 	if err != nil {
@@ -50,10 +85,106 @@ func (ctrl NewsService) GetByID(ctx context.Context, id int) (*News, error) {
 	return resp, nil
 }
 
+// GetBySlug returns news by its current or old slug. If moved is set, client should redirect to the current news slug.
+//
+//zenrpc:slug news slug
+//zenrpc:404 News not found
+func (ctrl NewsService) GetBySlug(ctx context.Context, slug string) (*NewsBySlug, error) {
+	item, moved, err := ctrl.service.GetNewsBySlug(ctx, slug)
+
+	switch {
+	case errors.Is(err, newsportal.ErrNotFound):
+		return nil, newNotFoundError(err)
+	case err != nil:
+		return nil, newInternalError(err)
+	}
+
+	return &NewsBySlug{News: NewNews(item), Moved: moved}, nil
+}
+
+// TrackView counts news view. Repeated views from the same IP are not counted for a while.
+//
+//zenrpc:id news id
+//zenrpc:return true if view is counted
+//zenrpc:404 News not found
+func (ctrl NewsService) TrackView(ctx context.Context, id int) (bool, error) {
+	ok, err := ctrl.service.TrackView(ctx, id, zm.IPFromContext(ctx))
+
+	switch {
+	case errors.Is(err, newsportal.ErrNotFound):
+		return false, newNotFoundError(err)
+	case err != nil:
+		return false, newInternalError(err)
+	}
+
+	return ok, nil
+}
+
+// MostRead returns news with the most views for the last day, week or month, most viewed first.
+//
+//zenrpc:period day, week or month
+//zenrpc:limit max number of news, default 10, max 50
+//zenrpc:400 Unknown period
+func (ctrl NewsService) MostRead(ctx context.Context, period string, limit int) ([]NewsViews, error) {
+	items, err := ctrl.service.GetMostRead(ctx, newsportal.ViewsPeriod(period), limit)
+
+	switch {
+	case errors.Is(err, newsportal.ErrBadRequest):
+		return nil, newBadRequestError(err)
+	case err != nil:
+		return nil, newInternalError(err)
+	}
+
+	resp := make([]NewsViews, 0, len(items))
+	for i := range items {
+		resp = append(resp, *NewNewsViews(&items[i]))
+	}
+
+	return resp, nil
+}
+
+// Related returns "read also" news for the given one: news with more common tags first,
+// then news from the same category, then the newest ones.
+//
+//zenrpc:id news id
+//zenrpc:limit max number of news, default 5, max 20
+//zenrpc:404 News not found
+func (ctrl NewsService) Related(ctx context.Context, id, limit int) ([]NewsSummary, error) {
+	items, err := ctrl.service.GetRelated(ctx, id, limit)
+
+	switch {
+	case errors.Is(err, newsportal.ErrNotFound):
+		return nil, newNotFoundError(err)
+	case err != nil:
+		return nil, newInternalError(err)
+	}
+
+	return NewNewsSummaries(items), nil
+}
+
+// Archive returns published news counts grouped by month, oldest first. Only filter fields of req are used.
+// If year is set, only news of the year are counted. If month is set too, counts are grouped by day of the month.
+//
+//zenrpc:year year, optional
+//zenrpc:month month 1-12, optional, requires year
+//zenrpc:400 Invalid year or month
+func (ctrl NewsService) Archive(ctx context.Context, req NewsListReq, year, month int) ([]ArchiveItem, error) {
+	items, err := ctrl.service.GetArchive(ctx, req.ToDomain(), year, month)
+
+	switch {
+	case errors.Is(err, newsportal.ErrBadRequest):
+		return nil, newBadRequestError(err)
+	case err != nil:
+		return nil, newInternalError(err)
+	}
+
+	return NewArchiveItems(items), nil
+}
+
 func (ctrl NewsService) Count(ctx context.Context, req NewsListReq) (int, error) {
 	count, err := ctrl.service.GetCount(
 		ctx,
-		newsportal.NewNewsFilter(req.CategoryID, req.TagID),
+		req.ToDomain(),
 	)
 	if err != nil {
 		return 0, err
@@ -84,6 +215,32 @@ func (ctrl NewsService) Tags(ctx context.Context) ([]Tag, error) {
 	return resp, nil
 }
 
+// TagCloud returns tags with numbers of published news using them, most used first.
+//
+//zenrpc:categoryId count news of the category only, optional
+//zenrpc:minCount skip tags with less news, optional
+//zenrpc:top max number of tags, optional
+func (ctrl NewsService) TagCloud(ctx context.Context, categoryID, minCount, top int) ([]TagCount, error) {
+	items, err := ctrl.service.GetTagCloud(ctx, newsportal.NewNewsFilter(categoryID, 0), minCount, top)
+	if err != nil {
+		return nil, newInternalError(err)
+	}
+
+	return NewTagCounts(items), nil
+}
+
+// CategoryCloud returns categories with numbers of published news in them, most used first.
+//
+//zenrpc:minCount skip categories with less news, optional
+func (ctrl NewsService) CategoryCloud(ctx context.Context, minCount int) ([]CategoryCount, error) {
+	items, err := ctrl.service.GetCategoryCounts(ctx, newsportal.NewsesFilter{}, minCount)
+	if err != nil {
+		return nil, newInternalError(err)
+	}
+
+	return NewCategoryCounts(items), nil
+}
+
 func (ctrl NewsService) ValidateSuggestion(ctx context.Context, req NewsSuggestion) (ValidationErrors, error) {
 	dtos, err := ctrl.service.ValidateSuggestion(ctx, req.ToDomain())
 	if err != nil {
@@ -96,15 +253,29 @@ func (ctrl NewsService) ValidateSuggestion(ctx context.Context, req NewsSuggesti
 	return NewValidationErrors(dtos), nil
 }
 
-func (ctrl NewsService) Suggest(ctx context.Context, req NewsSuggestion) (*News, error) {
-	dto, err := ctrl.service.Suggest(ctx, req.ToDomain())
+// SuggestChallenge returns proof-of-work challenge, which solution must be sent with Suggest. It returns null if challenge is disabled.
+func (ctrl NewsService) SuggestChallenge() (*SuggestionChallenge, error) {
+	return NewSuggestionChallenge(ctrl.service.SuggestionChallenge()), nil
+}
+
+// Suggest adds news suggestion to moderation queue.
+//
+//zenrpc:400 Invalid suggestion
+//zenrpc:422 Suggestion is rejected as spam
+//zenrpc:429 Too many suggestions
+func (ctrl NewsService) Suggest(ctx context.Context, req NewsSuggestion) (*Suggestion, error) {
+	dto, err := ctrl.service.Suggest(ctx, req.ToDomain(), zm.IPFromContext(ctx))
 
 	switch {
 	case errors.Is(err, newsportal.ErrBadRequest):
 		return nil, newBadRequestError(err)
+	case errors.Is(err, newsportal.ErrSpam):
+		return nil, newSpamError(err)
+	case errors.Is(err, newsportal.ErrTooManyRequests):
+		return nil, newTooManyRequestsError(err)
 	case err != nil:
 		return nil, newInternalError(err)
 	}
 
-	return NewNews(dto), nil
+	return NewSuggestion(dto), nil
 }
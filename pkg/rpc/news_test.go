@@ -59,6 +59,31 @@ func TestDB_NewsService_Get(t *testing.T) {
 					Req:         rpc.NewsListReq{TagID: 100500},
 					LenExpected: 0,
 				},
+				{
+					Name:        "With any of categories",
+					Req:         rpc.NewsListReq{CategoryIDs: []int{1, 100500}},
+					LenExpected: 1,
+				},
+				{
+					Name:        "With any of tags",
+					Req:         rpc.NewsListReq{TagIDs: []int{1, 100500}},
+					LenExpected: 1,
+				},
+				{
+					Name:        "With all of tags",
+					Req:         rpc.NewsListReq{TagIDs: []int{1, 100500}, TagMatch: "all"},
+					LenExpected: 0,
+				},
+				{
+					Name:        "With full-text query",
+					Req:         rpc.NewsListReq{Query: "drunk cats"},
+					LenExpected: 1,
+				},
+				{
+					Name:        "With unmatched full-text query",
+					Req:         rpc.NewsListReq{Query: "elephant"},
+					LenExpected: 0,
+				},
 			}
 
 			for _, testCase := range positiveCases {
@@ -79,6 +104,48 @@ func TestDB_NewsService_Get(t *testing.T) {
 	})
 }
 
+func TestDB_NewsService_GetByCursor(t *testing.T) {
+	Convey("Test NewsService GetByCursor", t, func() {
+		ctx := t.Context()
+		srv := initRPC(t)
+
+		Convey("First page", func() {
+			page, err := srv.GetByCursor(ctx, rpc.NewsListReq{PerPage: 1})
+
+			So(err, ShouldBeNil)
+			So(page, ShouldNotBeNil)
+			So(page.List, ShouldHaveLength, 1)
+			So(page.PrevCursor, ShouldBeNil)
+			So(page.NextCursor, ShouldBeNil)
+		})
+
+		Convey("Invalid cursor", func() {
+			page, err := srv.GetByCursor(ctx, rpc.NewsListReq{Cursor: "invalid"})
+
+			So(err, ShouldBeError)
+			So(page, ShouldBeNil)
+		})
+
+		Convey("Selected fields", func() {
+			page, err := srv.GetByCursor(ctx, rpc.NewsListReq{Fields: []string{"title", "content"}})
+
+			So(err, ShouldBeNil)
+			So(page.List, ShouldNotBeEmpty)
+			So(page.List[0].Title, ShouldNotBeEmpty)
+			So(page.List[0].Content, ShouldNotBeNil)
+			So(page.List[0].ShortText, ShouldBeEmpty)
+			So(page.List[0].Category, ShouldBeNil)
+		})
+
+		Convey("Unknown field", func() {
+			page, err := srv.GetByCursor(ctx, rpc.NewsListReq{Fields: []string{"password"}})
+
+			So(err, ShouldBeError)
+			So(page, ShouldBeNil)
+		})
+	})
+}
+
 func TestDB_NewsService_GetByID(t *testing.T) {
 	Convey("Test NewsService GetByID", t, func() {
 		ctx := t.Context()
@@ -101,6 +168,134 @@ func TestDB_NewsService_GetByID(t *testing.T) {
 	})
 }
 
+func TestDB_NewsService_GetBySlug(t *testing.T) {
+	Convey("Test NewsService GetBySlug", t, func() {
+		ctx := t.Context()
+		srv := initRPC(t)
+
+		Convey("Current slug", func() {
+			res, err := srv.GetBySlug(ctx, "drunk-cat-occurred-massive-traffic-jam-in-the-la")
+
+			So(err, ShouldBeNil)
+			So(res.Moved, ShouldBeFalse)
+			So(res.News.Slug, ShouldEqual, "drunk-cat-occurred-massive-traffic-jam-in-the-la")
+		})
+
+		Convey("Old slug", func() {
+			res, err := srv.GetBySlug(ctx, "drunk-cat-in-la")
+
+			So(err, ShouldBeNil)
+			So(res.Moved, ShouldBeTrue)
+			So(res.News.Slug, ShouldEqual, "drunk-cat-occurred-massive-traffic-jam-in-the-la")
+		})
+
+		Convey("Unpublished news", func() {
+			res, err := srv.GetBySlug(ctx, "drunk-cats-build-starship")
+
+			So(err, ShouldBeError)
+			So(res, ShouldBeNil)
+		})
+
+		Convey("Unknown slug", func() {
+			res, err := srv.GetBySlug(ctx, "elephant")
+
+			So(err, ShouldBeError)
+			So(res, ShouldBeNil)
+		})
+	})
+}
+
+func TestDB_NewsService_Related(t *testing.T) {
+	Convey("Test NewsService Related", t, func() {
+		ctx := t.Context()
+		srv := initRPC(t)
+
+		Convey("Valid ID", func() {
+			list, err := srv.Related(ctx, 5, 3)
+
+			So(err, ShouldBeNil)
+			So(len(list), ShouldBeLessThanOrEqualTo, 3)
+			for _, news := range list {
+				So(news.ID, ShouldNotEqual, 5)
+			}
+		})
+
+		Convey("Unknown ID", func() {
+			list, err := srv.Related(ctx, 100500, 0)
+
+			So(err, ShouldBeError)
+			So(list, ShouldBeNil)
+		})
+	})
+}
+
+func TestDB_NewsService_Archive(t *testing.T) {
+	Convey("Test NewsService Archive", t, func() {
+		ctx := t.Context()
+		srv := initRPC(t)
+
+		Convey("By month", func() {
+			list, err := srv.Archive(ctx, rpc.NewsListReq{}, 0, 0)
+
+			So(err, ShouldBeNil)
+			So(list, ShouldHaveLength, 1)
+			So(list[0].Count, ShouldEqual, 1)
+			So(list[0].Day, ShouldBeNil)
+		})
+
+		Convey("By day", func() {
+			months, err := srv.Archive(ctx, rpc.NewsListReq{}, 0, 0)
+			So(err, ShouldBeNil)
+			So(months, ShouldNotBeEmpty)
+
+			list, err := srv.Archive(ctx, rpc.NewsListReq{}, months[0].Year, months[0].Month)
+
+			So(err, ShouldBeNil)
+			So(list, ShouldHaveLength, 1)
+			So(list[0].Day, ShouldNotBeNil)
+		})
+
+		Convey("Month without year", func() {
+			list, err := srv.Archive(ctx, rpc.NewsListReq{}, 0, 5)
+
+			So(err, ShouldBeError)
+			So(list, ShouldBeNil)
+		})
+	})
+}
+
+func TestDB_NewsService_TagCloud(t *testing.T) {
+	Convey("Test NewsService TagCloud", t, func() {
+		ctx := t.Context()
+		srv := initRPC(t)
+
+		Convey("All tags", func() {
+			list, err := srv.TagCloud(ctx, 0, 0, 0)
+
+			So(err, ShouldBeNil)
+			So(list, ShouldHaveLength, 1)
+			So(list[0].ID, ShouldEqual, 1)
+			So(list[0].Count, ShouldEqual, 1)
+		})
+
+		Convey("With min count", func() {
+			list, err := srv.TagCloud(ctx, 0, 2, 0)
+
+			So(err, ShouldBeNil)
+			So(list, ShouldBeEmpty)
+		})
+
+		Convey("Categories", func() {
+			list, err := srv.CategoryCloud(ctx, 0)
+
+			So(err, ShouldBeNil)
+			So(list, ShouldHaveLength, 1)
+			So(list[0].ID, ShouldEqual, 1)
+			So(list[0].Count, ShouldEqual, 1)
+		})
+	})
+}
+
 func TestDB_NewsService_ValidateSuggestion(t *testing.T) {
 	Convey("Test NewsService ValidateSuggestion", t, func() {
 		ctx := t.Context()
@@ -150,3 +345,56 @@ func TestDB_NewsService_ValidateSuggestion(t *testing.T) {
 		}
 	})
 }
+
+func TestDB_NewsService_MostRead(t *testing.T) {
+	Convey("Test NewsService MostRead", t, func() {
+		ctx := t.Context()
+		srv := initRPC(t)
+
+		Convey("Valid period", func() {
+			list, err := srv.MostRead(ctx, "week", 5)
+
+			So(err, ShouldBeNil)
+			So(len(list), ShouldBeLessThanOrEqualTo, 5)
+		})
+
+		Convey("Unknown period", func() {
+			_, err := srv.MostRead(ctx, "year", 5)
+
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Track view of unknown news", func() {
+			_, err := srv.TrackView(ctx, -1)
+
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestDB_NewsService_Suggest(t *testing.T) {
+	Convey("Test NewsService Suggest", t, func() {
+		ctx := t.Context()
+		srv := initRPC(t)
+
+		Convey("Valid suggestion", func() {
+			suggestion, err := srv.Suggest(ctx, rpc.NewsSuggestion{
+				Title:      "Test",
+				Text:       "Test text",
+				ShortText:  "Test",
+				CategoryID: 1,
+				Tags:       []string{"Mascots"},
+				Contact:    "test@example.com",
+			})
+
+			So(err, ShouldBeNil)
+			So(suggestion.ID, ShouldBeGreaterThan, 0)
+		})
+
+		Convey("Invalid suggestion", func() {
+			_, err := srv.Suggest(ctx, rpc.NewsSuggestion{Title: "1"})
+
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
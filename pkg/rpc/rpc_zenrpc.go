@@ -11,15 +11,24 @@ import (
 )
 
 var RPC = struct {
-	NewsService struct{ Get, GetByID, Count, Categories, Tags, ValidateSuggestion, Suggest string }
+	NewsService struct{ Get, GetByCursor, GetByID, GetBySlug, TrackView, MostRead, Related, Archive, Count, Categories, Tags, TagCloud, CategoryCloud, ValidateSuggestion, SuggestChallenge, Suggest string }
 }{
-	NewsService: struct{ Get, GetByID, Count, Categories, Tags, ValidateSuggestion, Suggest string }{
+	NewsService: struct{ Get, GetByCursor, GetByID, GetBySlug, TrackView, MostRead, Related, Archive, Count, Categories, Tags, TagCloud, CategoryCloud, ValidateSuggestion, SuggestChallenge, Suggest string }{
 		Get:                "get",
+		GetByCursor:        "getbycursor",
 		GetByID:            "getbyid",
+		GetBySlug:          "getbyslug",
+		TrackView:          "trackview",
+		MostRead:           "mostread",
+		Related:            "related",
+		Archive:            "archive",
 		Count:              "count",
 		Categories:         "categories",
 		Tags:               "tags",
+		TagCloud:           "tagcloud",
+		CategoryCloud:      "categorycloud",
 		ValidateSuggestion: "validatesuggestion",
+		SuggestChallenge:   "suggestchallenge",
 		Suggest:            "suggest",
 	},
 }
@@ -28,6 +37,7 @@ func (NewsService) SMD() smd.ServiceInfo {
 	return smd.ServiceInfo{
 		Methods: map[string]smd.Service{
 			"Get": {
+				Description: `Get returns news list page. Use fields to select only rendered fields, content is not returned by default.`,
 				Parameters: []smd.JSONSchema{
 					{
 						Name:     "req",
@@ -50,23 +60,79 @@ func (NewsService) SMD() smd.ServiceInfo {
 								Name: "perPage",
 								Type: smd.Integer,
 							},
+							{
+								Name:        "categoryIds",
+								Description: `CategoryIDs matches news from any of the categories.`,
+								Type:        smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+							{
+								Name:        "tagIds",
+								Description: `TagIDs matches news tagged with any (default) or all of the tags, see TagMatch.`,
+								Type:        smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+							{
+								Name:        "tagMatch",
+								Description: `TagMatch is a TagIDs match mode: any or all.`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "publishedFrom",
+								Optional:    true,
+								Description: `PublishedFrom and PublishedTo are inclusive publishedAt range bounds.`,
+								Type:        smd.String,
+							},
+							{
+								Name:     "publishedTo",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:        "cursor",
+								Description: `Cursor is an opaque nextCursor/prevCursor value from NewsPage, used by GetByCursor only.`,
+								Type:        smd.String,
+							},
+							{
+								Name: "query",
+								Description: `Query is a full-text search query over title, shortText and content.
+Supports "quoted phrases", OR and -exclusions.`,
+								Type: smd.String,
+							},
+							{
+								Name: "fields",
+								Description: `Fields is a list of returned fields: title, shortText, content, author, publishedAt, category, tags.
+Default is all fields except content. ID, slug and publishedAt are always returned.`,
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.String,
+								},
+							},
 						},
 					},
 				},
 				Returns: smd.JSONSchema{
 					Type:     smd.Array,
-					TypeName: "[]News",
+					TypeName: "[]NewsSummary",
 					Items: map[string]string{
-						"$ref": "#/definitions/News",
+						"$ref": "#/definitions/NewsSummary",
 					},
 					Definitions: map[string]smd.Definition{
-						"News": {
+						"NewsSummary": {
 							Type: "object",
 							Properties: smd.PropertyList{
 								{
 									Name: "id",
 									Type: smd.Integer,
 								},
+								{
+									Name: "slug",
+									Type: smd.String,
+								},
 								{
 									Name: "title",
 									Type: smd.String,
@@ -86,8 +152,9 @@ func (NewsService) SMD() smd.ServiceInfo {
 									Type:     smd.String,
 								},
 								{
-									Name: "publishedAt",
-									Type: smd.String,
+									Name:     "publishedAt",
+									Optional: true,
+									Type:     smd.String,
 								},
 								{
 									Name:     "category",
@@ -100,6 +167,12 @@ func (NewsService) SMD() smd.ServiceInfo {
 									Ref:  "#/definitions/Tags",
 									Type: smd.Object,
 								},
+								{
+									Name:        "snippet",
+									Optional:    true,
+									Description: `Snippet is a highlighted with <b> tag text fragment, set for full-text search results only.`,
+									Type:        smd.String,
+								},
 							},
 						},
 						"Category": {
@@ -113,6 +186,10 @@ func (NewsService) SMD() smd.ServiceInfo {
 									Name: "title",
 									Type: smd.String,
 								},
+								{
+									Name: "slug",
+									Type: smd.String,
+								},
 							},
 						},
 						"Tags": {
@@ -122,6 +199,115 @@ func (NewsService) SMD() smd.ServiceInfo {
 					},
 				},
 			},
+			"GetByCursor": {
+				Description: `GetByCursor returns news list page using keyset pagination. Page field of the request is ignored,
+pass nextCursor or prevCursor of the previous response to load adjacent page.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:     "req",
+						Type:     smd.Object,
+						TypeName: "NewsListReq",
+						Properties: smd.PropertyList{
+							{
+								Name: "categoryId",
+								Type: smd.Integer,
+							},
+							{
+								Name: "tagId",
+								Type: smd.Integer,
+							},
+							{
+								Name: "page",
+								Type: smd.Integer,
+							},
+							{
+								Name: "perPage",
+								Type: smd.Integer,
+							},
+							{
+								Name:        "categoryIds",
+								Description: `CategoryIDs matches news from any of the categories.`,
+								Type:        smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+							{
+								Name:        "tagIds",
+								Description: `TagIDs matches news tagged with any (default) or all of the tags, see TagMatch.`,
+								Type:        smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+							{
+								Name:        "tagMatch",
+								Description: `TagMatch is a TagIDs match mode: any or all.`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "publishedFrom",
+								Optional:    true,
+								Description: `PublishedFrom and PublishedTo are inclusive publishedAt range bounds.`,
+								Type:        smd.String,
+							},
+							{
+								Name:     "publishedTo",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:        "cursor",
+								Description: `Cursor is an opaque nextCursor/prevCursor value from NewsPage, used by GetByCursor only.`,
+								Type:        smd.String,
+							},
+							{
+								Name: "query",
+								Description: `Query is a full-text search query over title, shortText and content.
+Supports "quoted phrases", OR and -exclusions.`,
+								Type: smd.String,
+							},
+							{
+								Name: "fields",
+								Description: `Fields is a list of returned fields: title, shortText, content, author, publishedAt, category, tags.
+Default is all fields except content. ID, slug and publishedAt are always returned.`,
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.String,
+								},
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Optional: true,
+					Type:     smd.Object,
+					TypeName: "NewsPage",
+					Properties: smd.PropertyList{
+						{
+							Name: "list",
+							Ref:  "#/definitions/NewsSummaries",
+							Type: smd.Object,
+						},
+						{
+							Name:     "nextCursor",
+							Optional: true,
+							Type:     smd.String,
+						},
+						{
+							Name:     "prevCursor",
+							Optional: true,
+							Type:     smd.String,
+						},
+					},
+					Definitions: map[string]smd.Definition{
+						"NewsSummaries": {
+							Type:       "object",
+							Properties: smd.PropertyList{},
+						},
+					},
+				},
+			},
 			"GetByID": {
 				Parameters: []smd.JSONSchema{
 					{
@@ -142,6 +328,10 @@ func (NewsService) SMD() smd.ServiceInfo {
 							Name: "title",
 							Type: smd.String,
 						},
+						{
+							Name: "slug",
+							Type: smd.String,
+						},
 						{
 							Name: "shortText",
 							Type: smd.String,
@@ -171,6 +361,12 @@ func (NewsService) SMD() smd.ServiceInfo {
 							Ref:  "#/definitions/Tags",
 							Type: smd.Object,
 						},
+						{
+							Name:        "snippet",
+							Optional:    true,
+							Description: `Snippet is a highlighted with <b> tag text fragment, set for full-text search results only.`,
+							Type:        smd.String,
+						},
 					},
 					Definitions: map[string]smd.Definition{
 						"Category": {
@@ -184,6 +380,10 @@ func (NewsService) SMD() smd.ServiceInfo {
 									Name: "title",
 									Type: smd.String,
 								},
+								{
+									Name: "slug",
+									Type: smd.String,
+								},
 							},
 						},
 						"Tags": {
@@ -193,46 +393,637 @@ func (NewsService) SMD() smd.ServiceInfo {
 					},
 				},
 			},
-			"Count": {
+			"GetBySlug": {
+				Description: `GetBySlug returns news by its current or old slug. If moved is set, client should redirect to the current news slug.`,
 				Parameters: []smd.JSONSchema{
 					{
-						Name:     "req",
-						Type:     smd.Object,
-						TypeName: "NewsListReq",
-						Properties: smd.PropertyList{
-							{
-								Name: "categoryId",
-								Type: smd.Integer,
+						Name:        "slug",
+						Description: `news slug`,
+						Type:        smd.String,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Optional: true,
+					Type:     smd.Object,
+					TypeName: "NewsBySlug",
+					Properties: smd.PropertyList{
+						{
+							Name:     "news",
+							Optional: true,
+							Ref:      "#/definitions/News",
+							Type:     smd.Object,
+						},
+						{
+							Name: "moved",
+							Type: smd.Boolean,
+						},
+					},
+					Definitions: map[string]smd.Definition{
+						"News": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "slug",
+									Type: smd.String,
+								},
+								{
+									Name: "shortText",
+									Type: smd.String,
+								},
+								{
+									Name:     "content",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name:     "author",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name: "publishedAt",
+									Type: smd.String,
+								},
+								{
+									Name:     "category",
+									Optional: true,
+									Ref:      "#/definitions/Category",
+									Type:     smd.Object,
+								},
+								{
+									Name: "tags",
+									Ref:  "#/definitions/Tags",
+									Type: smd.Object,
+								},
+								{
+									Name:        "snippet",
+									Optional:    true,
+									Description: `Snippet is a highlighted with <b> tag text fragment, set for full-text search results only.`,
+									Type:        smd.String,
+								},
 							},
-							{
-								Name: "tagId",
-								Type: smd.Integer,
+						},
+						"Category": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "slug",
+									Type: smd.String,
+								},
 							},
-							{
-								Name: "page",
-								Type: smd.Integer,
+						},
+						"Tags": {
+							Type:       "object",
+							Properties: smd.PropertyList{},
+						},
+					},
+				},
+				Errors: map[int]string{
+					404: "News not found",
+				},
+			},
+			"TrackView": {
+				Description: `TrackView counts news view. Repeated views from the same IP are not counted for a while.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `news id`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `true if view is counted`,
+					Type:        smd.Boolean,
+				},
+				Errors: map[int]string{
+					404: "News not found",
+				},
+			},
+			"MostRead": {
+				Description: `MostRead returns news with the most views for the last day, week or month, most viewed first.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "period",
+						Description: `day, week or month`,
+						Type:        smd.String,
+					},
+					{
+						Name:        "limit",
+						Description: `max number of news, default 10, max 50`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Type:     smd.Array,
+					TypeName: "[]NewsViews",
+					Items: map[string]string{
+						"$ref": "#/definitions/NewsViews",
+					},
+					Definitions: map[string]smd.Definition{
+						"NewsViews": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name:     "news",
+									Optional: true,
+									Ref:      "#/definitions/NewsSummary",
+									Type:     smd.Object,
+								},
+								{
+									Name: "views",
+									Type: smd.Integer,
+								},
 							},
-							{
-								Name: "perPage",
-								Type: smd.Integer,
+						},
+						"NewsSummary": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "slug",
+									Type: smd.String,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "shortText",
+									Type: smd.String,
+								},
+								{
+									Name:     "content",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name:     "author",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name:     "publishedAt",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name:     "category",
+									Optional: true,
+									Ref:      "#/definitions/Category",
+									Type:     smd.Object,
+								},
+								{
+									Name: "tags",
+									Ref:  "#/definitions/Tags",
+									Type: smd.Object,
+								},
+								{
+									Name:        "snippet",
+									Optional:    true,
+									Description: `Snippet is a highlighted with <b> tag text fragment, set for full-text search results only.`,
+									Type:        smd.String,
+								},
+							},
+						},
+						"Category": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "slug",
+									Type: smd.String,
+								},
+							},
+						},
+						"Tags": {
+							Type:       "object",
+							Properties: smd.PropertyList{},
+						},
+					},
+				},
+				Errors: map[int]string{
+					400: "Unknown period",
+				},
+			},
+			"Related": {
+				Description: `Related returns "read also" news for the given one: news with more common tags first,
+then news from the same category, then the newest ones.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `news id`,
+						Type:        smd.Integer,
+					},
+					{
+						Name:        "limit",
+						Description: `max number of news, default 5, max 20`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Type:     smd.Array,
+					TypeName: "[]NewsSummary",
+					Items: map[string]string{
+						"$ref": "#/definitions/NewsSummary",
+					},
+					Definitions: map[string]smd.Definition{
+						"NewsSummary": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "slug",
+									Type: smd.String,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "shortText",
+									Type: smd.String,
+								},
+								{
+									Name:     "content",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name:     "author",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name:     "publishedAt",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name:     "category",
+									Optional: true,
+									Ref:      "#/definitions/Category",
+									Type:     smd.Object,
+								},
+								{
+									Name: "tags",
+									Ref:  "#/definitions/Tags",
+									Type: smd.Object,
+								},
+								{
+									Name:        "snippet",
+									Optional:    true,
+									Description: `Snippet is a highlighted with <b> tag text fragment, set for full-text search results only.`,
+									Type:        smd.String,
+								},
+							},
+						},
+						"Category": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "slug",
+									Type: smd.String,
+								},
+							},
+						},
+						"Tags": {
+							Type:       "object",
+							Properties: smd.PropertyList{},
+						},
+					},
+				},
+				Errors: map[int]string{
+					404: "News not found",
+				},
+			},
+			"Archive": {
+				Description: `Archive returns published news counts grouped by month, oldest first. Only filter fields of req are used.
+If year is set, only news of the year are counted. If month is set too, counts are grouped by day of the month.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:     "req",
+						Type:     smd.Object,
+						TypeName: "NewsListReq",
+						Properties: smd.PropertyList{
+							{
+								Name: "categoryId",
+								Type: smd.Integer,
+							},
+							{
+								Name: "tagId",
+								Type: smd.Integer,
+							},
+							{
+								Name: "page",
+								Type: smd.Integer,
+							},
+							{
+								Name: "perPage",
+								Type: smd.Integer,
+							},
+							{
+								Name:        "categoryIds",
+								Description: `CategoryIDs matches news from any of the categories.`,
+								Type:        smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+							{
+								Name:        "tagIds",
+								Description: `TagIDs matches news tagged with any (default) or all of the tags, see TagMatch.`,
+								Type:        smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+							{
+								Name:        "tagMatch",
+								Description: `TagMatch is a TagIDs match mode: any or all.`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "publishedFrom",
+								Optional:    true,
+								Description: `PublishedFrom and PublishedTo are inclusive publishedAt range bounds.`,
+								Type:        smd.String,
+							},
+							{
+								Name:     "publishedTo",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:        "cursor",
+								Description: `Cursor is an opaque nextCursor/prevCursor value from NewsPage, used by GetByCursor only.`,
+								Type:        smd.String,
+							},
+							{
+								Name: "query",
+								Description: `Query is a full-text search query over title, shortText and content.
+Supports "quoted phrases", OR and -exclusions.`,
+								Type: smd.String,
+							},
+							{
+								Name: "fields",
+								Description: `Fields is a list of returned fields: title, shortText, content, author, publishedAt, category, tags.
+Default is all fields except content. ID, slug and publishedAt are always returned.`,
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.String,
+								},
+							},
+						},
+					},
+					{
+						Name:        "year",
+						Description: `year, optional`,
+						Type:        smd.Integer,
+					},
+					{
+						Name:        "month",
+						Description: `month 1-12, optional, requires year`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Type:     smd.Array,
+					TypeName: "[]ArchiveItem",
+					Items: map[string]string{
+						"$ref": "#/definitions/ArchiveItem",
+					},
+					Definitions: map[string]smd.Definition{
+						"ArchiveItem": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "year",
+									Type: smd.Integer,
+								},
+								{
+									Name: "month",
+									Type: smd.Integer,
+								},
+								{
+									Name:     "day",
+									Optional: true,
+									Type:     smd.Integer,
+								},
+								{
+									Name: "count",
+									Type: smd.Integer,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					400: "Invalid year or month",
+				},
+			},
+			"Count": {
+				Parameters: []smd.JSONSchema{
+					{
+						Name:     "req",
+						Type:     smd.Object,
+						TypeName: "NewsListReq",
+						Properties: smd.PropertyList{
+							{
+								Name: "categoryId",
+								Type: smd.Integer,
+							},
+							{
+								Name: "tagId",
+								Type: smd.Integer,
+							},
+							{
+								Name: "page",
+								Type: smd.Integer,
+							},
+							{
+								Name: "perPage",
+								Type: smd.Integer,
+							},
+							{
+								Name:        "categoryIds",
+								Description: `CategoryIDs matches news from any of the categories.`,
+								Type:        smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+							{
+								Name:        "tagIds",
+								Description: `TagIDs matches news tagged with any (default) or all of the tags, see TagMatch.`,
+								Type:        smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+							{
+								Name:        "tagMatch",
+								Description: `TagMatch is a TagIDs match mode: any or all.`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "publishedFrom",
+								Optional:    true,
+								Description: `PublishedFrom and PublishedTo are inclusive publishedAt range bounds.`,
+								Type:        smd.String,
+							},
+							{
+								Name:     "publishedTo",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:        "cursor",
+								Description: `Cursor is an opaque nextCursor/prevCursor value from NewsPage, used by GetByCursor only.`,
+								Type:        smd.String,
+							},
+							{
+								Name: "query",
+								Description: `Query is a full-text search query over title, shortText and content.
+Supports "quoted phrases", OR and -exclusions.`,
+								Type: smd.String,
+							},
+							{
+								Name: "fields",
+								Description: `Fields is a list of returned fields: title, shortText, content, author, publishedAt, category, tags.
+Default is all fields except content. ID, slug and publishedAt are always returned.`,
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.String,
+								},
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Type: smd.Integer,
+				},
+			},
+			"Categories": {
+				Parameters: []smd.JSONSchema{},
+				Returns: smd.JSONSchema{
+					Type:     smd.Array,
+					TypeName: "[]Category",
+					Items: map[string]string{
+						"$ref": "#/definitions/Category",
+					},
+					Definitions: map[string]smd.Definition{
+						"Category": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "slug",
+									Type: smd.String,
+								},
+							},
+						},
+					},
+				},
+			},
+			"Tags": {
+				Parameters: []smd.JSONSchema{},
+				Returns: smd.JSONSchema{
+					Type:     smd.Array,
+					TypeName: "[]Tag",
+					Items: map[string]string{
+						"$ref": "#/definitions/Tag",
+					},
+					Definitions: map[string]smd.Definition{
+						"Tag": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "name",
+									Type: smd.String,
+								},
+								{
+									Name: "statusId",
+									Type: smd.Integer,
+								},
 							},
 						},
 					},
 				},
-				Returns: smd.JSONSchema{
-					Type: smd.Integer,
-				},
 			},
-			"Categories": {
-				Parameters: []smd.JSONSchema{},
+			"TagCloud": {
+				Description: `TagCloud returns tags with numbers of published news using them, most used first.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name: "categoryID",
+						Type: smd.Integer,
+					},
+					{
+						Name:        "minCount",
+						Description: `skip tags with less news, optional`,
+						Type:        smd.Integer,
+					},
+					{
+						Name:        "top",
+						Description: `max number of tags, optional`,
+						Type:        smd.Integer,
+					},
+				},
 				Returns: smd.JSONSchema{
 					Type:     smd.Array,
-					TypeName: "[]Category",
+					TypeName: "[]TagCount",
 					Items: map[string]string{
-						"$ref": "#/definitions/Category",
+						"$ref": "#/definitions/TagCount",
 					},
 					Definitions: map[string]smd.Definition{
-						"Category": {
+						"TagCount": {
 							Type: "object",
 							Properties: smd.PropertyList{
 								{
@@ -240,24 +1031,35 @@ func (NewsService) SMD() smd.ServiceInfo {
 									Type: smd.Integer,
 								},
 								{
-									Name: "title",
+									Name: "name",
 									Type: smd.String,
 								},
+								{
+									Name: "count",
+									Type: smd.Integer,
+								},
 							},
 						},
 					},
 				},
 			},
-			"Tags": {
-				Parameters: []smd.JSONSchema{},
+			"CategoryCloud": {
+				Description: `CategoryCloud returns categories with numbers of published news in them, most used first.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "minCount",
+						Description: `skip categories with less news, optional`,
+						Type:        smd.Integer,
+					},
+				},
 				Returns: smd.JSONSchema{
 					Type:     smd.Array,
-					TypeName: "[]Tag",
+					TypeName: "[]CategoryCount",
 					Items: map[string]string{
-						"$ref": "#/definitions/Tag",
+						"$ref": "#/definitions/CategoryCount",
 					},
 					Definitions: map[string]smd.Definition{
-						"Tag": {
+						"CategoryCount": {
 							Type: "object",
 							Properties: smd.PropertyList{
 								{
@@ -265,11 +1067,15 @@ func (NewsService) SMD() smd.ServiceInfo {
 									Type: smd.Integer,
 								},
 								{
-									Name: "name",
+									Name: "title",
 									Type: smd.String,
 								},
 								{
-									Name: "statusId",
+									Name: "slug",
+									Type: smd.String,
+								},
+								{
+									Name: "count",
 									Type: smd.Integer,
 								},
 							},
@@ -307,6 +1113,25 @@ func (NewsService) SMD() smd.ServiceInfo {
 									"type": smd.String,
 								},
 							},
+							{
+								Name:        "Contact",
+								Description: `Contact is an optional submitter contact: email, phone, etc.`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "Website",
+								Description: `Website is a honeypot field, it should be hidden from users and left empty.`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "Challenge",
+								Description: `Challenge is a SuggestChallenge token and Nonce is its solution, required if challenge is enabled.`,
+								Type:        smd.String,
+							},
+							{
+								Name: "Nonce",
+								Type: smd.String,
+							},
 						},
 					},
 				},
@@ -316,7 +1141,27 @@ func (NewsService) SMD() smd.ServiceInfo {
 					Properties: smd.PropertyList{},
 				},
 			},
+			"SuggestChallenge": {
+				Description: `SuggestChallenge returns proof-of-work challenge, which solution must be sent with Suggest. It returns null if challenge is disabled.`,
+				Parameters:  []smd.JSONSchema{},
+				Returns: smd.JSONSchema{
+					Optional: true,
+					Type:     smd.Object,
+					TypeName: "SuggestionChallenge",
+					Properties: smd.PropertyList{
+						{
+							Name: "token",
+							Type: smd.String,
+						},
+						{
+							Name: "difficulty",
+							Type: smd.Integer,
+						},
+					},
+				},
+			},
 			"Suggest": {
+				Description: `Suggest adds news suggestion to moderation queue.`,
 				Parameters: []smd.JSONSchema{
 					{
 						Name:     "req",
@@ -346,13 +1191,32 @@ func (NewsService) SMD() smd.ServiceInfo {
 									"type": smd.String,
 								},
 							},
+							{
+								Name:        "Contact",
+								Description: `Contact is an optional submitter contact: email, phone, etc.`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "Website",
+								Description: `Website is a honeypot field, it should be hidden from users and left empty.`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "Challenge",
+								Description: `Challenge is a SuggestChallenge token and Nonce is its solution, required if challenge is enabled.`,
+								Type:        smd.String,
+							},
+							{
+								Name: "Nonce",
+								Type: smd.String,
+							},
 						},
 					},
 				},
 				Returns: smd.JSONSchema{
 					Optional: true,
 					Type:     smd.Object,
-					TypeName: "News",
+					TypeName: "Suggestion",
 					Properties: smd.PropertyList{
 						{
 							Name: "id",
@@ -362,56 +1226,13 @@ func (NewsService) SMD() smd.ServiceInfo {
 							Name: "title",
 							Type: smd.String,
 						},
-						{
-							Name: "shortText",
-							Type: smd.String,
-						},
-						{
-							Name:     "content",
-							Optional: true,
-							Type:     smd.String,
-						},
-						{
-							Name:     "author",
-							Optional: true,
-							Type:     smd.String,
-						},
-						{
-							Name: "publishedAt",
-							Type: smd.String,
-						},
-						{
-							Name:     "category",
-							Optional: true,
-							Ref:      "#/definitions/Category",
-							Type:     smd.Object,
-						},
-						{
-							Name: "tags",
-							Ref:  "#/definitions/Tags",
-							Type: smd.Object,
-						},
-					},
-					Definitions: map[string]smd.Definition{
-						"Category": {
-							Type: "object",
-							Properties: smd.PropertyList{
-								{
-									Name: "id",
-									Type: smd.Integer,
-								},
-								{
-									Name: "title",
-									Type: smd.String,
-								},
-							},
-						},
-						"Tags": {
-							Type:       "object",
-							Properties: smd.PropertyList{},
-						},
 					},
 				},
+				Errors: map[int]string{
+					400: "Invalid suggestion",
+					422: "Suggestion is rejected as spam",
+					429: "Too many suggestions",
+				},
 			},
 		},
 	}
@@ -442,6 +1263,25 @@ func (s NewsService) Invoke(ctx context.Context, method string, params json.RawM
 
 		resp.Set(s.Get(ctx, args.Req))
 
+	case RPC.NewsService.GetByCursor:
+		var args = struct {
+			Req NewsListReq `json:"req"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"req"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.GetByCursor(ctx, args.Req))
+
 	case RPC.NewsService.GetByID:
 		var args = struct {
 			Id int `json:"id"`
@@ -461,6 +1301,105 @@ func (s NewsService) Invoke(ctx context.Context, method string, params json.RawM
 
 		resp.Set(s.GetByID(ctx, args.Id))
 
+	case RPC.NewsService.GetBySlug:
+		var args = struct {
+			Slug string `json:"slug"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"slug"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.GetBySlug(ctx, args.Slug))
+
+	case RPC.NewsService.TrackView:
+		var args = struct {
+			Id int `json:"id"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"id"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.TrackView(ctx, args.Id))
+
+	case RPC.NewsService.MostRead:
+		var args = struct {
+			Period string `json:"period"`
+			Limit  int    `json:"limit"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"period", "limit"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.MostRead(ctx, args.Period, args.Limit))
+
+	case RPC.NewsService.Related:
+		var args = struct {
+			Id    int `json:"id"`
+			Limit int `json:"limit"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"id", "limit"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Related(ctx, args.Id, args.Limit))
+
+	case RPC.NewsService.Archive:
+		var args = struct {
+			Req   NewsListReq `json:"req"`
+			Year  int         `json:"year"`
+			Month int         `json:"month"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"req", "year", "month"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Archive(ctx, args.Req, args.Year, args.Month))
+
 	case RPC.NewsService.Count:
 		var args = struct {
 			Req NewsListReq `json:"req"`
@@ -486,6 +1425,46 @@ func (s NewsService) Invoke(ctx context.Context, method string, params json.RawM
 	case RPC.NewsService.Tags:
 		resp.Set(s.Tags(ctx))
 
+	case RPC.NewsService.TagCloud:
+		var args = struct {
+			CategoryID int `json:"categoryID"`
+			MinCount   int `json:"minCount"`
+			Top        int `json:"top"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"categoryID", "minCount", "top"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.TagCloud(ctx, args.CategoryID, args.MinCount, args.Top))
+
+	case RPC.NewsService.CategoryCloud:
+		var args = struct {
+			MinCount int `json:"minCount"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"minCount"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.CategoryCloud(ctx, args.MinCount))
+
 	case RPC.NewsService.ValidateSuggestion:
 		var args = struct {
 			Req NewsSuggestion `json:"req"`
@@ -505,6 +1484,9 @@ func (s NewsService) Invoke(ctx context.Context, method string, params json.RawM
 
 		resp.Set(s.ValidateSuggestion(ctx, args.Req))
 
+	case RPC.NewsService.SuggestChallenge:
+		resp.Set(s.SuggestChallenge())
+
 	case RPC.NewsService.Suggest:
 		var args = struct {
 			Req NewsSuggestion `json:"req"`
@@ -61,3 +61,15 @@ func newInternalError(err error) *zenrpc.Error {
 func newBadRequestError(err error) *zenrpc.Error {
 	return zenrpc.NewError(http.StatusBadRequest, err)
 }
+
+func newNotFoundError(err error) *zenrpc.Error {
+	return zenrpc.NewError(http.StatusNotFound, err)
+}
+
+func newSpamError(err error) *zenrpc.Error {
+	return zenrpc.NewError(http.StatusUnprocessableEntity, err)
+}
+
+func newTooManyRequestsError(err error) *zenrpc.Error {
+	return zenrpc.NewError(http.StatusTooManyRequests, err)
+}
@@ -0,0 +1,229 @@
+package sitemap
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"apisrv/pkg/db"
+	"apisrv/pkg/newsportal"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultPageSize = 10000
+	maxPageSize     = 50000
+	defaultLanguage = "ru"
+	cacheMaxAge     = 15 * time.Minute
+
+	// newsPeriod is a max age of Google News sitemap items.
+	newsPeriod = 48 * time.Hour
+	// maxNewsItems is a max number of Google News sitemap items.
+	maxNewsItems = 1000
+
+	contentType = "application/xml; charset=utf-8"
+)
+
+// Config is a sitemaps configuration.
+type Config struct {
+	PageSize        int    // max urls in child sitemap, default is 10000, max is 50000
+	PublicationName string // Google News publication name
+	Language        string // Google News publication language, default is ru
+}
+
+// Handler serves sitemap index, paginated news and tags sitemaps, categories sitemap and Google News sitemap of published news.
+type Handler struct {
+	cfg     Config
+	baseURL string
+	siteURL string
+	repo    db.NewsRepo
+}
+
+// NewHandler returns sitemaps handler. Child sitemaps are referred by public baseURL of the api server,
+// page links are built from public siteURL of the news site.
+func NewHandler(dbo db.DB, cfg Config, baseURL, siteURL string) *Handler {
+	if cfg.PageSize <= 0 || cfg.PageSize > maxPageSize {
+		cfg.PageSize = defaultPageSize
+	}
+
+	if cfg.Language == "" {
+		cfg.Language = defaultLanguage
+	}
+
+	return &Handler{
+		cfg:     cfg,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		siteURL: strings.TrimRight(siteURL, "/"),
+		repo:    db.NewNewsRepo(dbo).WithEnabledOnly(),
+	}
+}
+
+// Register adds /sitemap.xml index and /sitemap/* child sitemaps to echo.
+func (h *Handler) Register(e *echo.Echo) {
+	e.GET("/sitemap.xml", h.index)
+
+	g := e.Group("/sitemap")
+	g.GET("/news/:file", h.news)
+	g.GET("/tags/:file", h.tags)
+	g.GET("/categories.xml", h.categories)
+	g.GET("/google-news.xml", h.googleNews)
+}
+
+func (h *Handler) index(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	newsCount, err := h.repo.CountNews(ctx, nil, db.AlreadyPublished())
+	if err != nil {
+		return fmt.Errorf("count news: %w", err)
+	}
+
+	tagsCount, err := h.repo.CountTags(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("count tags: %w", err)
+	}
+
+	refs := []Ref{
+		{Loc: h.baseURL + "/sitemap/categories.xml"},
+		{Loc: h.baseURL + "/sitemap/google-news.xml"},
+	}
+
+	for page := 1; page <= h.pages(newsCount); page++ {
+		refs = append(refs, Ref{Loc: h.baseURL + "/sitemap/news/" + strconv.Itoa(page) + ".xml"})
+	}
+
+	for page := 1; page <= h.pages(tagsCount); page++ {
+		refs = append(refs, Ref{Loc: h.baseURL + "/sitemap/tags/" + strconv.Itoa(page) + ".xml"})
+	}
+
+	return serve(c, Index, refs)
+}
+
+func (h *Handler) news(c echo.Context) error {
+	page, ok := parsePage(c.Param("file"))
+	if !ok {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+
+	list, err := h.repo.NewsByFilters(c.Request().Context(), nil, db.NewPager(page, h.cfg.PageSize),
+		db.AlreadyPublished(),
+		db.WithColumns(db.Columns.News.ID, db.Columns.News.Slug, db.Columns.News.PublishedAt, db.Columns.News.UpdatedAt),
+		db.WithSort(db.NewSortField(db.Columns.News.ID, false)),
+	)
+	if err != nil {
+		return fmt.Errorf("read news: %w", err)
+	} else if len(list) == 0 {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+
+	urls := make([]URL, 0, len(list))
+	for _, news := range list {
+		// scheduled news could be updated before publication
+		lastMod := news.UpdatedAt
+		if news.PublishedAt.After(lastMod) {
+			lastMod = news.PublishedAt
+		}
+
+		urls = append(urls, URL{Loc: h.newsLink(news), LastMod: lastMod})
+	}
+
+	return serve(c, URLSet, urls)
+}
+
+func (h *Handler) tags(c echo.Context) error {
+	page, ok := parsePage(c.Param("file"))
+	if !ok {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+
+	list, err := h.repo.TagsByFilters(c.Request().Context(), nil, db.NewPager(page, h.cfg.PageSize),
+		db.WithSort(db.NewSortField(db.Columns.Tag.ID, false)),
+	)
+	if err != nil {
+		return fmt.Errorf("read tags: %w", err)
+	} else if len(list) == 0 {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+
+	urls := make([]URL, 0, len(list))
+	for _, tag := range list {
+		urls = append(urls, URL{Loc: h.siteURL + newsportal.TagPath(tag.ID)})
+	}
+
+	return serve(c, URLSet, urls)
+}
+
+func (h *Handler) categories(c echo.Context) error {
+	list, err := h.repo.CategoriesByFilters(c.Request().Context(), nil, db.PagerNoLimit, h.repo.DefaultCategorySort())
+	if err != nil {
+		return fmt.Errorf("read categories: %w", err)
+	}
+
+	urls := make([]URL, 0, len(list))
+	for _, category := range list {
+		urls = append(urls, URL{Loc: h.siteURL + newsportal.CategoryPath(category.Slug)})
+	}
+
+	return serve(c, URLSet, urls)
+}
+
+func (h *Handler) googleNews(c echo.Context) error {
+	from := time.Now().Add(-newsPeriod)
+
+	list, err := h.repo.NewsByFilters(c.Request().Context(), &db.NewsSearch{PublishedFrom: &from}, db.Pager{PageSize: maxNewsItems},
+		db.AlreadyPublished(),
+		db.WithColumns(db.Columns.News.ID, db.Columns.News.Title, db.Columns.News.Slug, db.Columns.News.PublishedAt),
+		db.WithSort(db.NewSortField(db.Columns.News.PublishedAt, true)),
+	)
+	if err != nil {
+		return fmt.Errorf("read news: %w", err)
+	}
+
+	urls := make([]URL, 0, len(list))
+	for _, news := range list {
+		urls = append(urls, URL{
+			Loc: h.newsLink(news),
+			News: &News{
+				PublicationName: h.cfg.PublicationName,
+				Language:        h.cfg.Language,
+				Published:       news.PublishedAt,
+				Title:           news.Title,
+			},
+		})
+	}
+
+	return serve(c, URLSet, urls)
+}
+
+// serve writes encoded sitemap with public cache header.
+func serve[T any](c echo.Context, encode func(T) ([]byte, error), v T) error {
+	b, err := encode(v)
+	if err != nil {
+		return err
+	}
+
+	c.Response().Header().Set(echo.HeaderCacheControl, fmt.Sprintf("public, max-age=%d", int(cacheMaxAge.Seconds())))
+
+	return c.Blob(http.StatusOK, contentType, b)
+}
+
+// pages returns number of child sitemaps for count items.
+func (h *Handler) pages(count int) int {
+	return (count + h.cfg.PageSize - 1) / h.cfg.PageSize
+}
+
+func (h *Handler) newsLink(news db.News) string {
+	return h.siteURL + newsportal.NewsPath(news.Slug)
+}
+
+// parsePage parses child sitemap file name like 1.xml.
+func parsePage(file string) (int, bool) {
+	page, err := strconv.Atoi(strings.TrimSuffix(file, ".xml"))
+	if err != nil || page <= 0 || !strings.HasSuffix(file, ".xml") {
+		return 0, false
+	}
+
+	return page, true
+}
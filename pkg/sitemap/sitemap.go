@@ -0,0 +1,123 @@
+package sitemap
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+const (
+	sitemapNS = "http://www.sitemaps.org/schemas/sitemap/0.9"
+	newsNS    = "http://www.google.com/schemas/sitemap-news/0.9"
+)
+
+// URL is a sitemap entry.
+type URL struct {
+	Loc     string
+	LastMod time.Time
+	News    *News // set for Google News sitemap entries only
+}
+
+// News is a Google News sitemap entry data.
+type News struct {
+	PublicationName string
+	Language        string
+	Published       time.Time
+	Title           string
+}
+
+// Ref is a sitemap index entry, which refers to a child sitemap.
+type Ref struct {
+	Loc     string
+	LastMod time.Time
+}
+
+type urlSet struct {
+	XMLName xml.Name `xml:"urlset"`
+	NS      string   `xml:"xmlns,attr"`
+	NewsNS  string   `xml:"xmlns:news,attr,omitempty"`
+	URLs    []xmlURL `xml:"url"`
+}
+
+type xmlURL struct {
+	Loc     string   `xml:"loc"`
+	LastMod string   `xml:"lastmod,omitempty"`
+	News    *xmlNews `xml:"news:news,omitempty"`
+}
+
+type xmlNews struct {
+	Publication     xmlPublication `xml:"news:publication"`
+	PublicationDate string         `xml:"news:publication_date"`
+	Title           string         `xml:"news:title"`
+}
+
+type xmlPublication struct {
+	Name     string `xml:"news:name"`
+	Language string `xml:"news:language"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name `xml:"sitemapindex"`
+	NS       string   `xml:"xmlns,attr"`
+	Sitemaps []xmlRef `xml:"sitemap"`
+}
+
+type xmlRef struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// URLSet encodes urls as sitemap document. Google News namespace is added if any url has news data.
+func URLSet(urls []URL) ([]byte, error) {
+	doc := urlSet{
+		NS:   sitemapNS,
+		URLs: make([]xmlURL, 0, len(urls)),
+	}
+
+	for _, u := range urls {
+		xu := xmlURL{Loc: u.Loc, LastMod: formatTime(u.LastMod)}
+		if u.News != nil {
+			doc.NewsNS = newsNS
+			xu.News = &xmlNews{
+				Publication:     xmlPublication{Name: u.News.PublicationName, Language: u.News.Language},
+				PublicationDate: formatTime(u.News.Published),
+				Title:           u.News.Title,
+			}
+		}
+
+		doc.URLs = append(doc.URLs, xu)
+	}
+
+	return marshalXML(doc)
+}
+
+// Index encodes refs as sitemap index document.
+func Index(refs []Ref) ([]byte, error) {
+	doc := sitemapIndex{
+		NS:       sitemapNS,
+		Sitemaps: make([]xmlRef, 0, len(refs)),
+	}
+
+	for _, r := range refs {
+		doc.Sitemaps = append(doc.Sitemaps, xmlRef{Loc: r.Loc, LastMod: formatTime(r.LastMod)})
+	}
+
+	return marshalXML(doc)
+}
+
+// formatTime formats t as W3C datetime, zero time is formatted as empty string.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+func marshalXML(v any) ([]byte, error) {
+	b, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), b...), nil
+}
@@ -0,0 +1,59 @@
+package sitemap
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestEncoders(t *testing.T) {
+	Convey("Test sitemap encoders", t, func() {
+		published := time.Date(2025, 9, 15, 10, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+
+		Convey("URLSet", func() {
+			b, err := URLSet([]URL{{Loc: "https://example.com/news/drunk-cat", LastMod: published}})
+			So(err, ShouldBeNil)
+			So(string(b), ShouldContainSubstring, `<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">`)
+			So(string(b), ShouldContainSubstring, `<lastmod>2025-09-15T07:00:00Z</lastmod>`)
+			So(string(b), ShouldNotContainSubstring, `news:`)
+
+			var doc urlSet
+			So(xml.Unmarshal(b, &doc), ShouldBeNil)
+			So(doc.URLs, ShouldHaveLength, 1)
+		})
+
+		Convey("Google News URLSet", func() {
+			b, err := URLSet([]URL{{
+				Loc:  "https://example.com/news/drunk-cat",
+				News: &News{PublicationName: "NewsPortal", Language: "ru", Published: published, Title: "Drunk cat"},
+			}})
+			So(err, ShouldBeNil)
+			So(string(b), ShouldContainSubstring, `xmlns:news="http://www.google.com/schemas/sitemap-news/0.9"`)
+			So(string(b), ShouldContainSubstring, `<news:name>NewsPortal</news:name>`)
+			So(string(b), ShouldContainSubstring, `<news:publication_date>2025-09-15T07:00:00Z</news:publication_date>`)
+			So(string(b), ShouldNotContainSubstring, `<lastmod>`)
+		})
+
+		Convey("Index", func() {
+			b, err := Index([]Ref{{Loc: "https://example.com/sitemap/news/1.xml"}})
+			So(err, ShouldBeNil)
+			So(string(b), ShouldContainSubstring, `<sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">`)
+			So(string(b), ShouldContainSubstring, `<loc>https://example.com/sitemap/news/1.xml</loc>`)
+		})
+	})
+}
+
+func TestParsePage(t *testing.T) {
+	Convey("Test child sitemap file name parsing", t, func() {
+		page, ok := parsePage("2.xml")
+		So(ok, ShouldBeTrue)
+		So(page, ShouldEqual, 2)
+
+		for _, file := range []string{"0.xml", "-1.xml", "2", "a.xml", "2.xml.xml"} {
+			_, ok = parsePage(file)
+			So(ok, ShouldBeFalse)
+		}
+	})
+}
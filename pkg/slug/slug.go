@@ -0,0 +1,75 @@
+// Package slug makes URL slugs from titles.
+package slug
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// MaxLen is a max length of generated slug, it leaves room for a uniqueness suffix.
+const MaxLen = 200
+
+var translit = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh",
+	'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+	'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "h", 'ц': "ts",
+	'ч': "ch", 'ш': "sh", 'щ': "sch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu",
+	'я': "ya", 'і': "i", 'ї': "yi", 'є': "ye", 'ґ': "g",
+}
+
+// Make returns lowercase latin slug of s: cyrillic letters are transliterated,
+// other non-alphanumeric characters are replaced with dashes.
+func Make(s string) string {
+	var b strings.Builder
+	dash := false
+
+	for _, r := range strings.ToLower(s) {
+		var part string
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			part = string(r)
+		case unicode.Is(unicode.Cyrillic, r):
+			part = translit[r]
+		}
+
+		if part == "" {
+			// apostrophes and soft signs should not split words
+			if r != '\'' && r != 'ъ' && r != 'ь' {
+				dash = b.Len() > 0
+			}
+
+			continue
+		}
+
+		if b.Len()+len(part)+1 > MaxLen {
+			break
+		}
+
+		if dash {
+			b.WriteByte('-')
+			dash = false
+		}
+		b.WriteString(part)
+	}
+
+	return b.String()
+}
+
+// WithSuffix returns slug with numeric suffix, n > 1 is expected.
+func WithSuffix(slug string, n int) string {
+	return slug + "-" + strconv.Itoa(n)
+}
+
+// Unique returns slug or slug with the smallest numeric suffix, which is not used according to isUsed.
+func Unique(slug string, isUsed func(string) (bool, error)) (string, error) {
+	candidate := slug
+	for n := 2; ; n++ {
+		used, err := isUsed(candidate)
+		if err != nil || !used {
+			return candidate, err
+		}
+
+		candidate = WithSuffix(slug, n)
+	}
+}
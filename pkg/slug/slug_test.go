@@ -0,0 +1,27 @@
+package slug
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMake(t *testing.T) {
+	Convey("Test Make", t, func() {
+		cases := map[string]string{
+			"Drunk cat occurred massive traffic jam in the LA": "drunk-cat-occurred-massive-traffic-jam-in-the-la",
+			"Пьяный кот устроил пробку в Лос-Анджелесе":        "pyanyy-kot-ustroil-probku-v-los-andzhelese",
+			"  Съешь же ещё этих мягких булок!  ":              "sesh-zhe-esche-etih-myagkih-bulok",
+			"Rock'n'roll 2025": "rocknroll-2025",
+			"!!!":              "",
+		}
+
+		for in, out := range cases {
+			So(Make(in), ShouldEqual, out)
+		}
+
+		So(len(Make(strings.Repeat("слово ", 100))), ShouldBeLessThanOrEqualTo, MaxLen)
+		So(WithSuffix("news", 2), ShouldEqual, "news-2")
+	})
+}
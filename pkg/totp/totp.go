@@ -0,0 +1,113 @@
+// Package totp implements time-based one-time passwords (RFC 6238) compatible with authenticator apps.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // RFC 6238 default algorithm, supported by all authenticator apps
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Digits is a number of code digits.
+	Digits = 6
+	// Period is a code lifetime.
+	Period = 30 * time.Second
+	// Skew is a number of periods before and after the current one, which codes are also valid.
+	Skew = 1
+
+	secretSize = 20
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewSecret returns a new random base32 encoded secret.
+func NewSecret() string {
+	b := make([]byte, secretSize)
+	_, _ = rand.Read(b)
+	return encoding.EncodeToString(b)
+}
+
+// URI returns otpauth provisioning URI of the secret for authenticator apps.
+func URI(issuer, account, secret string) string {
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(Digits))
+	v.Set("period", fmt.Sprint(int(Period.Seconds())))
+
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(account)
+	return "otpauth://totp/" + label + "?" + v.Encode()
+}
+
+// Counter returns time step number of t.
+func Counter(t time.Time) int64 {
+	return t.Unix() / int64(Period.Seconds())
+}
+
+// Code returns the code of the secret for time t.
+func Code(secret string, t time.Time) (string, error) {
+	key, err := decode(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return code(key, Counter(t), Digits), nil
+}
+
+// Validate checks the code of the secret for time t with Skew and returns matched time step number.
+// Codes with step number less or equal to lastCounter are rejected, so each code is used once.
+func Validate(secret, passcode string, t time.Time, lastCounter int64) (int64, bool) {
+	key, err := decode(secret)
+	if err != nil || len(passcode) != Digits {
+		return 0, false
+	}
+
+	current := Counter(t)
+	for counter := current - Skew; counter <= current+Skew; counter++ {
+		if counter <= lastCounter {
+			continue
+		}
+
+		if hmac.Equal([]byte(code(key, counter, Digits)), []byte(passcode)) {
+			return counter, true
+		}
+	}
+
+	return 0, false
+}
+
+func decode(secret string) ([]byte, error) {
+	key, err := encoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return nil, fmt.Errorf("decode secret: %w", err)
+	}
+
+	return key, nil
+}
+
+// code returns HOTP value (RFC 4226) of the counter.
+func code(key []byte, counter int64, digits int) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(counter))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for range digits {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", digits, value%mod)
+}
@@ -0,0 +1,77 @@
+package totp
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// rfcSecret is a base32 encoded "12345678901234567890" key of RFC 6238 test vectors.
+var rfcSecret = encoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestCode(t *testing.T) {
+	Convey("Test RFC 6238 SHA1 vectors", t, func() {
+		cases := map[int64]string{
+			59:          "94287082",
+			1111111109:  "07081804",
+			1111111111:  "14050471",
+			1234567890:  "89005924",
+			2000000000:  "69279037",
+			20000000000: "65353130",
+		}
+
+		key, err := decode(rfcSecret)
+		So(err, ShouldBeNil)
+
+		for ts, want := range cases {
+			So(code(key, Counter(time.Unix(ts, 0)), 8), ShouldEqual, want)
+		}
+
+		c, err := Code(rfcSecret, time.Unix(59, 0))
+		So(err, ShouldBeNil)
+		So(c, ShouldEqual, "287082")
+	})
+}
+
+func TestValidate(t *testing.T) {
+	Convey("Test Validate", t, func() {
+		now := time.Unix(1111111111, 0)
+		secret := NewSecret()
+		So(secret, ShouldHaveLength, 32)
+
+		current, err := Code(secret, now)
+		So(err, ShouldBeNil)
+		prev, _ := Code(secret, now.Add(-Period))
+		old, _ := Code(secret, now.Add(-3*Period))
+
+		counter, ok := Validate(secret, current, now, 0)
+		So(ok, ShouldBeTrue)
+		So(counter, ShouldEqual, Counter(now))
+
+		_, ok = Validate(secret, prev, now, 0)
+		So(ok, ShouldBeTrue)
+
+		_, ok = Validate(secret, old, now, 0)
+		So(ok, ShouldBeFalse)
+
+		// used code is rejected
+		_, ok = Validate(secret, current, now, counter)
+		So(ok, ShouldBeFalse)
+
+		_, ok = Validate(secret, "12345", now, 0)
+		So(ok, ShouldBeFalse)
+
+		_, ok = Validate("not base32!", current, now, 0)
+		So(ok, ShouldBeFalse)
+	})
+
+	Convey("Test URI", t, func() {
+		uri := URI("apisrv", "admin", "JBSWY3DPEHPK3PXP")
+		So(strings.HasPrefix(uri, "otpauth://totp/apisrv:admin?"), ShouldBeTrue)
+		So(uri, ShouldContainSubstring, "secret=JBSWY3DPEHPK3PXP")
+		So(uri, ShouldContainSubstring, "issuer=apisrv")
+		So(uri, ShouldContainSubstring, "digits=6")
+	})
+}
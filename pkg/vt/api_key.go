@@ -0,0 +1,307 @@
+package vt
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"time"
+
+	"apisrv/pkg/db"
+
+	"github.com/vmkteam/embedlog"
+	"github.com/vmkteam/zenrpc/v2"
+)
+
+const (
+	// apiKeyPrefix distinguishes API keys from session tokens in authKey header.
+	apiKeyPrefix = "vtk_"
+	apiKeyBytes  = 20
+	// apiKeyVisibleLen is a length of key beginning, which is stored as is to identify the key.
+	apiKeyVisibleLen = len(apiKeyPrefix) + 8
+
+	// apiKeyUsageInterval is a min interval between API key usage updates from the same IP.
+	apiKeyUsageInterval = sessionActivityInterval
+)
+
+// newAPIKeyToken returns a crypto-random API key.
+func newAPIKeyToken() string {
+	b := make([]byte, apiKeyBytes)
+	_, _ = rand.Read(b)
+	return apiKeyPrefix + hex.EncodeToString(b)
+}
+
+// isAPIKey checks that authKey header value is an API key, not a session token.
+func isAPIKey(token string) bool {
+	return strings.HasPrefix(token, apiKeyPrefix)
+}
+
+// authenticateAPIKey returns enabled unexpired API key of enabled service account by token or nil.
+func authenticateAPIKey(ctx context.Context, commonRepo *db.CommonRepo, token string) (*db.APIKey, error) {
+	key, err := commonRepo.APIKeyByTokenHash(ctx, hashToken(token))
+	if err != nil || key == nil || key.User == nil {
+		return nil, err
+	}
+
+	if key.StatusID != db.StatusEnabled || key.IsExpired(time.Now()) || key.User.StatusID != db.StatusEnabled || !key.User.IsService {
+		return nil, nil
+	}
+
+	return key, nil
+}
+
+// trackAPIKeyUsage updates API key last used time and IP, if the interval has passed or IP has changed.
+func trackAPIKeyUsage(ctx context.Context, commonRepo *db.CommonRepo, key *db.APIKey, ip string) error {
+	if key.LastUsedAt != nil && time.Since(*key.LastUsedAt) < apiKeyUsageInterval && key.LastUsedIP != nil && *key.LastUsedIP == ip {
+		return nil
+	}
+
+	return commonRepo.UpdateAPIKeyUsage(ctx, key, ip)
+}
+
+// APIKeyFromContext returns API key of current request, if it is authorized by API key.
+func APIKeyFromContext(ctx context.Context) *db.APIKey {
+	if key, ok := ctx.Value(apiKeyKey).(*db.APIKey); ok {
+		return key
+	}
+	return nil
+}
+
+type APIKeyService struct {
+	zenrpc.Service
+	embedlog.Logger
+
+	commonRepo db.CommonRepo
+}
+
+func NewAPIKeyService(dbo db.DB, logger embedlog.Logger) *APIKeyService {
+	return &APIKeyService{
+		commonRepo: db.NewCommonRepo(dbo),
+		Logger:     logger,
+	}
+}
+
+func (s APIKeyService) dbSort(ops *ViewOps) db.OpFunc {
+	v := s.commonRepo.DefaultAPIKeySort()
+	if ops == nil {
+		return v
+	}
+
+	switch ops.SortColumn {
+	case db.Columns.APIKey.ID, db.Columns.APIKey.Title, db.Columns.APIKey.ExpiresAt, db.Columns.APIKey.LastUsedAt, db.Columns.APIKey.CreatedAt, db.Columns.APIKey.StatusID:
+		v = db.WithSort(db.NewSortField(ops.SortColumn, ops.SortDesc))
+	}
+
+	return v
+}
+
+// Count APIKeys according to conditions in search params
+//
+//zenrpc:search APIKeySearch
+//zenrpc:return int
+//zenrpc:500 Internal Error
+func (s APIKeyService) Count(ctx context.Context, search *APIKeySearch) (int, error) {
+	count, err := s.commonRepo.CountAPIKeys(ctx, search.ToDB())
+	if err != nil {
+		return 0, InternalError(err)
+	}
+	return count, nil
+}
+
+// Get а list of APIKeys according to conditions in search params
+//
+//zenrpc:search APIKeySearch
+//zenrpc:viewOps ViewOps
+//zenrpc:return []APIKey
+//zenrpc:500 Internal Error
+func (s APIKeyService) Get(ctx context.Context, search *APIKeySearch, viewOps *ViewOps) ([]APIKey, error) {
+	list, err := s.commonRepo.APIKeysByFilters(ctx, search.ToDB(), viewOps.Pager(), s.dbSort(viewOps), s.commonRepo.FullAPIKey())
+	if err != nil {
+		return nil, InternalError(err)
+	}
+	keys := make([]APIKey, 0, len(list))
+	for i := range list {
+		if key := NewAPIKey(&list[i]); key != nil {
+			keys = append(keys, *key)
+		}
+	}
+	return keys, nil
+}
+
+// GetByID returns a APIKey by its ID.
+//
+//zenrpc:id int
+//zenrpc:return APIKey
+//zenrpc:500 Internal Error
+//zenrpc:404 Not Found
+func (s APIKeyService) GetByID(ctx context.Context, id int) (*APIKey, error) {
+	db, err := s.byID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return NewAPIKey(db), nil
+}
+
+func (s APIKeyService) byID(ctx context.Context, id int) (*db.APIKey, error) {
+	db, err := s.commonRepo.APIKeyByID(ctx, id, s.commonRepo.FullAPIKey())
+	if err != nil {
+		return nil, InternalError(err)
+	} else if db == nil {
+		return nil, ErrNotFound
+	}
+	return db, nil
+}
+
+// Add creates an API key of the service account. The key token is returned only once, only its hash is stored.
+//
+//zenrpc:apiKey APIKey
+//zenrpc:return APIKeyToken
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+//zenrpc:403 Service Account Or Permissions, Which Current User Doesn't Have
+func (s APIKeyService) Add(ctx context.Context, apiKey APIKey) (*APIKeyToken, error) {
+	if ve := s.isValid(ctx, apiKey); ve.HasErrors() {
+		return nil, ve.Error()
+	}
+
+	if err := s.checkGrant(ctx, apiKey); err != nil {
+		return nil, err
+	}
+
+	token := newAPIKeyToken()
+	key := apiKey.ToDB()
+	key.Prefix, key.TokenHash = token[:apiKeyVisibleLen], hashToken(token)
+
+	key, err := s.commonRepo.AddAPIKey(ctx, key)
+	if err != nil {
+		return nil, InternalError(err)
+	}
+
+	key, err = s.byID(ctx, key.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &APIKeyToken{APIKey: *NewAPIKey(key), Token: token}, nil
+}
+
+// Update updates title, permissions, expiration time and status of the APIKey identified by id from the query.
+//
+//zenrpc:apiKey APIKey
+//zenrpc:return APIKey
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+//zenrpc:403 Service Account Or Permissions, Which Current User Doesn't Have
+//zenrpc:404 Not Found
+func (s APIKeyService) Update(ctx context.Context, apiKey APIKey) (bool, error) {
+	orig, err := s.byID(ctx, apiKey.ID)
+	if err != nil {
+		return false, err
+	}
+
+	// owner could not be changed
+	apiKey.UserID = orig.UserID
+	if ve := s.isValid(ctx, apiKey); ve.HasErrors() {
+		return false, ve.Error()
+	}
+
+	if err = s.checkGrant(ctx, apiKey); err != nil {
+		return false, err
+	}
+
+	ok, err := s.commonRepo.UpdateAPIKey(ctx, apiKey.ToDB(), db.WithColumns(db.Columns.APIKey.Title, db.Columns.APIKey.Permissions, db.Columns.APIKey.ExpiresAt, db.Columns.APIKey.StatusID))
+	if err != nil {
+		return false, InternalError(err)
+	}
+	return ok, nil
+}
+
+// Delete revokes the APIKey by its ID.
+//
+//zenrpc:id int
+//zenrpc:return isDeleted
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+//zenrpc:404 Not Found
+func (s APIKeyService) Delete(ctx context.Context, id int) (bool, error) {
+	if _, err := s.byID(ctx, id); err != nil {
+		return false, err
+	}
+
+	ok, err := s.commonRepo.DeleteAPIKey(ctx, id)
+	if err != nil {
+		return false, InternalError(err)
+	}
+	return ok, err
+}
+
+// Validate Verifies that APIKey data is valid.
+//
+//zenrpc:apiKey APIKey
+//zenrpc:return []FieldError
+//zenrpc:500 Internal Error
+func (s APIKeyService) Validate(ctx context.Context, apiKey APIKey) ([]FieldError, error) {
+	if apiKey.ID != 0 {
+		orig, err := s.byID(ctx, apiKey.ID)
+		if err != nil {
+			return nil, err
+		}
+		apiKey.UserID = orig.UserID
+	}
+
+	ve := s.isValid(ctx, apiKey)
+	if ve.HasInternalError() {
+		return nil, ve.Error()
+	}
+
+	return ve.Fields(), nil
+}
+
+// checkGrant returns ErrForbidden, if current user doesn't have all permissions of the key owner roles or key scopes,
+// so keys couldn't be used for privilege escalation.
+func (s APIKeyService) checkGrant(ctx context.Context, apiKey APIKey) error {
+	owner, err := s.commonRepo.UserByID(ctx, apiKey.UserID)
+	if err != nil {
+		return InternalError(err)
+	} else if owner == nil {
+		return ErrNotFound
+	}
+
+	permissions, err := rolesPermissions(ctx, &s.commonRepo, owner.RoleIDs)
+	if err != nil {
+		return InternalError(err)
+	}
+
+	return checkGrant(ctx, &s.commonRepo, slices.Concat(permissions, apiKey.Permissions))
+}
+
+func (s APIKeyService) isValid(ctx context.Context, apiKey APIKey) Validator {
+	var v Validator
+
+	if v.CheckBasic(ctx, apiKey); v.HasInternalError() {
+		return v
+	}
+
+	// check user is a service account
+	user, err := s.commonRepo.UserByID(ctx, apiKey.UserID)
+	if err != nil {
+		v.SetInternalError(err)
+	} else if user == nil || !user.IsService {
+		v.Append("userId", FieldErrorIncorrect)
+	}
+
+	// check permissions format: "*" or "namespace:action"
+	for _, p := range apiKey.Permissions {
+		if !isValidPermission(p) {
+			v.Append("permissions", FieldErrorIncorrect)
+			break
+		}
+	}
+
+	if apiKey.ExpiresAt != nil && !apiKey.ExpiresAt.After(time.Now()) {
+		v.Append("expiresAt", FieldErrorIncorrect)
+	}
+
+	return v
+}
@@ -0,0 +1,66 @@
+package vt
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"apisrv/pkg/db"
+	"apisrv/pkg/db/test"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAPIKey(t *testing.T) {
+	Convey("Test API key token", t, func() {
+		token := newAPIKeyToken()
+		So(token, ShouldHaveLength, len(apiKeyPrefix)+2*apiKeyBytes)
+		So(newAPIKeyToken(), ShouldNotEqual, token)
+		So(isAPIKey(token), ShouldBeTrue)
+		So(isAPIKey(newSessionToken()), ShouldBeFalse)
+		So(token[:apiKeyVisibleLen], ShouldStartWith, apiKeyPrefix)
+	})
+
+	Convey("Test API key expiration", t, func() {
+		now := time.Now()
+		expiresAt := now.Add(time.Hour)
+
+		So(db.APIKey{}.IsExpired(now), ShouldBeFalse)
+		So(db.APIKey{ExpiresAt: &expiresAt}.IsExpired(now), ShouldBeFalse)
+		So(db.APIKey{ExpiresAt: &expiresAt}.IsExpired(expiresAt), ShouldBeTrue)
+	})
+}
+
+func TestDB_APIKeyService(t *testing.T) {
+	Convey("Test APIKeyService grants", t, func() {
+		dbo, logger := test.Setup(t)
+		srv, users := NewAPIKeyService(dbo, logger), NewUserService(dbo, logger)
+		ctx := t.Context()
+
+		// editor role is seeded by init.sql
+		editorCtx := context.WithValue(ctx, userKey, &db.User{ID: 1, RoleIDs: []int{2}})
+
+		addService := func(roleIDs []int) int {
+			user, err := users.Add(ctx, User{Login: fmt.Sprintf("service_%d", time.Now().UnixNano()), IsService: true, StatusID: db.StatusEnabled, RoleIDs: roleIDs})
+			So(err, ShouldBeNil)
+			return user.ID
+		}
+
+		Convey("Key of service account with more permissions is refused", func() {
+			key, err := srv.Add(editorCtx, APIKey{Title: "admin", UserID: addService([]int{1}), StatusID: db.StatusEnabled})
+			So(err, ShouldEqual, ErrForbidden)
+			So(key, ShouldBeNil)
+		})
+
+		Convey("Key scopes could not be widened", func() {
+			key, err := srv.Add(editorCtx, APIKey{Title: "editor", UserID: addService([]int{2}), Permissions: []string{"news:read"}, StatusID: db.StatusEnabled})
+			So(err, ShouldBeNil)
+
+			key.APIKey.Permissions = []string{PermissionAll}
+			ok, err := srv.Update(editorCtx, key.APIKey)
+			So(err, ShouldEqual, ErrForbidden)
+			So(ok, ShouldBeFalse)
+		})
+	})
+}
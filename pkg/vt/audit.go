@@ -0,0 +1,278 @@
+package vt
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"apisrv/pkg/db"
+
+	"github.com/vmkteam/embedlog"
+	"github.com/vmkteam/vfs"
+	zm "github.com/vmkteam/zenrpc-middleware"
+	"github.com/vmkteam/zenrpc/v2"
+)
+
+const redacted = "[redacted]"
+
+// readMethods are methods of namespaces, which don't change data: they are allowed by "read" permission and are not audited.
+// Any other method, including a new one, is a write method until it is added here.
+var readMethods = map[string][]string{
+	NSAuth:       {RPC.AuthService.Profile, RPC.AuthService.Sessions},
+	NSUser:       {RPC.UserService.Count, RPC.UserService.Get, RPC.UserService.GetByID, RPC.UserService.CountLoginAttempts, RPC.UserService.GetLoginAttempts, RPC.UserService.Permissions, RPC.UserService.Validate},
+	NSRole:       {RPC.RoleService.Count, RPC.RoleService.Get, RPC.RoleService.GetByID, RPC.RoleService.Validate},
+	NSAPIKey:     {RPC.APIKeyService.Count, RPC.APIKeyService.Get, RPC.APIKeyService.GetByID, RPC.APIKeyService.Validate},
+	NSCategory:   {RPC.CategoryService.Count, RPC.CategoryService.Get, RPC.CategoryService.GetByID, RPC.CategoryService.Validate},
+	NSNews:       {RPC.NewsService.Count, RPC.NewsService.Get, RPC.NewsService.GetByID, RPC.NewsService.Revisions, RPC.NewsService.Revision, RPC.NewsService.RevisionDiff, RPC.NewsService.Validate},
+	NSTag:        {RPC.TagService.Count, RPC.TagService.Get, RPC.TagService.GetByID, RPC.TagService.GetOrphans, RPC.TagService.Validate},
+	NSModeration: {RPC.ModerationService.Count, RPC.ModerationService.Get, RPC.ModerationService.GetByID},
+	NSWebhook:    {RPC.WebhookService.Events, RPC.WebhookService.Count, RPC.WebhookService.Get, RPC.WebhookService.GetByID, RPC.WebhookService.Validate, RPC.WebhookService.CountDeliveries, RPC.WebhookService.GetDeliveries},
+	NSAudit:      {RPC.AuditService.Count, RPC.AuditService.Get},
+	NSTrash:      {RPC.TrashService.Count, RPC.TrashService.Get, RPC.TrashService.GetDependencies},
+	NSVFS: {
+		vfs.RPC.Service.GetFolder, vfs.RPC.Service.GetFolderBranch, vfs.RPC.Service.GetFiles, vfs.RPC.Service.CountFiles,
+		vfs.RPC.Service.SearchFolderByFileId, vfs.RPC.Service.SearchFolderByFile, vfs.RPC.Service.GetFavorites,
+		vfs.RPC.Service.HelpUpload, vfs.RPC.Service.UrlByHash, vfs.RPC.Service.UrlByHashList,
+	},
+}
+
+// secretParams are substrings of param names, which values are redacted in audit log.
+var secretParams = []string{"password", "secret", "token", "authkey", "code"}
+
+// auditMiddleware saves every non-read method call to audit log. It must be used after authMiddleware.
+func auditMiddleware(auditRepo db.AuditRepo, logger embedlog.Logger) zenrpc.MiddlewareFunc {
+	return func(h zenrpc.InvokeFunc) zenrpc.InvokeFunc {
+		return func(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
+			ns := zenrpc.NamespaceFromContext(ctx)
+			if isReadMethod(ns, method) {
+				return h(ctx, method, params)
+			}
+
+			start := time.Now()
+			resp := h(ctx, method, params)
+
+			record := &db.AuditLog{
+				Namespace: ns,
+				Method:    method,
+				EntityID:  auditEntityID(params, resp.Result),
+				Params:    redactParams(ns, params),
+				IsSuccess: resp.Error == nil,
+			}
+
+			if resp.Error != nil {
+				record.ErrorCode = &resp.Error.Code
+			}
+
+			addAuditLog(ctx, auditRepo, logger, record, start)
+
+			return resp
+		}
+	}
+}
+
+// HTTPAuditMiddleware saves every call of HTTP handler of the namespace method to audit log, query params are saved as params.
+// It must be used after HTTPAuthMiddleware.
+func HTTPAuditMiddleware(auditRepo db.AuditRepo, logger embedlog.Logger, ns, method string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(sw, r)
+
+		record := &db.AuditLog{
+			Namespace: ns,
+			Method:    method,
+			IsSuccess: sw.status < http.StatusBadRequest,
+		}
+
+		if query := r.URL.Query(); len(query) != 0 {
+			if b, err := json.Marshal(query); err == nil {
+				record.Params = redactParams(ns, b)
+			}
+		}
+
+		if !record.IsSuccess {
+			record.ErrorCode = &sw.status
+		}
+
+		addAuditLog(r.Context(), auditRepo, logger, record, start)
+	})
+}
+
+// statusWriter remembers response status code.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// addAuditLog fills request data of the call started at start and saves record to audit log, errors are only logged.
+func addAuditLog(ctx context.Context, auditRepo db.AuditRepo, logger embedlog.Logger, record *db.AuditLog, start time.Time) {
+	record.IP = zm.IPFromContext(ctx)
+	record.Duration = int(time.Since(start).Milliseconds())
+
+	if user := UserFromContext(ctx); user != nil {
+		record.UserID = &user.ID
+	}
+
+	if _, err := auditRepo.AddAuditLog(ctx, record); err != nil {
+		logger.Error(ctx, "add audit log", "namespace", record.Namespace, "method", record.Method, "err", err)
+	}
+}
+
+// isReadMethod checks that the namespace method doesn't change data.
+func isReadMethod(ns, method string) bool {
+	return slices.Contains(readMethods[ns], method)
+}
+
+// redactParams returns params with redacted secret values. Positional auth params are redacted entirely.
+func redactParams(ns string, params json.RawMessage) *string {
+	if len(params) == 0 {
+		return nil
+	}
+
+	var v any
+	if err := json.Unmarshal(params, &v); err != nil {
+		return nil
+	}
+
+	if _, ok := v.([]any); ok && ns == NSAuth {
+		s := redacted
+		return &s
+	}
+
+	b, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return nil
+	}
+
+	s := string(b)
+	return &s
+}
+
+func redactValue(v any) any {
+	switch vv := v.(type) {
+	case map[string]any:
+		for key, value := range vv {
+			if isSecretParam(key) {
+				vv[key] = redacted
+			} else {
+				vv[key] = redactValue(value)
+			}
+		}
+	case []any:
+		for i := range vv {
+			vv[i] = redactValue(vv[i])
+		}
+	}
+	return v
+}
+
+func isSecretParam(name string) bool {
+	name = strings.ToLower(name)
+	for _, s := range secretParams {
+		if strings.Contains(name, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// auditEntityID returns ID of the changed entity: id param, id of the first object param or id of the result object.
+func auditEntityID(params json.RawMessage, result *json.RawMessage) *int {
+	var named map[string]json.RawMessage
+	if err := json.Unmarshal(params, &named); err != nil {
+		var positional []json.RawMessage
+		if err := json.Unmarshal(params, &positional); err != nil || len(positional) == 0 {
+			return nil
+		}
+		named = map[string]json.RawMessage{"": positional[0]}
+	}
+
+	if id := jsonID(named["id"]); id != nil {
+		return id
+	}
+
+	for _, value := range named {
+		var obj struct {
+			ID *int `json:"id"`
+		}
+		if id := jsonID(value); id != nil {
+			return id
+		} else if json.Unmarshal(value, &obj) == nil && obj.ID != nil && *obj.ID > 0 {
+			return obj.ID
+		}
+	}
+
+	if result != nil {
+		var obj struct {
+			ID *int `json:"id"`
+		}
+		if json.Unmarshal(*result, &obj) == nil && obj.ID != nil && *obj.ID > 0 {
+			return obj.ID
+		}
+	}
+
+	return nil
+}
+
+// jsonID returns positive int value or nil.
+func jsonID(value json.RawMessage) *int {
+	var id int
+	if len(value) == 0 || json.Unmarshal(value, &id) != nil || id <= 0 {
+		return nil
+	}
+	return &id
+}
+
+type AuditService struct {
+	zenrpc.Service
+	embedlog.Logger
+	auditRepo db.AuditRepo
+}
+
+func NewAuditService(dbo db.DB, logger embedlog.Logger) *AuditService {
+	return &AuditService{
+		Logger:    logger,
+		auditRepo: db.NewAuditRepo(dbo),
+	}
+}
+
+// Count returns count of audit log records according to conditions in search params.
+//
+//zenrpc:search AuditLogSearch
+//zenrpc:return int
+//zenrpc:500 Internal Error
+func (s AuditService) Count(ctx context.Context, search *AuditLogSearch) (int, error) {
+	count, err := s.auditRepo.CountAuditLogs(ctx, search.ToDB())
+	if err != nil {
+		return 0, InternalError(err)
+	}
+	return count, nil
+}
+
+// Get returns а list of audit log records according to conditions in search params, latest first.
+//
+//zenrpc:search AuditLogSearch
+//zenrpc:viewOps ViewOps
+//zenrpc:return []AuditLog
+//zenrpc:500 Internal Error
+func (s AuditService) Get(ctx context.Context, search *AuditLogSearch, viewOps *ViewOps) ([]AuditLog, error) {
+	list, err := s.auditRepo.AuditLogsByFilters(ctx, search.ToDB(), viewOps.Pager(), s.auditRepo.DefaultAuditLogSort(), s.auditRepo.FullAuditLog())
+	if err != nil {
+		return nil, InternalError(err)
+	}
+	records := make([]AuditLog, 0, len(list))
+	for i := 0; i < len(list); i++ {
+		if record := NewAuditLog(&list[i]); record != nil {
+			records = append(records, *record)
+		}
+	}
+	return records, nil
+}
@@ -0,0 +1,27 @@
+package vt
+
+import (
+	"apisrv/pkg/db"
+)
+
+func NewAuditLog(in *db.AuditLog) *AuditLog {
+	if in == nil {
+		return nil
+	}
+
+	return &AuditLog{
+		ID:        in.ID,
+		UserID:    in.UserID,
+		IP:        in.IP,
+		Namespace: in.Namespace,
+		Method:    in.Method,
+		EntityID:  in.EntityID,
+		Params:    in.Params,
+		IsSuccess: in.IsSuccess,
+		ErrorCode: in.ErrorCode,
+		Duration:  in.Duration,
+		CreatedAt: in.CreatedAt,
+
+		User: NewUserSummary(in.User),
+	}
+}
@@ -0,0 +1,51 @@
+package vt
+
+import (
+	"time"
+
+	"apisrv/pkg/db"
+)
+
+type AuditLog struct {
+	ID        int    `json:"id"`
+	UserID    *int   `json:"userId"`
+	IP        string `json:"ip"`
+	Namespace string `json:"namespace"`
+	Method    string `json:"method"`
+	EntityID  *int   `json:"entityId"`
+	// Params is a JSON of method params with redacted secrets.
+	Params    *string `json:"params"`
+	IsSuccess bool    `json:"isSuccess"`
+	ErrorCode *int    `json:"errorCode"`
+	// Duration is a method call duration in milliseconds.
+	Duration  int       `json:"duration"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	User *UserSummary `json:"user"`
+}
+
+type AuditLogSearch struct {
+	UserID        *int       `json:"userId"`
+	Namespace     *string    `json:"namespace"`
+	Method        *string    `json:"method"`
+	EntityID      *int       `json:"entityId"`
+	IsSuccess     *bool      `json:"isSuccess"`
+	CreatedAtFrom *time.Time `json:"createdAtFrom"`
+	CreatedAtTo   *time.Time `json:"createdAtTo"`
+}
+
+func (als *AuditLogSearch) ToDB() *db.AuditLogSearch {
+	if als == nil {
+		return nil
+	}
+
+	return &db.AuditLogSearch{
+		UserID:        als.UserID,
+		Namespace:     als.Namespace,
+		Method:        als.Method,
+		EntityID:      als.EntityID,
+		IsSuccess:     als.IsSuccess,
+		CreatedAtFrom: als.CreatedAtFrom,
+		CreatedAtTo:   als.CreatedAtTo,
+	}
+}
@@ -0,0 +1,55 @@
+package vt
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/vmkteam/vfs"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAuditMiddleware_helpers(t *testing.T) {
+	Convey("Test isReadMethod", t, func() {
+		So(isReadMethod(NSNews, RPC.NewsService.Get), ShouldBeTrue)
+		So(isReadMethod(NSNews, RPC.NewsService.GetByID), ShouldBeTrue)
+		So(isReadMethod(NSNews, RPC.NewsService.RevisionDiff), ShouldBeTrue)
+		So(isReadMethod(NSNews, RPC.NewsService.Add), ShouldBeFalse)
+		So(isReadMethod(NSNews, RPC.NewsService.RestoreRevision), ShouldBeFalse)
+		So(isReadMethod(NSAuth, RPC.AuthService.Login), ShouldBeFalse)
+		So(isReadMethod(NSAuth, RPC.AuthService.ChangePassword), ShouldBeFalse)
+		So(isReadMethod(NSAuth, RPC.AuthService.Profile), ShouldBeTrue)
+		So(isReadMethod(NSVFS, vfs.RPC.Service.GetFiles), ShouldBeTrue)
+		So(isReadMethod(NSVFS, vfs.RPC.Service.DeleteFiles), ShouldBeFalse)
+		So(isReadMethod(NSTag, RPC.TagService.FixOrphans), ShouldBeFalse)
+		// methods are classified within their namespace only
+		So(isReadMethod(NSNews, RPC.AuthService.Profile), ShouldBeFalse)
+		So(isReadMethod(actionAll, RPC.NewsService.Get), ShouldBeFalse)
+	})
+
+	Convey("Test redactParams", t, func() {
+		params := redactParams(NSAuth, json.RawMessage(`{"login":"admin","password":"12345"}`))
+		So(*params, ShouldEqual, `{"login":"admin","password":"[redacted]"}`)
+
+		params = redactParams(NSAuth, json.RawMessage(`["admin","12345",true]`))
+		So(*params, ShouldEqual, redacted)
+
+		params = redactParams(NSWebhook, json.RawMessage(`{"webhook":{"id":1,"secret":"s","url":"https://example.com"}}`))
+		So(*params, ShouldEqual, `{"webhook":{"id":1,"secret":"[redacted]","url":"https://example.com"}}`)
+
+		params = redactParams(NSUser, json.RawMessage(`[{"login":"user","password":"12345"}]`))
+		So(*params, ShouldEqual, `[{"login":"user","password":"[redacted]"}]`)
+
+		So(redactParams(NSNews, nil), ShouldBeNil)
+	})
+
+	Convey("Test auditEntityID", t, func() {
+		result := json.RawMessage(`{"id":7,"title":"News"}`)
+
+		So(*auditEntityID(json.RawMessage(`{"id":5}`), nil), ShouldEqual, 5)
+		So(*auditEntityID(json.RawMessage(`[5]`), nil), ShouldEqual, 5)
+		So(*auditEntityID(json.RawMessage(`{"news":{"id":3,"title":"News"}}`), nil), ShouldEqual, 3)
+		So(*auditEntityID(json.RawMessage(`{"news":{"id":0,"title":"News"}}`), &result), ShouldEqual, 7)
+		So(auditEntityID(json.RawMessage(`{"login":"admin"}`), nil), ShouldBeNil)
+	})
+}
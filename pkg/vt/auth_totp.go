@@ -0,0 +1,391 @@
+package vt
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"apisrv/pkg/db"
+	"apisrv/pkg/totp"
+
+	"github.com/vmkteam/zenrpc/v2"
+)
+
+const (
+	// challengeTTL is a lifetime of the second login step token.
+	challengeTTL = 5 * time.Minute
+	// challengeScope separates challenge signatures from other HMACs keyed by the same user data.
+	challengeScope = "vt.totp.challenge:"
+
+	recoveryCodesCount = 10
+	recoveryCodeBytes  = 5
+)
+
+var (
+	errInvalidChallenge = zenrpc.NewStringError(http.StatusBadRequest, "invalid or expired challenge")
+	errInvalidCode      = zenrpc.NewStringError(http.StatusBadRequest, "invalid two-factor authentication code")
+	errTOTPEnabled      = zenrpc.NewStringError(http.StatusBadRequest, "two-factor authentication is already enabled")
+	errTOTPNotEnabled   = zenrpc.NewStringError(http.StatusBadRequest, "two-factor authentication is not enabled")
+	errTOTPNotEnrolled  = zenrpc.NewStringError(http.StatusBadRequest, "two-factor authentication is not enrolled")
+	errTOTPRequired     = zenrpc.NewStringError(http.StatusForbidden, "two-factor authentication is required")
+)
+
+// LoginTOTP completes login with two-factor authentication code or recovery code and starts a new session.
+// If enrollment is required, the code of the secret from LoginEnrollTOTP enables two-factor authentication
+// and recovery codes are returned.
+//
+//zenrpc:token Challenge token from Login
+//zenrpc:code TOTP code or recovery code
+//zenrpc:return User authentication key
+//zenrpc:400 Invalid challenge or code
+//zenrpc:429 Too many login attempts
+//zenrpc:500 Internal Error
+func (s AuthService) LoginTOTP(ctx context.Context, token, code string) (*LoginResult, error) {
+	dbu, remember, err := s.challengeUser(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	attempt := s.newAttempt(ctx, dbu.Login)
+	attempt.UserID = &dbu.ID
+	if err := s.checkAttempt(ctx, attempt); err != nil {
+		return nil, err
+	}
+
+	var res LoginResult
+	now := time.Now()
+	switch {
+	case dbu.IsTOTPEnabled:
+		if !checkTOTPCode(dbu, code, now) {
+			s.recordFailure(ctx, attempt)
+			return nil, errInvalidCode
+		}
+	case dbu.TOTPSecret == nil:
+		s.releaseAttempt(ctx, attempt)
+		return nil, errTOTPNotEnrolled
+	default:
+		if !enableTOTP(dbu, code, now) {
+			s.recordFailure(ctx, attempt)
+			return nil, errInvalidCode
+		}
+		res.RecoveryCodes = setRecoveryCodes(dbu)
+	}
+
+	if _, err := s.commonRepo.UpdateUserTOTP(ctx, dbu); err != nil {
+		return nil, InternalError(err)
+	}
+
+	authKey, err := s.completeLogin(ctx, dbu, remember, attempt)
+	if err != nil {
+		return nil, err
+	}
+	res.AuthKey = &authKey
+
+	return &res, nil
+}
+
+// LoginEnrollTOTP generates a new TOTP secret for the user, who must enroll two-factor authentication during login.
+// Enrollment is completed by LoginTOTP with the code of the secret.
+//
+//zenrpc:token Challenge token from Login
+//zenrpc:return TOTPEnrollment
+//zenrpc:400 Invalid challenge or two-factor authentication is already enabled
+//zenrpc:500 Internal Error
+func (s AuthService) LoginEnrollTOTP(ctx context.Context, token string) (*TOTPEnrollment, error) {
+	dbu, _, err := s.challengeUser(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.enroll(ctx, dbu)
+}
+
+// EnrollTOTP generates a new TOTP secret for current user. Enrollment is completed by ConfirmTOTP.
+//
+//zenrpc:return TOTPEnrollment
+//zenrpc:400 Two-factor authentication is already enabled
+//zenrpc:401 Invalid authentication credentials
+//zenrpc:500 Internal Error
+func (s AuthService) EnrollTOTP(ctx context.Context) (*TOTPEnrollment, error) {
+	user := UserFromContext(ctx)
+	if user == nil {
+		return nil, ErrUnauthorized
+	}
+
+	return s.enroll(ctx, user)
+}
+
+// ConfirmTOTP enables two-factor authentication for current user with the code of enrolled secret.
+// It returns one-time recovery codes, which are shown only once.
+//
+//zenrpc:code TOTP code
+//zenrpc:return Recovery codes
+//zenrpc:400 Invalid code or two-factor authentication is not enrolled
+//zenrpc:401 Invalid authentication credentials
+//zenrpc:500 Internal Error
+func (s AuthService) ConfirmTOTP(ctx context.Context, code string) ([]string, error) {
+	user := UserFromContext(ctx)
+	if user == nil {
+		return nil, ErrUnauthorized
+	} else if user.IsTOTPEnabled {
+		return nil, errTOTPEnabled
+	} else if user.TOTPSecret == nil {
+		return nil, errTOTPNotEnrolled
+	}
+
+	if !enableTOTP(user, code, time.Now()) {
+		return nil, errInvalidCode
+	}
+	codes := setRecoveryCodes(user)
+
+	if _, err := s.commonRepo.UpdateUserTOTP(ctx, user); err != nil {
+		return nil, InternalError(err)
+	}
+
+	return codes, nil
+}
+
+// GenerateRecoveryCodes replaces recovery codes of current user with new ones.
+//
+//zenrpc:code TOTP code or recovery code
+//zenrpc:return Recovery codes
+//zenrpc:400 Invalid code or two-factor authentication is not enabled
+//zenrpc:401 Invalid authentication credentials
+//zenrpc:500 Internal Error
+func (s AuthService) GenerateRecoveryCodes(ctx context.Context, code string) ([]string, error) {
+	user, err := s.verifiedTOTPUser(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	codes := setRecoveryCodes(user)
+	if _, err := s.commonRepo.UpdateUserTOTP(ctx, user); err != nil {
+		return nil, InternalError(err)
+	}
+
+	return codes, nil
+}
+
+// DisableTOTP disables two-factor authentication of current user, if it is not required by config or user roles.
+//
+//zenrpc:code TOTP code or recovery code
+//zenrpc:return isDisabled
+//zenrpc:400 Invalid code or two-factor authentication is not enabled
+//zenrpc:401 Invalid authentication credentials
+//zenrpc:403 Two-factor authentication is required
+//zenrpc:500 Internal Error
+func (s AuthService) DisableTOTP(ctx context.Context, code string) (bool, error) {
+	user := UserFromContext(ctx)
+	if user == nil {
+		return false, ErrUnauthorized
+	}
+
+	isRequired, err := s.guard.isTOTPRequired(ctx, user)
+	if err != nil {
+		return false, InternalError(err)
+	} else if isRequired {
+		return false, errTOTPRequired
+	}
+
+	if user, err = s.verifiedTOTPUser(ctx, code); err != nil {
+		return false, err
+	}
+
+	resetTOTP(user)
+	if _, err := s.commonRepo.UpdateUserTOTP(ctx, user); err != nil {
+		return false, InternalError(err)
+	}
+
+	return true, nil
+}
+
+// verifiedTOTPUser returns current user with enabled two-factor authentication, if the code is valid.
+// Used code is saved, so it could not be used again.
+func (s AuthService) verifiedTOTPUser(ctx context.Context, code string) (*db.User, error) {
+	user := UserFromContext(ctx)
+	if user == nil {
+		return nil, ErrUnauthorized
+	} else if !user.IsTOTPEnabled {
+		return nil, errTOTPNotEnabled
+	}
+
+	if !checkTOTPCode(user, code, time.Now()) {
+		return nil, errInvalidCode
+	}
+
+	if _, err := s.commonRepo.UpdateUserTOTP(ctx, user); err != nil {
+		return nil, InternalError(err)
+	}
+
+	return user, nil
+}
+
+// enroll saves a new pending TOTP secret of the user and returns it with provisioning URI.
+func (s AuthService) enroll(ctx context.Context, u *db.User) (*TOTPEnrollment, error) {
+	if u.IsTOTPEnabled {
+		return nil, errTOTPEnabled
+	}
+
+	secret := totp.NewSecret()
+	resetTOTP(u)
+	u.TOTPSecret = &secret
+
+	if _, err := s.commonRepo.UpdateUserTOTP(ctx, u); err != nil {
+		return nil, InternalError(err)
+	}
+
+	return &TOTPEnrollment{
+		Secret: secret,
+		URI:    totp.URI(s.guard.cfg.TOTPIssuer, u.Login, secret),
+	}, nil
+}
+
+// challengeUser returns enabled user and remember flag of valid challenge token.
+func (s AuthService) challengeUser(ctx context.Context, token string) (*db.User, bool, error) {
+	userID, remember, ok := parseChallenge(token, time.Now())
+	if !ok {
+		return nil, false, errInvalidChallenge
+	}
+
+	dbu, err := s.commonRepo.UserByID(ctx, userID)
+	if err != nil {
+		return nil, false, InternalError(err)
+	} else if dbu == nil || dbu.StatusID != db.StatusEnabled || !verifyChallenge(token, dbu.Password) {
+		return nil, false, errInvalidChallenge
+	}
+
+	return dbu, remember, nil
+}
+
+// newLoginChallenge returns a challenge of the second login step for the user, who passed password check.
+func newLoginChallenge(u *db.User, remember bool, now time.Time) *LoginChallenge {
+	expiresAt := now.Add(challengeTTL)
+
+	return &LoginChallenge{
+		Token:            signChallenge(u.ID, remember, expiresAt, u.Password),
+		ExpiresAt:        expiresAt,
+		IsEnrollRequired: !u.IsTOTPEnabled,
+	}
+}
+
+// signChallenge returns "userID.expiresAt.remember.signature" token. It is signed with user password hash,
+// so it is valid only for the user and becomes invalid after password change.
+func signChallenge(userID int, remember bool, expiresAt time.Time, key string) string {
+	payload := strings.Join([]string{strconv.Itoa(userID), strconv.FormatInt(expiresAt.Unix(), 10), strconv.FormatBool(remember)}, ".")
+	return payload + "." + challengeSignature(payload, key)
+}
+
+// parseChallenge returns user ID and remember flag of unexpired challenge token, signature is checked by verifyChallenge.
+func parseChallenge(token string, now time.Time) (int, bool, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 4 {
+		return 0, false, false
+	}
+
+	userID, err := strconv.Atoi(parts[0])
+	if err != nil || userID <= 0 {
+		return 0, false, false
+	}
+
+	expiresAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || !now.Before(time.Unix(expiresAt, 0)) {
+		return 0, false, false
+	}
+
+	remember, err := strconv.ParseBool(parts[2])
+	if err != nil {
+		return 0, false, false
+	}
+
+	return userID, remember, true
+}
+
+// verifyChallenge checks challenge token signature.
+func verifyChallenge(token, key string) bool {
+	i := strings.LastIndex(token, ".")
+	if i < 0 {
+		return false
+	}
+
+	return hmac.Equal([]byte(token[i+1:]), []byte(challengeSignature(token[:i], key)))
+}
+
+func challengeSignature(payload, key string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(challengeScope + payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// enableTOTP enables two-factor authentication, if code of the pending secret is valid.
+func enableTOTP(u *db.User, code string, now time.Time) bool {
+	counter, ok := totp.Validate(*u.TOTPSecret, strings.TrimSpace(code), now, u.TOTPCounter)
+	if !ok {
+		return false
+	}
+
+	u.IsTOTPEnabled, u.TOTPCounter = true, counter
+	return true
+}
+
+// checkTOTPCode checks TOTP code or recovery code of the user. Used TOTP code counter is saved and used recovery code is removed,
+// so each code could be used once.
+func checkTOTPCode(u *db.User, code string, now time.Time) bool {
+	if u.TOTPSecret == nil {
+		return false
+	}
+
+	if counter, ok := totp.Validate(*u.TOTPSecret, strings.TrimSpace(code), now, u.TOTPCounter); ok {
+		u.TOTPCounter = counter
+		return true
+	}
+
+	hash := hashToken(normalizeRecoveryCode(code))
+	if i := slices.Index(u.RecoveryCodes, hash); i >= 0 {
+		u.RecoveryCodes = slices.Delete(u.RecoveryCodes, i, i+1)
+		return true
+	}
+
+	return false
+}
+
+// setRecoveryCodes replaces user recovery codes with new ones and returns them, only hashes are stored in db.
+func setRecoveryCodes(u *db.User) []string {
+	codes := newRecoveryCodes()
+
+	u.RecoveryCodes = make([]string, 0, len(codes))
+	for _, code := range codes {
+		u.RecoveryCodes = append(u.RecoveryCodes, hashToken(normalizeRecoveryCode(code)))
+	}
+
+	return codes
+}
+
+// resetTOTP disables two-factor authentication and removes secret and recovery codes.
+func resetTOTP(u *db.User) {
+	u.TOTPSecret, u.IsTOTPEnabled, u.TOTPCounter, u.RecoveryCodes = nil, false, 0, []string{}
+}
+
+// newRecoveryCodes returns crypto-random recovery codes in "xxxxx-xxxxx" format.
+func newRecoveryCodes() []string {
+	codes := make([]string, 0, recoveryCodesCount)
+	b := make([]byte, recoveryCodeBytes)
+	for range recoveryCodesCount {
+		_, _ = rand.Read(b)
+		code := hex.EncodeToString(b)
+		codes = append(codes, code[:len(code)/2]+"-"+code[len(code)/2:])
+	}
+
+	return codes
+}
+
+// normalizeRecoveryCode returns lowercase code without spaces and dashes.
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+}
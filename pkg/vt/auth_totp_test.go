@@ -0,0 +1,88 @@
+package vt
+
+import (
+	"testing"
+	"time"
+
+	"apisrv/pkg/db"
+	"apisrv/pkg/totp"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTOTPChallenge(t *testing.T) {
+	Convey("Test challenge token", t, func() {
+		now := time.Now()
+		u := &db.User{ID: 7, Password: "hash"}
+
+		c := newLoginChallenge(u, true, now)
+		So(c.IsEnrollRequired, ShouldBeTrue)
+		So(c.ExpiresAt, ShouldEqual, now.Add(challengeTTL))
+
+		userID, remember, ok := parseChallenge(c.Token, now)
+		So(ok, ShouldBeTrue)
+		So(userID, ShouldEqual, 7)
+		So(remember, ShouldBeTrue)
+		So(verifyChallenge(c.Token, u.Password), ShouldBeTrue)
+
+		Convey("Expired token", func() {
+			_, _, ok := parseChallenge(c.Token, now.Add(challengeTTL))
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("Changed password", func() {
+			So(verifyChallenge(c.Token, "new hash"), ShouldBeFalse)
+		})
+
+		Convey("Forged token", func() {
+			forged := signChallenge(8, true, c.ExpiresAt, "other")
+			So(verifyChallenge(forged, u.Password), ShouldBeFalse)
+
+			_, _, ok := parseChallenge("7.abc.true.sig", now)
+			So(ok, ShouldBeFalse)
+			So(verifyChallenge("token", u.Password), ShouldBeFalse)
+		})
+	})
+}
+
+func TestTOTPCode(t *testing.T) {
+	Convey("Test TOTP enrollment and codes", t, func() {
+		now := time.Now()
+		secret := totp.NewSecret()
+		u := &db.User{TOTPSecret: &secret}
+
+		code, err := totp.Code(secret, now)
+		So(err, ShouldBeNil)
+		So(enableTOTP(u, "000000x", now), ShouldBeFalse)
+		So(enableTOTP(u, code, now), ShouldBeTrue)
+		So(u.IsTOTPEnabled, ShouldBeTrue)
+
+		codes := setRecoveryCodes(u)
+		So(codes, ShouldHaveLength, recoveryCodesCount)
+		So(u.RecoveryCodes, ShouldHaveLength, recoveryCodesCount)
+		So(codes[0], ShouldHaveLength, 2*recoveryCodeBytes+1)
+		So(u.RecoveryCodes, ShouldNotContain, codes[0])
+
+		Convey("TOTP code is used once", func() {
+			next, err := totp.Code(secret, now.Add(totp.Period))
+			So(err, ShouldBeNil)
+			So(checkTOTPCode(u, code, now), ShouldBeFalse)
+			So(checkTOTPCode(u, next, now.Add(totp.Period)), ShouldBeTrue)
+			So(checkTOTPCode(u, next, now.Add(totp.Period)), ShouldBeFalse)
+		})
+
+		Convey("Recovery code is used once", func() {
+			So(checkTOTPCode(u, " "+codes[1]+" ", now), ShouldBeTrue)
+			So(u.RecoveryCodes, ShouldHaveLength, recoveryCodesCount-1)
+			So(checkTOTPCode(u, codes[1], now), ShouldBeFalse)
+		})
+
+		Convey("Reset", func() {
+			resetTOTP(u)
+			So(u.IsTOTPEnabled, ShouldBeFalse)
+			So(u.TOTPSecret, ShouldBeNil)
+			So(u.RecoveryCodes, ShouldBeEmpty)
+			So(checkTOTPCode(u, codes[2], now), ShouldBeFalse)
+		})
+	})
+}
@@ -0,0 +1,278 @@
+package vt
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"apisrv/pkg/db"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/vmkteam/zenrpc/v2"
+)
+
+const (
+	defaultLoginWindow     = 15 * time.Minute
+	defaultLoginDelayAfter = 3
+	defaultLoginBaseDelay  = time.Second
+	defaultLoginMaxDelay   = 30 * time.Second
+	defaultLoginLimit      = 10
+	defaultLoginIPLimit    = 50
+	defaultLoginLockout    = 15 * time.Minute
+	defaultTOTPIssuer      = "apisrv"
+)
+
+// LoginConfig is a configuration of auth.Login brute-force protection and two-factor authentication.
+type LoginConfig struct {
+	// Window is a period of failed attempts counting, default is 15m.
+	Window time.Duration
+	// DelayAfter is a number of failures, after which next attempt is allowed only after a delay, default is 3.
+	DelayAfter int
+	// BaseDelay is a delay after DelayAfter failures, it doubles after each next failure up to MaxDelay, default is 1s and 30s.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// LoginLimit and IPLimit are numbers of failures, which lock login or IP for Lockout, default is 10 and 50.
+	LoginLimit int
+	IPLimit    int
+	// Lockout is a lock duration after the last failure, default is 15m.
+	Lockout time.Duration
+	// RequireTOTP enforces two-factor authentication for all users, otherwise it is enforced by user roles.
+	// Sessions of users without two-factor authentication could only enroll it, see the profile or log out.
+	RequireTOTP bool
+	// TOTPIssuer is an issuer name shown in authenticator apps, default is apisrv.
+	TOTPIssuer string
+}
+
+// LoginStats is a number of login attempts by result.
+type LoginStats struct {
+	Success uint64
+	Invalid uint64
+	Delayed uint64
+	Locked  uint64
+}
+
+// LoginGuard protects auth.Login from brute-force attacks: it counts failed attempts per login and per IP,
+// delays next attempts progressively and locks login or IP temporarily after too many failures.
+// All attempts are saved to login history, which is also a storage of failures, so limits are shared between app instances.
+// Allowed attempt is reserved as a failure before credentials check, so parallel attempts can't bypass limits.
+type LoginGuard struct {
+	cfg  LoginConfig
+	db   db.DB
+	repo db.CommonRepo
+
+	success, invalid, delayed, locked atomic.Uint64
+}
+
+func NewLoginGuard(dbo db.DB, cfg LoginConfig) *LoginGuard {
+	if cfg.Window <= 0 {
+		cfg.Window = defaultLoginWindow
+	}
+	if cfg.DelayAfter <= 0 {
+		cfg.DelayAfter = defaultLoginDelayAfter
+	}
+	if cfg.BaseDelay <= 0 {
+		cfg.BaseDelay = defaultLoginBaseDelay
+	}
+	if cfg.MaxDelay <= 0 {
+		cfg.MaxDelay = defaultLoginMaxDelay
+	}
+	if cfg.LoginLimit <= 0 {
+		cfg.LoginLimit = defaultLoginLimit
+	}
+	if cfg.IPLimit <= 0 {
+		cfg.IPLimit = defaultLoginIPLimit
+	}
+	if cfg.Lockout <= 0 {
+		cfg.Lockout = defaultLoginLockout
+	}
+	if cfg.TOTPIssuer == "" {
+		cfg.TOTPIssuer = defaultTOTPIssuer
+	}
+
+	return &LoginGuard{
+		cfg:  cfg,
+		db:   dbo,
+		repo: db.NewCommonRepo(dbo),
+	}
+}
+
+// Stats returns login attempts counters.
+func (g *LoginGuard) Stats() LoginStats {
+	return LoginStats{
+		Success: g.success.Load(),
+		Invalid: g.invalid.Load(),
+		Delayed: g.delayed.Load(),
+		Locked:  g.locked.Load(),
+	}
+}
+
+// Reserve returns a reason and retry delay, if login attempt is not allowed now. Empty reason means allowed attempt,
+// which is saved to history as a failure with invalid credentials until it is completed by Record or removed by Release.
+// Failures are read and the attempt is saved under login and IP locks, so parallel attempts count each other.
+func (g *LoginGuard) Reserve(ctx context.Context, attempt *db.LoginAttempt, now time.Time) (reason string, retry time.Duration, err error) {
+	locks := []string{"login:" + attempt.Login, "loginIP:" + attempt.IP}
+
+	err = g.db.RunInLocks(ctx, locks, func(tx *pg.Tx) error {
+		repo := g.repo.WithTransaction(tx)
+		if reason, retry, err = g.failures(ctx, repo, attempt.Login, attempt.IP, now); err != nil || reason != "" {
+			return err
+		}
+
+		invalid := db.LoginReasonInvalid
+		attempt.Reason = &invalid
+		if _, err := repo.AddLoginAttempt(ctx, g.truncate(attempt)); err != nil {
+			return fmt.Errorf("add login attempt: %w", err)
+		}
+
+		return nil
+	})
+
+	return reason, retry, err
+}
+
+// failures returns a reason and retry delay, if login attempt from ip is not allowed now.
+func (g *LoginGuard) failures(ctx context.Context, repo db.CommonRepo, login, ip string, now time.Time) (string, time.Duration, error) {
+	from := now.Add(-g.cfg.Window)
+
+	byLogin, err := repo.FailedLogins(ctx, db.Columns.LoginAttempt.Login, login, from)
+	if err != nil {
+		return "", 0, fmt.Errorf("read login failures: %w", err)
+	}
+
+	byIP, err := repo.FailedLogins(ctx, db.Columns.LoginAttempt.IP, ip, from)
+	if err != nil {
+		return "", 0, fmt.Errorf("read ip failures: %w", err)
+	}
+
+	reason, retry := g.check(byLogin, g.cfg.LoginLimit, now)
+	if ipReason, ipRetry := g.check(byIP, g.cfg.IPLimit, now); ipRetry > retry {
+		reason, retry = ipReason, ipRetry
+	}
+
+	return reason, retry, nil
+}
+
+// check returns locked reason if failures reached limit and lockout is not over,
+// delayed reason if failures reached DelayAfter and progressive delay is not over.
+func (g *LoginGuard) check(f db.LoginFailures, limit int, now time.Time) (string, time.Duration) {
+	if f.Last == nil {
+		return "", 0
+	}
+
+	if f.Count >= limit {
+		if retry := f.Last.Add(g.cfg.Lockout).Sub(now); retry > 0 {
+			return db.LoginReasonLocked, retry
+		}
+		return "", 0
+	}
+
+	if f.Count >= g.cfg.DelayAfter {
+		if retry := f.Last.Add(g.delay(f.Count)).Sub(now); retry > 0 {
+			return db.LoginReasonDelayed, retry
+		}
+	}
+
+	return "", 0
+}
+
+// delay returns a min interval after the last of failures.
+func (g *LoginGuard) delay(failures int) time.Duration {
+	delay := g.cfg.BaseDelay
+	for i := g.cfg.DelayAfter; i < failures && delay < g.cfg.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, g.cfg.MaxDelay)
+}
+
+// Record saves login attempt to history and counts it. Reserved attempt is updated, other one is added.
+// Successful attempt clears previous failures of the login.
+func (g *LoginGuard) Record(ctx context.Context, attempt *db.LoginAttempt) error {
+	if attempt.IsSuccess {
+		g.success.Add(1)
+	} else if attempt.Reason != nil {
+		switch *attempt.Reason {
+		case db.LoginReasonInvalid:
+			g.invalid.Add(1)
+		case db.LoginReasonDelayed:
+			g.delayed.Add(1)
+		case db.LoginReasonLocked:
+			g.locked.Add(1)
+		}
+	}
+
+	if attempt.IsSuccess {
+		attempt.Reason = nil
+	}
+
+	if attempt.ID == 0 {
+		if _, err := g.repo.AddLoginAttempt(ctx, g.truncate(attempt)); err != nil {
+			return fmt.Errorf("add login attempt: %w", err)
+		}
+	} else if _, err := g.repo.UpdateLoginAttempt(ctx, attempt, db.WithColumns(db.Columns.LoginAttempt.UserID, db.Columns.LoginAttempt.IsSuccess, db.Columns.LoginAttempt.Reason)); err != nil {
+		return fmt.Errorf("update login attempt: %w", err)
+	}
+
+	if attempt.IsSuccess {
+		if _, err := g.repo.ClearFailedLogins(ctx, db.Columns.LoginAttempt.Login, attempt.Login); err != nil {
+			return fmt.Errorf("clear login failures: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Release removes reserved attempt, which is neither a success nor a failure, e.g. a valid first step of two-factor login.
+func (g *LoginGuard) Release(ctx context.Context, attempt *db.LoginAttempt) error {
+	if attempt.ID == 0 {
+		return nil
+	}
+
+	if _, err := g.repo.DeleteLoginAttempt(ctx, attempt.ID); err != nil {
+		return fmt.Errorf("delete login attempt: %w", err)
+	}
+	attempt.ID, attempt.Reason = 0, nil
+
+	return nil
+}
+
+// truncate cuts too long user agent of the attempt.
+func (g *LoginGuard) truncate(attempt *db.LoginAttempt) *db.LoginAttempt {
+	if len(attempt.UserAgent) > maxUserAgentLen {
+		attempt.UserAgent = attempt.UserAgent[:maxUserAgentLen]
+	}
+
+	return attempt
+}
+
+// newLoginRetryError returns 429 error with retryAfter seconds in data.
+func newLoginRetryError(reason string, retry time.Duration) *zenrpc.Error {
+	msg := "too many login attempts, retry later"
+	if reason == db.LoginReasonLocked {
+		msg = "login is temporarily locked, retry later"
+	}
+
+	return &zenrpc.Error{
+		Code:    http.StatusTooManyRequests,
+		Message: msg,
+		Data:    map[string]int{"retryAfter": int(math.Ceil(retry.Seconds()))},
+	}
+}
+
+// isTOTPRequired checks that two-factor authentication is required for the user by config or by one of enabled user roles.
+func (g *LoginGuard) isTOTPRequired(ctx context.Context, u *db.User) (bool, error) {
+	if g.cfg.RequireTOTP {
+		return true, nil
+	}
+
+	roles, err := userRoles(ctx, &g.repo, u)
+	if err != nil {
+		return false, err
+	}
+
+	return slices.ContainsFunc(roles, func(r db.Role) bool { return r.IsTOTPRequired }), nil
+}
@@ -0,0 +1,104 @@
+package vt
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"apisrv/pkg/db"
+	"apisrv/pkg/db/test"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLoginGuard_check(t *testing.T) {
+	g := NewLoginGuard(db.DB{}, LoginConfig{})
+	now := time.Now()
+	failures := func(count int, ago time.Duration) db.LoginFailures {
+		last := now.Add(-ago)
+		return db.LoginFailures{Count: count, Last: &last}
+	}
+
+	Convey("Test delay", t, func() {
+		So(g.delay(3), ShouldEqual, time.Second)
+		So(g.delay(4), ShouldEqual, 2*time.Second)
+		So(g.delay(6), ShouldEqual, 8*time.Second)
+		So(g.delay(9), ShouldEqual, 30*time.Second)
+		So(g.delay(100), ShouldEqual, 30*time.Second)
+	})
+
+	Convey("Test check", t, func() {
+		reason, retry := g.check(db.LoginFailures{}, g.cfg.LoginLimit, now)
+		So(reason, ShouldBeEmpty)
+		So(retry, ShouldBeZeroValue)
+
+		reason, _ = g.check(failures(2, 0), g.cfg.LoginLimit, now)
+		So(reason, ShouldBeEmpty)
+
+		reason, retry = g.check(failures(5, time.Second), g.cfg.LoginLimit, now)
+		So(reason, ShouldEqual, db.LoginReasonDelayed)
+		So(retry, ShouldEqual, 3*time.Second)
+
+		reason, _ = g.check(failures(5, 5*time.Second), g.cfg.LoginLimit, now)
+		So(reason, ShouldBeEmpty)
+
+		reason, retry = g.check(failures(10, time.Minute), g.cfg.LoginLimit, now)
+		So(reason, ShouldEqual, db.LoginReasonLocked)
+		So(retry, ShouldEqual, 14*time.Minute)
+
+		reason, _ = g.check(failures(10, 20*time.Minute), g.cfg.LoginLimit, now)
+		So(reason, ShouldBeEmpty)
+
+		reason, retry = g.check(failures(10, 10*time.Second), g.cfg.IPLimit, now)
+		So(reason, ShouldEqual, db.LoginReasonDelayed)
+		So(retry, ShouldEqual, 20*time.Second)
+	})
+
+	Convey("Test newLoginRetryError", t, func() {
+		err := newLoginRetryError(db.LoginReasonLocked, 1500*time.Millisecond)
+		So(err.Code, ShouldEqual, 429)
+		So(err.Data, ShouldResemble, map[string]int{"retryAfter": 2})
+	})
+}
+
+func TestDB_LoginGuard_Reserve(t *testing.T) {
+	dbo, _ := test.Setup(t)
+	g := NewLoginGuard(dbo, LoginConfig{})
+	ctx := context.Background()
+	login := fmt.Sprintf("guard-%d", time.Now().UnixNano())
+
+	Convey("Test parallel attempts are counted before credentials check", t, func() {
+		var (
+			wg      sync.WaitGroup
+			mu      sync.Mutex
+			allowed []*db.LoginAttempt
+		)
+
+		for range 2 * g.cfg.DelayAfter {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				attempt := &db.LoginAttempt{Login: login, IP: "192.0.2.1"}
+				reason, _, err := g.Reserve(ctx, attempt, time.Now())
+				if err == nil && reason == "" {
+					mu.Lock()
+					allowed = append(allowed, attempt)
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+
+		So(allowed, ShouldHaveLength, g.cfg.DelayAfter)
+
+		Convey("Released attempt is not a failure", func() {
+			So(g.Release(ctx, allowed[0]), ShouldBeNil)
+
+			failures, err := g.repo.FailedLogins(ctx, db.Columns.LoginAttempt.Login, login, time.Now().Add(-time.Hour))
+			So(err, ShouldBeNil)
+			So(failures.Count, ShouldEqual, g.cfg.DelayAfter-1)
+		})
+	})
+}
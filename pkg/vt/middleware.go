@@ -4,21 +4,25 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"slices"
 	"time"
 
 	"apisrv/pkg/db"
 
 	"github.com/vmkteam/embedlog"
+	zm "github.com/vmkteam/zenrpc-middleware"
 	"github.com/vmkteam/zenrpc/v2"
 )
 
 type userCtx string
 
 const (
-	userKey userCtx = "vt.user"
+	userKey    userCtx = "vt.user"
+	sessionKey userCtx = "vt.session"
+	apiKeyKey  userCtx = "vt.apiKey"
 )
 
-func authMiddleware(commonRepo *db.CommonRepo, logger embedlog.Logger) zenrpc.MiddlewareFunc {
+func authMiddleware(commonRepo *db.CommonRepo, guard *LoginGuard, logger embedlog.Logger) zenrpc.MiddlewareFunc {
 	return func(h zenrpc.InvokeFunc) zenrpc.InvokeFunc {
 		return func(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
 			req, ok := zenrpc.RequestFromContext(ctx)
@@ -28,35 +32,111 @@ func authMiddleware(commonRepo *db.CommonRepo, logger embedlog.Logger) zenrpc.Mi
 
 			ns := zenrpc.NamespaceFromContext(ctx)
 
-			// skip auth.Login method
-			if ns == NSAuth && method == RPC.AuthService.Login {
+			// skip auth.Login methods
+			if ns == NSAuth && (method == RPC.AuthService.Login || method == RPC.AuthService.LoginTOTP || method == RPC.AuthService.LoginEnrollTOTP) {
 				return h(ctx, method, params)
 			}
 
-			authHeader := req.Header.Get(AuthKey)
-			// return error if header is not set
-			if authHeader == "" {
-				return zenrpc.NewResponseError(zenrpc.IDFromContext(ctx), ErrUnauthorized.Code, ErrUnauthorized.Message, ErrUnauthorized.Data)
+			// service accounts use API keys instead of sessions
+			token := req.Header.Get(AuthKey)
+			if isAPIKey(token) {
+				key, err := authenticateAPIKey(ctx, commonRepo, token)
+				if err != nil || key == nil {
+					return zenrpc.NewResponseError(zenrpc.IDFromContext(ctx), ErrUnauthorized.Code, ErrUnauthorized.Message, ErrUnauthorized.Data)
+				}
+
+				if err := trackAPIKeyUsage(ctx, commonRepo, key, zm.IPFromContext(ctx)); err != nil {
+					logger.Errorf("update api key usage error=%s", err)
+				}
+
+				ctx = context.WithValue(ctx, apiKeyKey, key)
+				return h(context.WithValue(ctx, userKey, key.User), method, params)
 			}
 
-			// return error  if user not found
-			dbu, err := commonRepo.EnabledUserByAuthKey(ctx, authHeader)
-			if err != nil || dbu == nil {
+			// return error if header is not set or session not found
+			session, err := authenticate(ctx, commonRepo, token)
+			if err != nil || session == nil {
 				return zenrpc.NewResponseError(zenrpc.IDFromContext(ctx), ErrUnauthorized.Code, ErrUnauthorized.Message, ErrUnauthorized.Data)
 			}
 
 			// updating last activity
-			if dbu.LastActivityAt == nil || time.Since(*dbu.LastActivityAt) > time.Second*90 {
-				if _, err := commonRepo.UpdateUserActivity(ctx, dbu); err != nil {
-					logger.Errorf("update user activity error=%s", err)
+			if time.Since(session.LastActivityAt) > sessionActivityInterval {
+				if err := commonRepo.UpdateSessionActivity(ctx, session, session.User); err != nil {
+					logger.Errorf("update session activity error=%s", err)
 				}
 			}
 
-			return h(context.WithValue(ctx, userKey, dbu), method, params)
+			// sessions started before two-factor authentication was required could only enroll it
+			if err := checkTOTP(ctx, guard, session.User, ns, method); err != nil {
+				return zenrpc.NewResponseError(zenrpc.IDFromContext(ctx), err.Code, err.Message, err.Data)
+			}
+
+			ctx = context.WithValue(ctx, sessionKey, session)
+			return h(context.WithValue(ctx, userKey, session.User), method, params)
+		}
+	}
+}
+
+// totpEnrollMethods are auth methods, which are allowed for sessions of users without required two-factor authentication.
+var totpEnrollMethods = []string{
+	RPC.AuthService.Profile, RPC.AuthService.EnrollTOTP, RPC.AuthService.ConfirmTOTP, RPC.AuthService.Logout,
+}
+
+// checkTOTP returns errTOTPRequired, if two-factor authentication is required for the user, but it is not enabled,
+// and the namespace method is not one of totpEnrollMethods.
+func checkTOTP(ctx context.Context, guard *LoginGuard, user *db.User, ns, method string) *zenrpc.Error {
+	if user.IsTOTPEnabled || (ns == NSAuth && slices.Contains(totpEnrollMethods, method)) {
+		return nil
+	}
+
+	isRequired, err := guard.isTOTPRequired(ctx, user)
+	if err != nil {
+		return InternalError(err)
+	} else if isRequired {
+		return errTOTPRequired
+	}
+
+	return nil
+}
+
+// dataChangeMethods are methods of namespaces, which change news, categories or tags.
+var dataChangeMethods = map[string][]string{
+	NSNews: {
+		RPC.NewsService.Add, RPC.NewsService.Update, RPC.NewsService.Delete, RPC.NewsService.UpdateStatus,
+		RPC.NewsService.DeleteMany, RPC.NewsService.RestoreRevision,
+	},
+	NSCategory: {
+		RPC.CategoryService.Add, RPC.CategoryService.Update, RPC.CategoryService.Delete, RPC.CategoryService.UpdateStatus,
+		RPC.CategoryService.DeleteMany,
+	},
+	NSTag: {
+		RPC.TagService.Add, RPC.TagService.Update, RPC.TagService.Delete, RPC.TagService.UpdateStatus,
+		RPC.TagService.DeleteMany, RPC.TagService.Merge, RPC.TagService.FixOrphans,
+	},
+	NSModeration: {RPC.ModerationService.Approve},
+	NSTrash:      {RPC.TrashService.Restore},
+}
+
+// DataChangedMiddleware calls fn after successful add, update, delete, bulk status change or bulk delete of news, categories or tags,
+// tags merge and orphan tags fix, news revision restore, suggestion approval and trash restore.
+func DataChangedMiddleware(fn func()) zenrpc.MiddlewareFunc {
+	return func(h zenrpc.InvokeFunc) zenrpc.InvokeFunc {
+		return func(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
+			resp := h(ctx, method, params)
+			if resp.Error == nil && isDataChangeMethod(zenrpc.NamespaceFromContext(ctx), method) {
+				fn()
+			}
+
+			return resp
 		}
 	}
 }
 
+// isDataChangeMethod checks that the namespace method changes news, categories or tags.
+func isDataChangeMethod(ns, method string) bool {
+	return slices.Contains(dataChangeMethods[ns], method)
+}
+
 func UserFromContext(ctx context.Context) *db.User {
 	if user, ok := ctx.Value(userKey).(*db.User); ok {
 		return user
@@ -64,8 +144,17 @@ func UserFromContext(ctx context.Context) *db.User {
 	return nil
 }
 
-// HTTPAuthMiddleware checks user from authKey header
-func HTTPAuthMiddleware(commonRepo db.CommonRepo, next http.Handler) http.Handler {
+// SessionFromContext returns current user session.
+func SessionFromContext(ctx context.Context) *db.Session {
+	if session, ok := ctx.Value(sessionKey).(*db.Session); ok {
+		return session
+	}
+	return nil
+}
+
+// HTTPAuthMiddleware checks user session or service account API key from authKey header,
+// two-factor authentication of session user, if guard requires it, and user permission to call the namespace method, otherwise it returns 403.
+func HTTPAuthMiddleware(commonRepo db.CommonRepo, guard *LoginGuard, ns, method string, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		errCode := http.StatusUnauthorized
 
@@ -76,13 +165,50 @@ func HTTPAuthMiddleware(commonRepo db.CommonRepo, next http.Handler) http.Handle
 			return
 		}
 
-		// return error if user not found
-		dbu, err := commonRepo.EnabledUserByAuthKey(r.Context(), authHeader)
-		if err != nil || dbu == nil {
-			http.Error(w, "user not found", errCode)
+		// return error if api key not found, usage tracking error doesn't block the request
+		if isAPIKey(authHeader) {
+			key, err := authenticateAPIKey(r.Context(), &commonRepo, authHeader)
+			if err != nil || key == nil {
+				http.Error(w, "api key not found", errCode)
+				return
+			}
+
+			_ = trackAPIKeyUsage(r.Context(), &commonRepo, key, zm.IPFromContext(r.Context()))
+			serveAllowed(w, r, &commonRepo, key.User, key, ns, method, next)
 			return
 		}
 
-		next.ServeHTTP(w, r)
+		// return error if session not found
+		session, err := authenticate(r.Context(), &commonRepo, authHeader)
+		if err != nil || session == nil {
+			http.Error(w, "session not found", errCode)
+			return
+		}
+
+		if err := checkTOTP(r.Context(), guard, session.User, ns, method); err != nil {
+			http.Error(w, err.Message, err.Code)
+			return
+		}
+
+		serveAllowed(w, r, &commonRepo, session.User, nil, ns, method, next)
 	})
 }
+
+// serveAllowed calls next handler, if user has a permission to call the namespace method and API key scopes allow it.
+func serveAllowed(w http.ResponseWriter, r *http.Request, commonRepo *db.CommonRepo, user *db.User, key *db.APIKey, ns, method string, next http.Handler) {
+	permissions, err := userPermissions(r.Context(), commonRepo, user)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	} else if !isAllowed(permissions, ns, method) || !isKeyAllowed(key, ns, method) {
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return
+	}
+
+	ctx := context.WithValue(r.Context(), userKey, user)
+	if key != nil {
+		ctx = context.WithValue(ctx, apiKeyKey, key)
+	}
+
+	next.ServeHTTP(w, r.WithContext(ctx))
+}
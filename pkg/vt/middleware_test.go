@@ -0,0 +1,44 @@
+package vt
+
+import (
+	"context"
+	"testing"
+
+	"apisrv/pkg/db"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDataChangedMiddleware_isDataChangeMethod(t *testing.T) {
+	Convey("Test isDataChangeMethod", t, func() {
+		So(isDataChangeMethod(NSNews, RPC.NewsService.Update), ShouldBeTrue)
+		So(isDataChangeMethod(NSCategory, RPC.CategoryService.DeleteMany), ShouldBeTrue)
+		So(isDataChangeMethod(NSTag, RPC.TagService.Merge), ShouldBeTrue)
+		So(isDataChangeMethod(NSTrash, RPC.TrashService.Restore), ShouldBeTrue)
+		So(isDataChangeMethod(NSNews, RPC.NewsService.Get), ShouldBeFalse)
+		// same method names of other namespaces don't change news
+		So(isDataChangeMethod(NSUser, RPC.UserService.Update), ShouldBeFalse)
+		So(isDataChangeMethod(NSWebhook, RPC.WebhookService.Delete), ShouldBeFalse)
+		So(isDataChangeMethod(NSNews, RPC.TagService.Merge), ShouldBeFalse)
+	})
+}
+
+func TestAuthMiddleware_checkTOTP(t *testing.T) {
+	Convey("Test checkTOTP", t, func() {
+		ctx, guard := context.Background(), &LoginGuard{cfg: LoginConfig{RequireTOTP: true}}
+
+		Convey("User without two-factor authentication could only enroll it", func() {
+			user := &db.User{}
+			So(checkTOTP(ctx, guard, user, NSNews, RPC.NewsService.Get), ShouldEqual, errTOTPRequired)
+			So(checkTOTP(ctx, guard, user, NSAuth, RPC.AuthService.ChangePassword), ShouldEqual, errTOTPRequired)
+			So(checkTOTP(ctx, guard, user, NSAuth, RPC.AuthService.Profile), ShouldBeNil)
+			So(checkTOTP(ctx, guard, user, NSAuth, RPC.AuthService.EnrollTOTP), ShouldBeNil)
+			So(checkTOTP(ctx, guard, user, NSAuth, RPC.AuthService.ConfirmTOTP), ShouldBeNil)
+			So(checkTOTP(ctx, guard, user, NSAuth, RPC.AuthService.Logout), ShouldBeNil)
+		})
+
+		Convey("User with two-factor authentication has access", func() {
+			So(checkTOTP(ctx, guard, &db.User{IsTOTPEnabled: true}, NSNews, RPC.NewsService.Get), ShouldBeNil)
+		})
+	})
+}
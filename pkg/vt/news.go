@@ -2,9 +2,14 @@ package vt
 
 import (
 	"context"
+	"errors"
+	"slices"
+	"strings"
 
 	"apisrv/pkg/db"
+	"apisrv/pkg/webhook"
 
+	"github.com/go-pg/pg/v10"
 	"github.com/vmkteam/embedlog"
 	"github.com/vmkteam/zenrpc/v2"
 )
@@ -12,13 +17,17 @@ import (
 type CategoryService struct {
 	zenrpc.Service
 	embedlog.Logger
+	db       db.DB
 	newsRepo db.NewsRepo
+	hooks    webhook.Emitter
 }
 
 func NewCategoryService(dbo db.DB, logger embedlog.Logger) *CategoryService {
 	return &CategoryService{
 		Logger:   logger,
+		db:       dbo,
 		newsRepo: db.NewNewsRepo(dbo),
+		hooks:    webhook.NewEmitter(dbo),
 	}
 }
 
@@ -104,11 +113,21 @@ func (s CategoryService) Add(ctx context.Context, category Category) (*Category,
 		return nil, ve.Error()
 	}
 
-	db, err := s.newsRepo.AddCategory(ctx, category.ToDB())
-	if err != nil {
+	if err := s.fillSlug(ctx, &category, nil); err != nil {
 		return nil, InternalError(err)
 	}
-	return NewCategory(db), nil
+
+	var dto *db.Category
+	err := s.db.RunInTransaction(ctx, func(tx *pg.Tx) (err error) {
+		if dto, err = s.newsRepo.WithTransaction(tx).AddCategory(ctx, category.ToDB()); err != nil {
+			return err
+		}
+		return s.hooks.WithTransaction(tx).Emit(ctx, webhook.EventCategoryCreated, webhook.NewCategory(dto))
+	})
+	if err != nil {
+		return nil, slugError(err, db.IndexCategorySlug)
+	}
+	return NewCategory(dto), nil
 }
 
 // Update updates the Category data identified by id from the query.
@@ -119,7 +138,8 @@ func (s CategoryService) Add(ctx context.Context, category Category) (*Category,
 //zenrpc:400 Validation Error
 //zenrpc:404 Not Found
 func (s CategoryService) Update(ctx context.Context, category Category) (bool, error) {
-	if _, err := s.byID(ctx, category.ID); err != nil {
+	current, err := s.byID(ctx, category.ID)
+	if err != nil {
 		return false, err
 	}
 
@@ -127,10 +147,23 @@ func (s CategoryService) Update(ctx context.Context, category Category) (bool, e
 		return false, ve.Error()
 	}
 
-	ok, err := s.newsRepo.UpdateCategory(ctx, category.ToDB())
-	if err != nil {
+	// unlike news, old category slugs are not kept: categories are not resolved by slug in API,
+	// site gets their slugs from the category list, so there is nothing to redirect.
+	if err := s.fillSlug(ctx, &category, current); err != nil {
 		return false, InternalError(err)
 	}
+
+	var ok bool
+	err = s.db.RunInTransaction(ctx, func(tx *pg.Tx) (err error) {
+		dto := category.ToDB()
+		if ok, err = s.newsRepo.WithTransaction(tx).UpdateCategory(ctx, dto); err != nil || !ok {
+			return err
+		}
+		return s.hooks.WithTransaction(tx).Emit(ctx, webhook.EventCategoryUpdated, webhook.NewCategory(dto))
+	})
+	if err != nil {
+		return false, slugError(err, db.IndexCategorySlug)
+	}
 	return ok, nil
 }
 
@@ -146,13 +179,108 @@ func (s CategoryService) Delete(ctx context.Context, id int) (bool, error) {
 		return false, err
 	}
 
-	ok, err := s.newsRepo.DeleteCategory(ctx, id)
+	var ok bool
+	err := s.db.RunInTransaction(ctx, func(tx *pg.Tx) (err error) {
+		if ok, err = s.newsRepo.WithTransaction(tx).DeleteCategory(ctx, id); err != nil || !ok {
+			return err
+		}
+		return s.hooks.WithTransaction(tx).Emit(ctx, webhook.EventCategoryDeleted, webhook.Object{ID: id})
+	})
 	if err != nil {
 		return false, InternalError(err)
 	}
 	return ok, err
 }
 
+// UpdateStatus sets status of the Categories by their IDs in a single transaction.
+//
+//zenrpc:statusUpdate StatusUpdate
+//zenrpc:return []BulkResult
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+func (s CategoryService) UpdateStatus(ctx context.Context, statusUpdate StatusUpdate) ([]BulkResult, error) {
+	if ve := isValidStatusUpdate(ctx, statusUpdate); ve.HasErrors() {
+		return nil, ve.Error()
+	}
+
+	list, err := s.byIDs(ctx, statusUpdate.ObjectIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]BulkResult, 0, len(list))
+	err = s.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		repo, hooks := s.newsRepo.WithTransaction(tx), s.hooks.WithTransaction(tx)
+		for i := range list {
+			dto := &list[i]
+			isChanged := dto.StatusID != statusUpdate.StatusID
+			results = append(results, BulkResult{ID: dto.ID, IsChanged: isChanged})
+			if !isChanged {
+				continue
+			}
+
+			dto.StatusID = statusUpdate.StatusID
+			if _, err := repo.UpdateCategory(ctx, dto, db.WithColumns(db.Columns.Category.StatusID)); err != nil {
+				return err
+			}
+
+			if err := hooks.Emit(ctx, webhook.EventCategoryUpdated, webhook.NewCategory(dto)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, InternalError(err)
+	}
+	return results, nil
+}
+
+// DeleteMany deletes the Categories by their IDs in a single transaction.
+//
+//zenrpc:ids []int
+//zenrpc:return []BulkResult
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+func (s CategoryService) DeleteMany(ctx context.Context, ids []int) ([]BulkResult, error) {
+	list, err := s.byIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]BulkResult, 0, len(list))
+	err = s.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		repo, hooks := s.newsRepo.WithTransaction(tx), s.hooks.WithTransaction(tx)
+		for i := range list {
+			id := list[i].ID
+			ok, err := repo.DeleteCategory(ctx, id)
+			if err != nil {
+				return err
+			}
+			results = append(results, BulkResult{ID: id, IsChanged: ok})
+			if !ok {
+				continue
+			}
+
+			if err = hooks.Emit(ctx, webhook.EventCategoryDeleted, webhook.Object{ID: id}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, InternalError(err)
+	}
+	return results, nil
+}
+
+// byIDs returns Categories in order of unique ids or validation error, if some of them are not found.
+func (s CategoryService) byIDs(ctx context.Context, ids []int) ([]db.Category, error) {
+	return bulkList(ids, func(ids []int) ([]db.Category, error) {
+		return s.newsRepo.CategoriesByFilters(ctx, &db.CategorySearch{IDs: ids}, db.PagerNoLimit)
+	}, func(dto *db.Category) int { return dto.ID })
+}
+
 // Validate verifies that Category data is valid.
 //
 //zenrpc:category Category
@@ -183,19 +311,57 @@ func (s CategoryService) isValid(ctx context.Context, category Category, isUpdat
 	}
 
 	// custom validation starts here
+	if category.Slug != "" {
+		used, err := s.newsRepo.IsCategorySlugUsed(ctx, category.Slug, category.ID)
+		if err != nil {
+			v.SetInternalError(err)
+		} else if used {
+			v.Append("slug", FieldErrorUnique)
+		}
+	}
+
 	return v
 }
 
+// fillSlug keeps current slug of updated category or generates unique slug from title if slug is empty.
+func (s CategoryService) fillSlug(ctx context.Context, category *Category, current *db.Category) (err error) {
+	switch {
+	case category.Slug != "":
+	case current != nil:
+		category.Slug = current.Slug
+	default:
+		category.Slug, err = s.newsRepo.UniqueCategorySlug(ctx, category.Title, category.ID)
+	}
+
+	return err
+}
+
+// slugError returns slug validation error if err is a violation of the unique slug index, otherwise internal error.
+// Slug is checked before save, so it happens only with concurrent saves of the same slug.
+func slugError(err error, index string) error {
+	if db.IsUniqueViolation(err, index) {
+		var v Validator
+		v.Append("slug", FieldErrorUnique)
+		return v.Error()
+	}
+
+	return InternalError(err)
+}
+
 type NewsService struct {
 	zenrpc.Service
 	embedlog.Logger
+	db       db.DB
 	newsRepo db.NewsRepo
+	hooks    webhook.Emitter
 }
 
 func NewNewsService(dbo db.DB, logger embedlog.Logger) *NewsService {
 	return &NewsService{
 		Logger:   logger,
+		db:       dbo,
 		newsRepo: db.NewNewsRepo(dbo),
+		hooks:    webhook.NewEmitter(dbo),
 	}
 }
 
@@ -213,6 +379,15 @@ func (s NewsService) dbSort(ops *ViewOps) db.OpFunc {
 	return v
 }
 
+// searchSort sorts full-text search results by rank unless sort column is set explicitly.
+func (s NewsService) searchSort(search *NewsSearch, ops *ViewOps) db.OpFunc {
+	if search == nil || search.Query == nil || strings.TrimSpace(*search.Query) == "" || (ops != nil && ops.SortColumn != "") {
+		return s.dbSort(ops)
+	}
+
+	return db.WithQueryRankSort(strings.TrimSpace(*search.Query))
+}
+
 // Count returns count News according to conditions in search params.
 //
 //zenrpc:search NewsSearch
@@ -233,7 +408,7 @@ func (s NewsService) Count(ctx context.Context, search *NewsSearch) (int, error)
 //zenrpc:return []NewsSummary
 //zenrpc:500 Internal Error
 func (s NewsService) Get(ctx context.Context, search *NewsSearch, viewOps *ViewOps) ([]NewsSummary, error) {
-	list, err := s.newsRepo.NewsByFilters(ctx, search.ToDB(), viewOps.Pager(), s.dbSort(viewOps), s.newsRepo.FullNews())
+	list, err := s.newsRepo.NewsByFilters(ctx, search.ToDB(), viewOps.Pager(), s.searchSort(search, viewOps), s.newsRepo.FullNews())
 	if err != nil {
 		return nil, InternalError(err)
 	}
@@ -281,11 +456,32 @@ func (s NewsService) Add(ctx context.Context, news News) (*News, error) {
 		return nil, ve.Error()
 	}
 
-	db, err := s.newsRepo.AddNews(ctx, news.ToDB())
-	if err != nil {
+	if err := s.fillSlug(ctx, &news, nil); err != nil {
 		return nil, InternalError(err)
 	}
-	return NewNews(db), nil
+
+	var dto *db.News
+	err := s.db.RunInTransaction(ctx, func(tx *pg.Tx) (err error) {
+		repo := s.newsRepo.WithTransaction(tx)
+		if dto, err = repo.AddNews(ctx, news.ToDB()); err != nil {
+			return err
+		}
+
+		if err = addNewsRevision(ctx, repo, dto, db.RevisionAdd); err != nil {
+			return err
+		}
+
+		hooks := s.hooks.WithTransaction(tx)
+		if err = hooks.Emit(ctx, webhook.EventNewsCreated, webhook.NewNews(dto)); err != nil {
+			return err
+		}
+
+		return hooks.EmitPublished(ctx, nil, dto)
+	})
+	if err != nil {
+		return nil, slugError(err, db.IndexNewsSlug)
+	}
+	return NewNews(dto), nil
 }
 
 // Update updates the News data identified by id from the query.
@@ -296,7 +492,8 @@ func (s NewsService) Add(ctx context.Context, news News) (*News, error) {
 //zenrpc:400 Validation Error
 //zenrpc:404 Not Found
 func (s NewsService) Update(ctx context.Context, news News) (bool, error) {
-	if _, err := s.byID(ctx, news.ID); err != nil {
+	current, err := s.byID(ctx, news.ID)
+	if err != nil {
 		return false, err
 	}
 
@@ -304,13 +501,61 @@ func (s NewsService) Update(ctx context.Context, news News) (bool, error) {
 		return false, ve.Error()
 	}
 
-	ok, err := s.newsRepo.UpdateNews(ctx, news.ToDB())
-	if err != nil {
+	if err = s.fillSlug(ctx, &news, current); err != nil {
 		return false, InternalError(err)
 	}
+
+	ok, err := s.update(ctx, current, news, db.RevisionUpdate)
+	if err != nil {
+		return false, slugError(err, db.IndexNewsSlug)
+	}
 	return ok, nil
 }
 
+// update saves news and its revision made by action.
+func (s NewsService) update(ctx context.Context, current *db.News, news News, action string) (ok bool, err error) {
+	err = s.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		repo, dto := s.newsRepo.WithTransaction(tx), news.ToDB()
+		if ok, err = repo.UpdateNews(ctx, dto); err != nil || !ok {
+			return err
+		}
+
+		// keep old slug resolvable
+		if current.Slug != news.Slug {
+			if err = repo.MoveNewsSlug(ctx, news.ID, current.Slug, news.Slug); err != nil {
+				return err
+			}
+		}
+
+		if err = addNewsRevisions(ctx, repo, current, dto, action); err != nil {
+			return err
+		}
+
+		hooks := s.hooks.WithTransaction(tx)
+		if err = hooks.Emit(ctx, webhook.EventNewsUpdated, webhook.NewNews(dto)); err != nil {
+			return err
+		}
+
+		return hooks.EmitPublished(ctx, current, dto)
+	})
+
+	return ok, err
+}
+
+// addNewsRevisions saves revision of the updated news made by action. The current news state is saved as initial revision if news has no revisions.
+func addNewsRevisions(ctx context.Context, repo db.NewsRepo, current, updated *db.News, action string) error {
+	count, err := repo.CountNewsRevisions(ctx, &db.NewsRevisionSearch{NewsID: &current.ID})
+	if err != nil {
+		return err
+	} else if count == 0 {
+		if err = addNewsRevision(ctx, repo, current, db.RevisionInitial); err != nil {
+			return err
+		}
+	}
+
+	return addNewsRevision(ctx, repo, updated, action)
+}
+
 // Delete deletes the News by its ID.
 //
 //zenrpc:id int
@@ -319,17 +564,249 @@ func (s NewsService) Update(ctx context.Context, news News) (bool, error) {
 //zenrpc:400 Validation Error
 //zenrpc:404 Not Found
 func (s NewsService) Delete(ctx context.Context, id int) (bool, error) {
-	if _, err := s.byID(ctx, id); err != nil {
+	current, err := s.byID(ctx, id)
+	if err != nil {
 		return false, err
 	}
 
-	ok, err := s.newsRepo.DeleteNews(ctx, id)
+	var ok bool
+	err = s.db.RunInTransaction(ctx, func(tx *pg.Tx) (err error) {
+		repo := s.newsRepo.WithTransaction(tx)
+		if ok, err = repo.DeleteNews(ctx, id); err != nil || !ok {
+			return err
+		}
+
+		current.StatusID = db.StatusDeleted
+		if err = addNewsRevision(ctx, repo, current, db.RevisionDelete); err != nil {
+			return err
+		}
+
+		return s.hooks.WithTransaction(tx).Emit(ctx, webhook.EventNewsDeleted, webhook.Object{ID: id})
+	})
 	if err != nil {
 		return false, InternalError(err)
 	}
 	return ok, err
 }
 
+// UpdateStatus sets status of the News by their IDs in a single transaction, a revision is saved for every changed News.
+//
+//zenrpc:statusUpdate StatusUpdate
+//zenrpc:return []BulkResult
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+func (s NewsService) UpdateStatus(ctx context.Context, statusUpdate StatusUpdate) ([]BulkResult, error) {
+	if ve := isValidStatusUpdate(ctx, statusUpdate); ve.HasErrors() {
+		return nil, ve.Error()
+	}
+
+	list, err := s.byIDs(ctx, statusUpdate.ObjectIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]BulkResult, 0, len(list))
+	err = s.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		repo, hooks := s.newsRepo.WithTransaction(tx), s.hooks.WithTransaction(tx)
+		for i := range list {
+			current := &list[i]
+			isChanged := current.StatusID != statusUpdate.StatusID
+			results = append(results, BulkResult{ID: current.ID, IsChanged: isChanged})
+			if !isChanged {
+				continue
+			}
+
+			dto := *current
+			dto.StatusID = statusUpdate.StatusID
+			if _, err := repo.UpdateNews(ctx, &dto, db.WithColumns(db.Columns.News.StatusID)); err != nil {
+				return err
+			}
+
+			if err := addNewsRevisions(ctx, repo, current, &dto, db.RevisionUpdate); err != nil {
+				return err
+			}
+
+			if err := hooks.Emit(ctx, webhook.EventNewsUpdated, webhook.NewNews(&dto)); err != nil {
+				return err
+			}
+
+			if err := hooks.EmitPublished(ctx, current, &dto); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, InternalError(err)
+	}
+	return results, nil
+}
+
+// DeleteMany deletes the News by their IDs in a single transaction.
+//
+//zenrpc:ids []int
+//zenrpc:return []BulkResult
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+func (s NewsService) DeleteMany(ctx context.Context, ids []int) ([]BulkResult, error) {
+	list, err := s.byIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]BulkResult, 0, len(list))
+	err = s.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		repo, hooks := s.newsRepo.WithTransaction(tx), s.hooks.WithTransaction(tx)
+		for i := range list {
+			current := &list[i]
+			ok, err := repo.DeleteNews(ctx, current.ID)
+			if err != nil {
+				return err
+			}
+			results = append(results, BulkResult{ID: current.ID, IsChanged: ok})
+			if !ok {
+				continue
+			}
+
+			current.StatusID = db.StatusDeleted
+			if err = addNewsRevision(ctx, repo, current, db.RevisionDelete); err != nil {
+				return err
+			}
+
+			if err = hooks.Emit(ctx, webhook.EventNewsDeleted, webhook.Object{ID: current.ID}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, InternalError(err)
+	}
+	return results, nil
+}
+
+// byIDs returns News in order of unique ids or validation error, if some of them are not found.
+func (s NewsService) byIDs(ctx context.Context, ids []int) ([]db.News, error) {
+	return bulkList(ids, func(ids []int) ([]db.News, error) {
+		return s.newsRepo.NewsByFilters(ctx, &db.NewsSearch{IDs: ids}, db.PagerNoLimit, s.newsRepo.FullNews())
+	}, func(dto *db.News) int { return dto.ID })
+}
+
+// Revisions returns a list of the News revisions, latest first.
+//
+//zenrpc:id int
+//zenrpc:return []NewsRevisionSummary
+//zenrpc:500 Internal Error
+func (s NewsService) Revisions(ctx context.Context, id int) ([]NewsRevisionSummary, error) {
+	list, err := s.newsRepo.NewsRevisionsByFilters(ctx, &db.NewsRevisionSearch{NewsID: &id}, db.PagerNoLimit,
+		db.WithColumns(db.TableColumns, db.Columns.NewsRevision.User), s.newsRepo.DefaultNewsRevisionSort())
+	if err != nil {
+		return nil, InternalError(err)
+	}
+	revisions := make([]NewsRevisionSummary, 0, len(list))
+	for i := range list {
+		revisions = append(revisions, *NewNewsRevisionSummary(&list[i]))
+	}
+	return revisions, nil
+}
+
+// Revision returns a News revision by its ID.
+//
+//zenrpc:revId int
+//zenrpc:return NewsRevision
+//zenrpc:500 Internal Error
+//zenrpc:404 Not Found
+func (s NewsService) Revision(ctx context.Context, revId int) (*NewsRevision, error) {
+	rev, err := s.revisionByID(ctx, revId)
+	if err != nil {
+		return nil, err
+	}
+	return NewNewsRevision(rev), nil
+}
+
+// RevisionDiff returns two revisions of the same News with a list of fields changed from fromRevId to toRevId.
+//
+//zenrpc:fromRevId int
+//zenrpc:toRevId int
+//zenrpc:return NewsRevisionDiff
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+//zenrpc:404 Not Found
+func (s NewsService) RevisionDiff(ctx context.Context, fromRevId, toRevId int) (*NewsRevisionDiff, error) {
+	from, err := s.revisionByID(ctx, fromRevId)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := s.revisionByID(ctx, toRevId)
+	if err != nil {
+		return nil, err
+	}
+
+	if from.NewsID != to.NewsID {
+		var v Validator
+		v.Append("toRevId", FieldErrorIncorrect)
+		return nil, v.Error()
+	}
+
+	return NewNewsRevisionDiff(from, to), nil
+}
+
+// RestoreRevision restores the News data from its revision. Deleted news and revisions of deleted state can't be restored.
+//
+//zenrpc:revId int
+//zenrpc:return isRestored
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+//zenrpc:404 Not Found
+func (s NewsService) RestoreRevision(ctx context.Context, revId int) (bool, error) {
+	rev, err := s.revisionByID(ctx, revId)
+	if err != nil {
+		return false, err
+	}
+
+	current, err := s.byID(ctx, rev.NewsID)
+	if err != nil {
+		return false, err
+	}
+
+	news := NewNewsRevision(rev).ToNews()
+	ve := s.isValid(ctx, news, true)
+	if rev.NewsStatusID == db.StatusDeleted {
+		ve.Append("revId", FieldErrorIncorrect)
+	}
+	if ve.HasErrors() {
+		return false, ve.Error()
+	}
+
+	ok, err := s.update(ctx, current, news, db.RevisionRestore)
+	if err != nil {
+		return false, slugError(err, db.IndexNewsSlug)
+	}
+	return ok, nil
+}
+
+func (s NewsService) revisionByID(ctx context.Context, id int) (*db.NewsRevision, error) {
+	rev, err := s.newsRepo.NewsRevisionByID(ctx, id, db.WithColumns(db.TableColumns, db.Columns.NewsRevision.User))
+	if err != nil {
+		return nil, InternalError(err)
+	} else if rev == nil {
+		return nil, ErrNotFound
+	}
+	return rev, nil
+}
+
+// addNewsRevision saves the news snapshot made by action of the current user.
+func addNewsRevision(ctx context.Context, repo db.NewsRepo, news *db.News, action string) error {
+	var userID *int
+	if user := UserFromContext(ctx); user != nil {
+		userID = &user.ID
+	}
+
+	_, err := repo.AddNewsRevision(ctx, db.NewNewsRevision(news, action, userID))
+	return err
+}
+
 // Validate verifies that News data is valid.
 //
 //zenrpc:news News
@@ -378,19 +855,48 @@ func (s NewsService) isValid(ctx context.Context, news News, isUpdate bool) Vali
 		}
 	}
 	// custom validation starts here
+	if news.Slug != "" {
+		used, err := s.newsRepo.IsNewsSlugUsed(ctx, news.Slug, news.ID)
+		if err != nil {
+			v.SetInternalError(err)
+		} else if used {
+			v.Append("slug", FieldErrorUnique)
+		}
+	}
+
 	return v
 }
 
+// fillSlug keeps current slug of updated news or generates unique slug from title if slug is empty.
+func (s NewsService) fillSlug(ctx context.Context, news *News, current *db.News) (err error) {
+	switch {
+	case news.Slug != "":
+	case current != nil:
+		news.Slug = current.Slug
+	default:
+		news.Slug, err = s.newsRepo.UniqueNewsSlug(ctx, news.Title, news.ID)
+	}
+
+	return err
+}
+
+// tagsLock is a lock name of news tagIds rewrites.
+const tagsLock = "vt.tags"
+
 type TagService struct {
 	zenrpc.Service
 	embedlog.Logger
+	db       db.DB
 	newsRepo db.NewsRepo
+	hooks    webhook.Emitter
 }
 
 func NewTagService(dbo db.DB, logger embedlog.Logger) *TagService {
 	return &TagService{
 		Logger:   logger,
+		db:       dbo,
 		newsRepo: db.NewNewsRepo(dbo),
+		hooks:    webhook.NewEmitter(dbo),
 	}
 }
 
@@ -476,11 +982,17 @@ func (s TagService) Add(ctx context.Context, tag Tag) (*Tag, error) {
 		return nil, ve.Error()
 	}
 
-	db, err := s.newsRepo.AddTag(ctx, tag.ToDB())
+	var dto *db.Tag
+	err := s.db.RunInTransaction(ctx, func(tx *pg.Tx) (err error) {
+		if dto, err = s.newsRepo.WithTransaction(tx).AddTag(ctx, tag.ToDB()); err != nil {
+			return err
+		}
+		return s.hooks.WithTransaction(tx).Emit(ctx, webhook.EventTagCreated, webhook.NewTag(dto))
+	})
 	if err != nil {
 		return nil, InternalError(err)
 	}
-	return NewTag(db), nil
+	return NewTag(dto), nil
 }
 
 // Update updates the Tag data identified by id from the query.
@@ -499,7 +1011,14 @@ func (s TagService) Update(ctx context.Context, tag Tag) (bool, error) {
 		return false, ve.Error()
 	}
 
-	ok, err := s.newsRepo.UpdateTag(ctx, tag.ToDB())
+	var ok bool
+	err := s.db.RunInTransaction(ctx, func(tx *pg.Tx) (err error) {
+		dto := tag.ToDB()
+		if ok, err = s.newsRepo.WithTransaction(tx).UpdateTag(ctx, dto); err != nil || !ok {
+			return err
+		}
+		return s.hooks.WithTransaction(tx).Emit(ctx, webhook.EventTagUpdated, webhook.NewTag(dto))
+	})
 	if err != nil {
 		return false, InternalError(err)
 	}
@@ -518,13 +1037,269 @@ func (s TagService) Delete(ctx context.Context, id int) (bool, error) {
 		return false, err
 	}
 
-	ok, err := s.newsRepo.DeleteTag(ctx, id)
+	var ok bool
+	err := s.db.RunInTransaction(ctx, func(tx *pg.Tx) (err error) {
+		if ok, err = s.newsRepo.WithTransaction(tx).DeleteTag(ctx, id); err != nil || !ok {
+			return err
+		}
+		return s.hooks.WithTransaction(tx).Emit(ctx, webhook.EventTagDeleted, webhook.Object{ID: id})
+	})
 	if err != nil {
 		return false, InternalError(err)
 	}
 	return ok, err
 }
 
+// UpdateStatus sets status of the Tags by their IDs in a single transaction.
+//
+//zenrpc:statusUpdate StatusUpdate
+//zenrpc:return []BulkResult
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+func (s TagService) UpdateStatus(ctx context.Context, statusUpdate StatusUpdate) ([]BulkResult, error) {
+	if ve := isValidStatusUpdate(ctx, statusUpdate); ve.HasErrors() {
+		return nil, ve.Error()
+	}
+
+	list, err := s.byIDs(ctx, statusUpdate.ObjectIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]BulkResult, 0, len(list))
+	err = s.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		repo, hooks := s.newsRepo.WithTransaction(tx), s.hooks.WithTransaction(tx)
+		for i := range list {
+			dto := &list[i]
+			isChanged := dto.StatusID != statusUpdate.StatusID
+			results = append(results, BulkResult{ID: dto.ID, IsChanged: isChanged})
+			if !isChanged {
+				continue
+			}
+
+			dto.StatusID = statusUpdate.StatusID
+			if _, err := repo.UpdateTag(ctx, dto, db.WithColumns(db.Columns.Tag.StatusID)); err != nil {
+				return err
+			}
+
+			if err := hooks.Emit(ctx, webhook.EventTagUpdated, webhook.NewTag(dto)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, InternalError(err)
+	}
+	return results, nil
+}
+
+// DeleteMany deletes the Tags by their IDs in a single transaction.
+//
+//zenrpc:ids []int
+//zenrpc:return []BulkResult
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+func (s TagService) DeleteMany(ctx context.Context, ids []int) ([]BulkResult, error) {
+	list, err := s.byIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]BulkResult, 0, len(list))
+	err = s.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		repo, hooks := s.newsRepo.WithTransaction(tx), s.hooks.WithTransaction(tx)
+		for i := range list {
+			id := list[i].ID
+			ok, err := repo.DeleteTag(ctx, id)
+			if err != nil {
+				return err
+			}
+			results = append(results, BulkResult{ID: id, IsChanged: ok})
+			if !ok {
+				continue
+			}
+
+			if err = hooks.Emit(ctx, webhook.EventTagDeleted, webhook.Object{ID: id}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, InternalError(err)
+	}
+	return results, nil
+}
+
+// byIDs returns Tags in order of unique ids or validation error, if some of them are not found.
+func (s TagService) byIDs(ctx context.Context, ids []int) ([]db.Tag, error) {
+	return bulkList(ids, func(ids []int) ([]db.Tag, error) {
+		return s.newsRepo.TagsByFilters(ctx, &db.TagSearch{IDs: ids}, db.PagerNoLimit)
+	}, func(dto *db.Tag) int { return dto.ID })
+}
+
+// Merge replaces source tags with the target tag in tagIds of all news and deletes source tags.
+// Revisions are saved and events are emitted for changed news. It returns the number of changed news.
+//
+//zenrpc:sourceIds []int
+//zenrpc:targetId int
+//zenrpc:return number of changed news
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+func (s TagService) Merge(ctx context.Context, sourceIds []int, targetId int) (int, error) {
+	sourceIDs := slices.Compact(slices.Sorted(slices.Values(sourceIds)))
+
+	// tags are checked under tags lock, so they couldn't be merged or deleted concurrently
+	var ve Validator
+	count, err := s.rewriteNewsTags(ctx, sourceIDs, func(repo db.NewsRepo, hooks webhook.Emitter) ([]int, error) {
+		if ve = s.isValidMerge(ctx, repo, sourceIDs, targetId); ve.HasErrors() {
+			return nil, nil
+		}
+
+		ids, err := repo.ReplaceNewsTags(ctx, sourceIDs, targetId)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range sourceIDs {
+			ok, err := repo.DeleteTag(ctx, id)
+			if err != nil {
+				return nil, err
+			} else if !ok {
+				continue
+			}
+
+			if err = hooks.Emit(ctx, webhook.EventTagDeleted, webhook.Object{ID: id}); err != nil {
+				return nil, err
+			}
+		}
+
+		return ids, nil
+	})
+	if err != nil {
+		return 0, InternalError(err)
+	} else if ve.HasErrors() {
+		return 0, ve.Error()
+	}
+	return count, nil
+}
+
+// isValidMerge checks that unique source ids are not empty, don't contain target id and all tags exist.
+func (s TagService) isValidMerge(ctx context.Context, repo db.NewsRepo, sourceIDs []int, targetID int) Validator {
+	var v Validator
+
+	if len(sourceIDs) == 0 {
+		v.Append("sourceIds", FieldErrorRequired)
+	} else if slices.Contains(sourceIDs, targetID) {
+		v.Append("sourceIds", FieldErrorIncorrect)
+	} else if count, err := repo.CountTags(ctx, &db.TagSearch{IDs: sourceIDs}); err != nil {
+		v.SetInternalError(err)
+	} else if count != len(sourceIDs) {
+		v.Append("sourceIds", FieldErrorIncorrect)
+	}
+
+	if target, err := repo.TagByID(ctx, targetID); err != nil {
+		v.SetInternalError(err)
+	} else if target == nil {
+		v.Append("targetId", FieldErrorIncorrect)
+	}
+
+	return v
+}
+
+// GetOrphans returns missing tags, which are used in news tagIds. Deleted tags in trash are not orphans.
+//
+//zenrpc:return []OrphanTag
+//zenrpc:500 Internal Error
+func (s TagService) GetOrphans(ctx context.Context) ([]OrphanTag, error) {
+	list, err := s.newsRepo.OrphanTags(ctx)
+	if err != nil {
+		return nil, InternalError(err)
+	}
+	return NewOrphanTags(list), nil
+}
+
+// FixOrphans removes missing tags from news tagIds.
+// Revisions are saved and events are emitted for changed news. It returns removed tags.
+//
+//zenrpc:return []OrphanTag
+//zenrpc:500 Internal Error
+func (s TagService) FixOrphans(ctx context.Context) ([]OrphanTag, error) {
+	var list []db.OrphanTag
+	err := s.db.RunInLock(ctx, tagsLock, func(tx *pg.Tx) (err error) {
+		list, err = s.newsRepo.WithTransaction(tx).OrphanTags(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, InternalError(err)
+	} else if len(list) == 0 {
+		return []OrphanTag{}, nil
+	}
+
+	tagIDs := make([]int, 0, len(list))
+	for _, t := range list {
+		tagIDs = append(tagIDs, t.ID)
+	}
+
+	_, err = s.rewriteNewsTags(ctx, tagIDs, func(repo db.NewsRepo, _ webhook.Emitter) ([]int, error) {
+		return repo.RemoveNewsTags(ctx, tagIDs)
+	})
+	if err != nil {
+		return nil, InternalError(err)
+	}
+	return NewOrphanTags(list), nil
+}
+
+// rewriteNewsTags calls fn, which rewrites tagIds of news with the tags, in a transaction with tags lock.
+// Revisions are saved and news.updated events are emitted for changed not deleted news. It returns the number of changed news.
+// News saved concurrently with previous tags could leave orphan tags, they are fixed by FixOrphans.
+func (s TagService) rewriteNewsTags(ctx context.Context, tagIDs []int, fn func(repo db.NewsRepo, hooks webhook.Emitter) ([]int, error)) (int, error) {
+	var count int
+	err := s.db.RunInLock(ctx, tagsLock, func(tx *pg.Tx) error {
+		repo, hooks := s.newsRepo.WithTransaction(tx), s.hooks.WithTransaction(tx)
+
+		list, err := repo.NewsByFilters(ctx, &db.NewsSearch{TagIDsAny: tagIDs}, db.PagerNoLimit)
+		if err != nil {
+			return err
+		}
+
+		ids, err := fn(repo, hooks)
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+		count = len(ids)
+
+		updated, err := repo.NewsByFilters(ctx, &db.NewsSearch{IDs: ids}, db.PagerNoLimit)
+		if err != nil {
+			return err
+		}
+
+		byID := make(map[int]*db.News, len(updated))
+		for i := range updated {
+			byID[updated[i].ID] = &updated[i]
+		}
+
+		for i := range list {
+			dto, ok := byID[list[i].ID]
+			if !ok {
+				continue
+			}
+
+			if err = addNewsRevisions(ctx, repo, &list[i], dto, db.RevisionUpdate); err != nil {
+				return err
+			}
+
+			if err = hooks.Emit(ctx, webhook.EventNewsUpdated, webhook.NewNews(dto)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	return count, err
+}
+
 // Validate verifies that Tag data is valid.
 //
 //zenrpc:tag Tag
@@ -557,3 +1332,281 @@ func (s TagService) isValid(ctx context.Context, tag Tag, isUpdate bool) Validat
 	// custom validation starts here
 	return v
 }
+
+type ModerationService struct {
+	zenrpc.Service
+	embedlog.Logger
+	db       db.DB
+	newsRepo db.NewsRepo
+	hooks    webhook.Emitter
+}
+
+func NewModerationService(dbo db.DB, logger embedlog.Logger) *ModerationService {
+	return &ModerationService{
+		Logger:   logger,
+		db:       dbo,
+		newsRepo: db.NewNewsRepo(dbo),
+		hooks:    webhook.NewEmitter(dbo),
+	}
+}
+
+func (s ModerationService) dbSort(ops *ViewOps) db.OpFunc {
+	v := s.newsRepo.DefaultSuggestionSort()
+	if ops == nil {
+		return v
+	}
+
+	switch ops.SortColumn {
+	case db.Columns.Suggestion.ID, db.Columns.Suggestion.Title, db.Columns.Suggestion.CategoryID, db.Columns.Suggestion.IP, db.Columns.Suggestion.CreatedAt, db.Columns.Suggestion.ModerationStatusID:
+		v = db.WithSort(db.NewSortField(ops.SortColumn, ops.SortDesc))
+	}
+
+	return v
+}
+
+// Count returns count Suggestions according to conditions in search params.
+//
+//zenrpc:search SuggestionSearch
+//zenrpc:return int
+//zenrpc:500 Internal Error
+func (s ModerationService) Count(ctx context.Context, search *SuggestionSearch) (int, error) {
+	count, err := s.newsRepo.CountSuggestions(ctx, search.ToDB())
+	if err != nil {
+		return 0, InternalError(err)
+	}
+	return count, nil
+}
+
+// Get returns а list of Suggestions according to conditions in search params. Pending suggestions have moderationStatusId=1.
+//
+//zenrpc:search SuggestionSearch
+//zenrpc:viewOps ViewOps
+//zenrpc:return []SuggestionSummary
+//zenrpc:500 Internal Error
+func (s ModerationService) Get(ctx context.Context, search *SuggestionSearch, viewOps *ViewOps) ([]SuggestionSummary, error) {
+	list, err := s.newsRepo.SuggestionsByFilters(ctx, search.ToDB(), viewOps.Pager(), s.dbSort(viewOps), s.newsRepo.FullSuggestion())
+	if err != nil {
+		return nil, InternalError(err)
+	}
+	suggestions := make([]SuggestionSummary, 0, len(list))
+	for i := 0; i < len(list); i++ {
+		if suggestion := NewSuggestionSummary(&list[i]); suggestion != nil {
+			suggestions = append(suggestions, *suggestion)
+		}
+	}
+	return suggestions, nil
+}
+
+// GetByID returns a Suggestion by its ID with its moderation decisions, latest first.
+//
+//zenrpc:id int
+//zenrpc:return Suggestion
+//zenrpc:500 Internal Error
+//zenrpc:404 Not Found
+func (s ModerationService) GetByID(ctx context.Context, id int) (*Suggestion, error) {
+	dto, err := s.byID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := s.newsRepo.SuggestionDecisionsByFilters(ctx, &db.SuggestionDecisionSearch{SuggestionID: &id}, db.PagerNoLimit,
+		s.newsRepo.DefaultSuggestionDecisionSort(), db.WithColumns(db.TableColumns, db.Columns.SuggestionDecision.User))
+	if err != nil {
+		return nil, InternalError(err)
+	}
+
+	suggestion := NewSuggestion(dto)
+	suggestion.Decisions = make([]SuggestionDecision, 0, len(list))
+	for i := range list {
+		suggestion.Decisions = append(suggestion.Decisions, *NewSuggestionDecision(&list[i]))
+	}
+
+	return suggestion, nil
+}
+
+func (s ModerationService) byID(ctx context.Context, id int) (*db.Suggestion, error) {
+	db, err := s.newsRepo.SuggestionByID(ctx, id, s.newsRepo.FullSuggestion())
+	if err != nil {
+		return nil, InternalError(err)
+	} else if db == nil {
+		return nil, ErrNotFound
+	}
+	return db, nil
+}
+
+// Approve publishes the pending Suggestion as a News with chosen category, optional tags and publishedAt.
+//
+//zenrpc:approval SuggestionApproval
+//zenrpc:return News
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+//zenrpc:404 Not Found
+func (s ModerationService) Approve(ctx context.Context, approval SuggestionApproval) (*News, error) {
+	suggestion, err := s.byID(ctx, approval.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	approval.TagIDs = uniqueIDs(approval.TagIDs)
+	if ve := s.isApprovalValid(ctx, approval, suggestion); ve.HasErrors() {
+		return nil, ve.Error()
+	}
+
+	var news *db.News
+	err = s.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		repo := s.newsRepo.WithTransaction(tx)
+		slug, err := repo.UniqueNewsSlug(ctx, suggestion.Title, 0)
+		if err != nil {
+			return err
+		}
+
+		news, err = repo.AddNews(ctx, &db.News{
+			Title:       suggestion.Title,
+			Slug:        slug,
+			ShortText:   suggestion.ShortText,
+			Content:     &suggestion.Text,
+			CategoryID:  approval.CategoryID,
+			TagIDs:      approval.TagIDs,
+			PublishedAt: approval.PublishedAt,
+			StatusID:    db.StatusEnabled,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err = addNewsRevision(ctx, repo, news, db.RevisionAdd); err != nil {
+			return err
+		}
+
+		hooks := s.hooks.WithTransaction(tx)
+		if err = hooks.Emit(ctx, webhook.EventNewsCreated, webhook.NewNews(news)); err != nil {
+			return err
+		}
+
+		if err = hooks.EmitPublished(ctx, nil, news); err != nil {
+			return err
+		}
+
+		return s.decide(ctx, repo, suggestion.ID, db.ModerationApproved, nil, &news.ID, db.ModerationPending)
+	})
+	if err != nil {
+		return nil, s.decisionError(err)
+	}
+	return NewNews(news), nil
+}
+
+// Reject rejects the pending Suggestion with a reason.
+//
+//zenrpc:rejection SuggestionRejection
+//zenrpc:return isRejected
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+//zenrpc:404 Not Found
+func (s ModerationService) Reject(ctx context.Context, rejection SuggestionRejection) (bool, error) {
+	suggestion, err := s.byID(ctx, rejection.ID)
+	if err != nil {
+		return false, err
+	}
+
+	var v Validator
+	if v.CheckBasic(ctx, rejection); v.HasErrors() {
+		return false, v.Error()
+	}
+	if suggestion.ModerationStatusID != db.ModerationPending {
+		v.Append("id", FieldErrorIncorrect)
+		return false, v.Error()
+	}
+
+	err = s.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		return s.decide(ctx, s.newsRepo.WithTransaction(tx), suggestion.ID, db.ModerationRejected, &rejection.Reason, nil, db.ModerationPending)
+	})
+	if err != nil {
+		return false, s.decisionError(err)
+	}
+	return true, nil
+}
+
+// errSuggestionDecided is returned by decide if suggestion was moderated concurrently.
+var errSuggestionDecided = errors.New("suggestion is already decided")
+
+// decide sets suggestion moderation status and saves the decision of the current user to history.
+func (s ModerationService) decide(ctx context.Context, repo db.NewsRepo, id, statusID int, reason *string, newsID *int, fromStatusIDs ...int) error {
+	user := UserFromContext(ctx)
+	if user == nil {
+		return ErrUnauthorized
+	}
+
+	ok, err := repo.SetSuggestionModerationStatus(ctx, id, statusID, newsID, fromStatusIDs...)
+	if err != nil {
+		return err
+	} else if !ok {
+		return errSuggestionDecided
+	}
+
+	_, err = repo.AddSuggestionDecision(ctx, &db.SuggestionDecision{
+		SuggestionID:       id,
+		UserID:             user.ID,
+		ModerationStatusID: statusID,
+		Reason:             reason,
+		NewsID:             newsID,
+	})
+
+	return err
+}
+
+func (s ModerationService) decisionError(err error) error {
+	var v Validator
+	switch {
+	case errors.Is(err, errSuggestionDecided):
+		v.Append("id", FieldErrorIncorrect)
+		return v.Error()
+	case errors.Is(err, ErrUnauthorized):
+		return ErrUnauthorized
+	}
+	return InternalError(err)
+}
+
+// uniqueIDs returns ids without duplicates in the original order, result is never nil.
+func uniqueIDs(ids []int) []int {
+	res := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if !slices.Contains(res, id) {
+			res = append(res, id)
+		}
+	}
+
+	return res
+}
+
+func (s ModerationService) isApprovalValid(ctx context.Context, approval SuggestionApproval, suggestion *db.Suggestion) Validator {
+	var v Validator
+
+	if v.CheckBasic(ctx, approval); v.HasInternalError() {
+		return v
+	}
+
+	if suggestion.ModerationStatusID != db.ModerationPending {
+		v.Append("id", FieldErrorIncorrect)
+	}
+
+	// check fks
+	if approval.CategoryID != 0 {
+		item, err := s.newsRepo.CategoryByID(ctx, approval.CategoryID)
+		if err != nil {
+			v.SetInternalError(err)
+		} else if item == nil {
+			v.Append("categoryId", FieldErrorIncorrect)
+		}
+	}
+
+	if len(approval.TagIDs) != 0 {
+		items, err := s.newsRepo.TagsByFilters(ctx, &db.TagSearch{IDs: approval.TagIDs}, db.PagerNoLimit)
+		if err != nil {
+			v.SetInternalError(err)
+		} else if len(items) != len(approval.TagIDs) {
+			v.Append("tagIds", FieldErrorIncorrect)
+		}
+	}
+
+	return v
+}
@@ -1,6 +1,8 @@
 package vt
 
 import (
+	"slices"
+
 	"apisrv/pkg/db"
 )
 
@@ -12,6 +14,7 @@ func NewCategory(in *db.Category) *Category {
 	category := &Category{
 		ID:       in.ID,
 		Title:    in.Title,
+		Slug:     in.Slug,
 		Sort:     in.Sort,
 		StatusID: in.StatusID,
 
@@ -29,6 +32,7 @@ func NewCategorySummary(in *db.Category) *CategorySummary {
 	return &CategorySummary{
 		ID:    in.ID,
 		Title: in.Title,
+		Slug:  in.Slug,
 		Sort:  in.Sort,
 
 		Status: NewStatus(in.StatusID),
@@ -43,6 +47,7 @@ func NewNews(in *db.News) *News {
 	news := &News{
 		ID:          in.ID,
 		Title:       in.Title,
+		Slug:        in.Slug,
 		ShortText:   in.ShortText,
 		Content:     in.Content,
 		Author:      in.Author,
@@ -67,6 +72,7 @@ func NewNewsSummary(in *db.News) *NewsSummary {
 	return &NewsSummary{
 		ID:          in.ID,
 		Title:       in.Title,
+		Slug:        in.Slug,
 		ShortText:   in.ShortText,
 		Content:     in.Content,
 		Author:      in.Author,
@@ -79,6 +85,82 @@ func NewNewsSummary(in *db.News) *NewsSummary {
 	}
 }
 
+func NewNewsRevision(in *db.NewsRevision) *NewsRevision {
+	if in == nil {
+		return nil
+	}
+
+	return &NewsRevision{
+		ID:           in.ID,
+		NewsID:       in.NewsID,
+		UserID:       in.UserID,
+		Action:       in.Action,
+		Title:        in.Title,
+		Slug:         in.Slug,
+		ShortText:    in.ShortText,
+		Content:      in.Content,
+		Author:       in.Author,
+		CategoryID:   in.CategoryID,
+		TagIDs:       in.TagIDs,
+		PublishedAt:  in.PublishedAt,
+		NewsStatusID: in.NewsStatusID,
+		CreatedAt:    in.CreatedAt,
+
+		User:       NewUserSummary(in.User),
+		NewsStatus: NewStatus(in.NewsStatusID),
+	}
+}
+
+func NewNewsRevisionSummary(in *db.NewsRevision) *NewsRevisionSummary {
+	if in == nil {
+		return nil
+	}
+
+	return &NewsRevisionSummary{
+		ID:        in.ID,
+		NewsID:    in.NewsID,
+		Action:    in.Action,
+		Title:     in.Title,
+		CreatedAt: in.CreatedAt,
+
+		User: NewUserSummary(in.User),
+	}
+}
+
+// NewNewsRevisionDiff returns revisions with a list of fields changed from one to another.
+func NewNewsRevisionDiff(from, to *db.NewsRevision) *NewsRevisionDiff {
+	fields := []string{}
+	add := func(field string, changed bool) {
+		if changed {
+			fields = append(fields, field)
+		}
+	}
+
+	add("title", from.Title != to.Title)
+	add("slug", from.Slug != to.Slug)
+	add("shortText", from.ShortText != to.ShortText)
+	add("content", !equalPtr(from.Content, to.Content))
+	add("author", !equalPtr(from.Author, to.Author))
+	add("categoryId", from.CategoryID != to.CategoryID)
+	add("tagIds", !slices.Equal(from.TagIDs, to.TagIDs))
+	add("publishedAt", !from.PublishedAt.Equal(to.PublishedAt))
+	add("newsStatusId", from.NewsStatusID != to.NewsStatusID)
+
+	return &NewsRevisionDiff{
+		From:   NewNewsRevision(from),
+		To:     NewNewsRevision(to),
+		Fields: fields,
+	}
+}
+
+// equalPtr checks that both pointers are nil or point to equal values.
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
 func NewTag(in *db.Tag) *Tag {
 	if in == nil {
 		return nil
@@ -107,3 +189,75 @@ func NewTagSummary(in *db.Tag) *TagSummary {
 		Status: NewStatus(in.StatusID),
 	}
 }
+
+func NewOrphanTags(in []db.OrphanTag) []OrphanTag {
+	res := make([]OrphanTag, 0, len(in))
+	for _, t := range in {
+		res = append(res, OrphanTag{ID: t.ID, NewsCount: t.NewsCount})
+	}
+	return res
+}
+
+func NewSuggestion(in *db.Suggestion) *Suggestion {
+	if in == nil {
+		return nil
+	}
+
+	suggestion := &Suggestion{
+		ID:                 in.ID,
+		Title:              in.Title,
+		ShortText:          in.ShortText,
+		Text:               in.Text,
+		CategoryID:         in.CategoryID,
+		Tags:               in.Tags,
+		IP:                 in.IP,
+		Contact:            in.Contact,
+		NewsID:             in.NewsID,
+		CreatedAt:          in.CreatedAt,
+		ModerationStatusID: in.ModerationStatusID,
+
+		Category:         NewCategorySummary(in.Category),
+		ModerationStatus: NewModerationStatus(in.ModerationStatusID),
+	}
+
+	return suggestion
+}
+
+func NewSuggestionSummary(in *db.Suggestion) *SuggestionSummary {
+	if in == nil {
+		return nil
+	}
+
+	return &SuggestionSummary{
+		ID:         in.ID,
+		Title:      in.Title,
+		ShortText:  in.ShortText,
+		CategoryID: in.CategoryID,
+		Tags:       in.Tags,
+		IP:         in.IP,
+		Contact:    in.Contact,
+		NewsID:     in.NewsID,
+		CreatedAt:  in.CreatedAt,
+
+		Category:         NewCategorySummary(in.Category),
+		ModerationStatus: NewModerationStatus(in.ModerationStatusID),
+	}
+}
+
+func NewSuggestionDecision(in *db.SuggestionDecision) *SuggestionDecision {
+	if in == nil {
+		return nil
+	}
+
+	return &SuggestionDecision{
+		ID:                 in.ID,
+		UserID:             in.UserID,
+		ModerationStatusID: in.ModerationStatusID,
+		Reason:             in.Reason,
+		NewsID:             in.NewsID,
+		CreatedAt:          in.CreatedAt,
+
+		User:             NewUserSummary(in.User),
+		ModerationStatus: NewModerationStatus(in.ModerationStatusID),
+	}
+}
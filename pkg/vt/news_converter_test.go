@@ -0,0 +1,32 @@
+package vt
+
+import (
+	"testing"
+	"time"
+
+	"apisrv/pkg/db"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestNewNewsRevisionDiff(t *testing.T) {
+	Convey("Test NewNewsRevisionDiff", t, func() {
+		now := time.Now()
+		from := &db.NewsRevision{ID: 1, NewsID: 1, Title: "Title", Slug: "title", TagIDs: []int{1, 2}, PublishedAt: now, NewsStatusID: db.StatusEnabled}
+
+		to := *from
+		to.ID = 2
+		So(NewNewsRevisionDiff(from, &to).Fields, ShouldBeEmpty)
+
+		content := "Content"
+		to.Title = "New title"
+		to.Content = &content
+		to.TagIDs = []int{2, 1}
+		to.PublishedAt = now.Add(time.Hour)
+
+		diff := NewNewsRevisionDiff(from, &to)
+		So(diff.Fields, ShouldResemble, []string{"title", "content", "tagIds", "publishedAt"})
+		So(diff.From.ID, ShouldEqual, 1)
+		So(diff.To.ID, ShouldEqual, 2)
+	})
+}
@@ -2,6 +2,7 @@
 package vt
 
 import (
+	"strings"
 	"time"
 
 	"apisrv/pkg/db"
@@ -10,6 +11,7 @@ import (
 type Category struct {
 	ID       int    `json:"id"`
 	Title    string `json:"title" validate:"required,max=255"`
+	Slug     string `json:"slug" validate:"omitempty,max=255,alias"`
 	Sort     *int   `json:"sort"`
 	StatusID int    `json:"statusId" validate:"required,status"`
 
@@ -24,6 +26,7 @@ func (c *Category) ToDB() *db.Category {
 	category := &db.Category{
 		ID:       c.ID,
 		Title:    c.Title,
+		Slug:     c.Slug,
 		Sort:     c.Sort,
 		StatusID: c.StatusID,
 	}
@@ -56,6 +59,7 @@ func (cs *CategorySearch) ToDB() *db.CategorySearch {
 type CategorySummary struct {
 	ID    int    `json:"id"`
 	Title string `json:"title"`
+	Slug  string `json:"slug"`
 	Sort  *int   `json:"sort"`
 
 	Status *Status `json:"status"`
@@ -64,6 +68,7 @@ type CategorySummary struct {
 type News struct {
 	ID          int       `json:"id"`
 	Title       string    `json:"title" validate:"required,max=255"`
+	Slug        string    `json:"slug" validate:"omitempty,max=255,alias"`
 	ShortText   string    `json:"shortText" validate:"required,max=1024"`
 	Content     *string   `json:"content"`
 	Author      *string   `json:"author" validate:"omitempty,max=255"`
@@ -85,6 +90,7 @@ func (n *News) ToDB() *db.News {
 	news := &db.News{
 		ID:          n.ID,
 		Title:       n.Title,
+		Slug:        n.Slug,
 		ShortText:   n.ShortText,
 		Content:     n.Content,
 		Author:      n.Author,
@@ -98,6 +104,9 @@ func (n *News) ToDB() *db.News {
 	return news
 }
 
+// tagMatchAll is a NewsSearch.TagMatch value for news tagged with all of the tags.
+const tagMatchAll = "all"
+
 type NewsSearch struct {
 	ID              *int       `json:"id"`
 	Title           *string    `json:"title"`
@@ -111,6 +120,13 @@ type NewsSearch struct {
 	IDs             []int      `json:"ids"`
 	TagID           *int       `json:"tagId"`
 	PublishedBefore *time.Time `json:"publishedBefore"`
+	// full-text search query over title, shortText and content
+	Query *string `json:"query"`
+	// news from any of the categories
+	CategoryIDs []int `json:"categoryIds"`
+	// news tagged with any (default) or all of the tags, see tagMatch
+	TagIDs   []int   `json:"tagIds"`
+	TagMatch *string `json:"tagMatch"`
 }
 
 func (ns *NewsSearch) ToDB() *db.NewsSearch {
@@ -118,7 +134,7 @@ func (ns *NewsSearch) ToDB() *db.NewsSearch {
 		return nil
 	}
 
-	return &db.NewsSearch{
+	search := &db.NewsSearch{
 		ID:              ns.ID,
 		TitleILike:      ns.Title,
 		ShortTextILike:  ns.ShortText,
@@ -131,12 +147,26 @@ func (ns *NewsSearch) ToDB() *db.NewsSearch {
 		IDs:             ns.IDs,
 		TagID:           ns.TagID,
 		PublishedBefore: ns.PublishedBefore,
+		CategoryIDs:     ns.CategoryIDs,
+	}
+
+	if ns.TagMatch != nil && *ns.TagMatch == tagMatchAll {
+		search.TagIDsAll = ns.TagIDs
+	} else {
+		search.TagIDsAny = ns.TagIDs
+	}
+
+	if ns.Query != nil {
+		search.WithQuery(strings.TrimSpace(*ns.Query))
 	}
+
+	return search
 }
 
 type NewsSummary struct {
 	ID          int       `json:"id"`
 	Title       string    `json:"title"`
+	Slug        string    `json:"slug"`
 	ShortText   string    `json:"shortText"`
 	Content     *string   `json:"content"`
 	Author      *string   `json:"author"`
@@ -148,6 +178,60 @@ type NewsSummary struct {
 	Status   *Status          `json:"status"`
 }
 
+type NewsRevision struct {
+	ID           int       `json:"id"`
+	NewsID       int       `json:"newsId"`
+	UserID       *int      `json:"userId"`
+	Action       string    `json:"action"`
+	Title        string    `json:"title"`
+	Slug         string    `json:"slug"`
+	ShortText    string    `json:"shortText"`
+	Content      *string   `json:"content"`
+	Author       *string   `json:"author"`
+	CategoryID   int       `json:"categoryId"`
+	TagIDs       []int     `json:"tagIds"`
+	PublishedAt  time.Time `json:"publishedAt"`
+	NewsStatusID int       `json:"newsStatusId"`
+	CreatedAt    time.Time `json:"createdAt"`
+
+	User       *UserSummary `json:"user"`
+	NewsStatus *Status      `json:"newsStatus"`
+}
+
+// ToNews returns news data of the revision.
+func (r *NewsRevision) ToNews() News {
+	return News{
+		ID:          r.NewsID,
+		Title:       r.Title,
+		Slug:        r.Slug,
+		ShortText:   r.ShortText,
+		Content:     r.Content,
+		Author:      r.Author,
+		CategoryID:  r.CategoryID,
+		TagIDs:      r.TagIDs,
+		PublishedAt: r.PublishedAt,
+		StatusID:    r.NewsStatusID,
+	}
+}
+
+type NewsRevisionSummary struct {
+	ID        int       `json:"id"`
+	NewsID    int       `json:"newsId"`
+	Action    string    `json:"action"`
+	Title     string    `json:"title"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	User *UserSummary `json:"user"`
+}
+
+// NewsRevisionDiff is a pair of revisions of the same news with a list of changed fields.
+type NewsRevisionDiff struct {
+	From *NewsRevision `json:"from"`
+	To   *NewsRevision `json:"to"`
+	// Fields is a list of changed fields: title, slug, shortText, content, author, categoryId, tagIds, publishedAt, newsStatusId.
+	Fields []string `json:"fields"`
+}
+
 type Tag struct {
 	ID       int    `json:"id"`
 	Name     string `json:"name" validate:"required,max=64"`
@@ -196,3 +280,95 @@ type TagSummary struct {
 
 	Status *Status `json:"status"`
 }
+
+// OrphanTag is a tag id used in news tagIds, which tag is missing.
+type OrphanTag struct {
+	ID int `json:"id"`
+	// NewsCount is a number of news with the tag, including deleted news.
+	NewsCount int `json:"newsCount"`
+}
+
+type Suggestion struct {
+	ID                 int       `json:"id"`
+	Title              string    `json:"title"`
+	ShortText          string    `json:"shortText"`
+	Text               string    `json:"text"`
+	CategoryID         int       `json:"categoryId"`
+	Tags               []string  `json:"tags"`
+	IP                 string    `json:"ip"`
+	Contact            *string   `json:"contact"`
+	NewsID             *int      `json:"newsId"`
+	CreatedAt          time.Time `json:"createdAt"`
+	ModerationStatusID int       `json:"moderationStatusId"`
+
+	Category         *CategorySummary     `json:"category"`
+	ModerationStatus *Status              `json:"moderationStatus"`
+	Decisions        []SuggestionDecision `json:"decisions"`
+}
+
+type SuggestionSearch struct {
+	ID                 *int       `json:"id"`
+	Title              *string    `json:"title"`
+	CategoryID         *int       `json:"categoryId"`
+	IP                 *string    `json:"ip"`
+	ModerationStatusID *int       `json:"moderationStatusId"`
+	CreatedAtFrom      *time.Time `json:"createdAtFrom"`
+	CreatedAtTo        *time.Time `json:"createdAtTo"`
+	IDs                []int      `json:"ids"`
+}
+
+func (ss *SuggestionSearch) ToDB() *db.SuggestionSearch {
+	if ss == nil {
+		return nil
+	}
+
+	return &db.SuggestionSearch{
+		ID:                 ss.ID,
+		TitleILike:         ss.Title,
+		CategoryID:         ss.CategoryID,
+		IP:                 ss.IP,
+		ModerationStatusID: ss.ModerationStatusID,
+		CreatedAtFrom:      ss.CreatedAtFrom,
+		CreatedAtTo:        ss.CreatedAtTo,
+		IDs:                ss.IDs,
+	}
+}
+
+type SuggestionSummary struct {
+	ID         int       `json:"id"`
+	Title      string    `json:"title"`
+	ShortText  string    `json:"shortText"`
+	CategoryID int       `json:"categoryId"`
+	Tags       []string  `json:"tags"`
+	IP         string    `json:"ip"`
+	Contact    *string   `json:"contact"`
+	NewsID     *int      `json:"newsId"`
+	CreatedAt  time.Time `json:"createdAt"`
+
+	Category         *CategorySummary `json:"category"`
+	ModerationStatus *Status          `json:"moderationStatus"`
+}
+
+type SuggestionDecision struct {
+	ID                 int       `json:"id"`
+	UserID             int       `json:"userId"`
+	ModerationStatusID int       `json:"moderationStatusId"`
+	Reason             *string   `json:"reason"`
+	NewsID             *int      `json:"newsId"`
+	CreatedAt          time.Time `json:"createdAt"`
+
+	User             *UserSummary `json:"user"`
+	ModerationStatus *Status      `json:"moderationStatus"`
+}
+
+type SuggestionApproval struct {
+	ID          int       `json:"id" validate:"required"`
+	CategoryID  int       `json:"categoryId" validate:"required"`
+	TagIDs      []int     `json:"tagIds"`
+	PublishedAt time.Time `json:"publishedAt" validate:"required"`
+}
+
+type SuggestionRejection struct {
+	ID     int    `json:"id" validate:"required"`
+	Reason string `json:"reason" validate:"required,max=1024"`
+}
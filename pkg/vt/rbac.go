@@ -0,0 +1,201 @@
+package vt
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"apisrv/pkg/db"
+
+	"github.com/vmkteam/zenrpc/v2"
+)
+
+const (
+	// PermissionAll grants access to all namespaces and methods.
+	PermissionAll = "*"
+
+	// actionAll grants access to all methods of namespace.
+	actionAll = "*"
+	// actionWrite grants access to all methods of namespace, it implies actionRead.
+	actionWrite = "write"
+	// actionRead grants access to methods of namespace, which don't change data.
+	actionRead = "read"
+)
+
+// rbacMiddleware checks that authorized user has a permission to call the method, otherwise it returns ErrForbidden.
+// Permissions are granted by user roles and limited by API key scopes, auth namespace is allowed for all users.
+// It must be used after authMiddleware.
+func rbacMiddleware(commonRepo *db.CommonRepo) zenrpc.MiddlewareFunc {
+	return func(h zenrpc.InvokeFunc) zenrpc.InvokeFunc {
+		return func(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
+			user := UserFromContext(ctx)
+			ns := zenrpc.NamespaceFromContext(ctx)
+			if user == nil || ns == NSAuth {
+				return h(ctx, method, params)
+			}
+
+			permissions, err := userPermissions(ctx, commonRepo, user)
+			if err != nil {
+				return zenrpc.NewResponseError(zenrpc.IDFromContext(ctx), ErrInternal.Code, ErrInternal.Message, ErrInternal.Data)
+			}
+
+			if !isAllowed(permissions, ns, method) || !isKeyAllowed(APIKeyFromContext(ctx), ns, method) {
+				return zenrpc.NewResponseError(zenrpc.IDFromContext(ctx), ErrForbidden.Code, ErrForbidden.Message, ErrForbidden.Data)
+			}
+
+			return h(ctx, method, params)
+		}
+	}
+}
+
+// userRoles returns enabled user roles.
+func userRoles(ctx context.Context, commonRepo *db.CommonRepo, user *db.User) ([]db.Role, error) {
+	if len(user.RoleIDs) == 0 {
+		return nil, nil
+	}
+
+	statusID := db.StatusEnabled
+	return commonRepo.RolesByFilters(ctx, &db.RoleSearch{IDs: user.RoleIDs, StatusID: &statusID}, db.PagerNoLimit)
+}
+
+// userPermissions returns sorted unique permissions of enabled user roles.
+func userPermissions(ctx context.Context, commonRepo *db.CommonRepo, user *db.User) ([]string, error) {
+	roles, err := userRoles(ctx, commonRepo, user)
+	if err != nil {
+		return nil, err
+	}
+
+	var permissions []string
+	for _, role := range roles {
+		permissions = append(permissions, role.Permissions...)
+	}
+	slices.Sort(permissions)
+
+	return append([]string{}, slices.Compact(permissions)...), nil
+}
+
+// isAllowed checks that one of permissions grants access to the namespace method.
+// Permission is "*" or "namespace:action", where namespace could be "*" and action is "*", "write", "read" or method name.
+func isAllowed(permissions []string, ns, method string) bool {
+	for _, p := range permissions {
+		if p == PermissionAll {
+			return true
+		}
+
+		pns, action, ok := strings.Cut(p, ":")
+		if !ok || (pns != ns && pns != actionAll) {
+			continue
+		}
+
+		switch action {
+		case actionAll, actionWrite, method:
+			return true
+		case actionRead:
+			if isReadMethod(ns, method) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isKeyAllowed checks that API key scopes grant access to the namespace method.
+// API key with scopes narrows permissions of its service account, key without scopes or session (nil key) is allowed.
+func isKeyAllowed(key *db.APIKey, ns, method string) bool {
+	return key == nil || len(key.Permissions) == 0 || isAllowed(key.Permissions, ns, method)
+}
+
+// checkGrant returns ErrForbidden, if user from context doesn't hold one of permissions, so roles and permissions
+// could be granted only by users, who already have them. API key scopes are applied too. Calls without user are allowed.
+func checkGrant(ctx context.Context, commonRepo *db.CommonRepo, permissions []string) error {
+	user := UserFromContext(ctx)
+	if user == nil || len(permissions) == 0 {
+		return nil
+	}
+
+	held, err := userPermissions(ctx, commonRepo, user)
+	if err != nil {
+		return InternalError(err)
+	}
+
+	key := APIKeyFromContext(ctx)
+	for _, p := range permissions {
+		if !isGranted(held, p) || (key != nil && len(key.Permissions) > 0 && !isGranted(key.Permissions, p)) {
+			return ErrForbidden
+		}
+	}
+
+	return nil
+}
+
+// rolesPermissions returns permissions of roles with any status, disabled role could be enabled later.
+func rolesPermissions(ctx context.Context, commonRepo *db.CommonRepo, roleIDs []int) ([]string, error) {
+	if len(roleIDs) == 0 {
+		return nil, nil
+	}
+
+	roles, err := commonRepo.RolesByFilters(ctx, &db.RoleSearch{IDs: roleIDs}, db.PagerNoLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	var permissions []string
+	for _, role := range roles {
+		permissions = append(permissions, role.Permissions...)
+	}
+
+	return permissions, nil
+}
+
+// isGranted checks that one of held permissions grants everything permission p does.
+func isGranted(held []string, p string) bool {
+	ns, action := actionAll, actionAll
+	if p != PermissionAll {
+		ns, action, _ = strings.Cut(p, ":")
+	}
+
+	for _, h := range held {
+		if h == PermissionAll {
+			return true
+		}
+
+		hns, haction, ok := strings.Cut(h, ":")
+		if !ok || (hns != ns && hns != actionAll) {
+			continue
+		}
+
+		switch haction {
+		case actionAll, actionWrite, action:
+			return true
+		case actionRead:
+			if isReadMethod(ns, action) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isValidPermission checks permission format.
+func isValidPermission(p string) bool {
+	if p == PermissionAll {
+		return true
+	}
+
+	ns, action, ok := strings.Cut(p, ":")
+	return ok && isPermissionPart(ns) && isPermissionPart(action)
+}
+
+// isPermissionPart checks that s is "*" or non-empty lowercase name.
+func isPermissionPart(s string) bool {
+	if s == actionAll {
+		return true
+	}
+
+	return s != "" && !strings.ContainsFunc(s, func(r rune) bool {
+		return (r < 'a' || r > 'z') && (r < '0' || r > '9')
+	})
+}
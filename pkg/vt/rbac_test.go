@@ -0,0 +1,59 @@
+package vt
+
+import (
+	"testing"
+
+	"apisrv/pkg/db"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRBAC_isAllowed(t *testing.T) {
+	Convey("Test isAllowed", t, func() {
+		So(isAllowed([]string{PermissionAll}, NSUser, RPC.UserService.Delete), ShouldBeTrue)
+		So(isAllowed([]string{"*:*"}, NSUser, RPC.UserService.Delete), ShouldBeTrue)
+		So(isAllowed([]string{"user:*"}, NSUser, RPC.UserService.Delete), ShouldBeTrue)
+		So(isAllowed([]string{"news:write"}, NSNews, RPC.NewsService.Get), ShouldBeTrue)
+		So(isAllowed([]string{"news:write"}, NSNews, RPC.NewsService.Delete), ShouldBeTrue)
+		So(isAllowed([]string{"news:read"}, NSNews, RPC.NewsService.GetByID), ShouldBeTrue)
+		So(isAllowed([]string{"news:read"}, NSNews, RPC.NewsService.Update), ShouldBeFalse)
+		So(isAllowed([]string{"*:read"}, NSTag, RPC.TagService.Count), ShouldBeTrue)
+		So(isAllowed([]string{"moderation:approve"}, NSModeration, RPC.ModerationService.Approve), ShouldBeTrue)
+		So(isAllowed([]string{"moderation:approve"}, NSModeration, RPC.ModerationService.Reject), ShouldBeFalse)
+		So(isAllowed([]string{"news:write"}, NSCategory, RPC.CategoryService.Delete), ShouldBeFalse)
+		So(isAllowed([]string{"news"}, NSNews, RPC.NewsService.Get), ShouldBeFalse)
+		So(isAllowed([]string{"vfs:write"}, "vfs", "upload"), ShouldBeTrue)
+		So(isAllowed([]string{"vfs:read"}, "vfs", "upload"), ShouldBeFalse)
+		So(isAllowed(nil, NSNews, RPC.NewsService.Get), ShouldBeFalse)
+	})
+
+	Convey("Test isKeyAllowed", t, func() {
+		So(isKeyAllowed(nil, "vfs", "upload"), ShouldBeTrue)
+		So(isKeyAllowed(&db.APIKey{}, "vfs", "upload"), ShouldBeTrue)
+		So(isKeyAllowed(&db.APIKey{Permissions: []string{"vfs:write"}}, "vfs", "upload"), ShouldBeTrue)
+		So(isKeyAllowed(&db.APIKey{Permissions: []string{"news:write"}}, "vfs", "upload"), ShouldBeFalse)
+	})
+
+	Convey("Test isGranted", t, func() {
+		So(isGranted([]string{PermissionAll}, PermissionAll), ShouldBeTrue)
+		So(isGranted([]string{"*:*"}, PermissionAll), ShouldBeTrue)
+		So(isGranted([]string{"user:*"}, PermissionAll), ShouldBeFalse)
+		So(isGranted([]string{"user:*"}, "*:read"), ShouldBeFalse)
+		So(isGranted([]string{"user:*"}, "user:write"), ShouldBeTrue)
+		So(isGranted([]string{"news:write"}, "news:delete"), ShouldBeTrue)
+		So(isGranted([]string{"news:read"}, "news:write"), ShouldBeFalse)
+		So(isGranted([]string{"news:read"}, "news:getbyid"), ShouldBeTrue)
+		So(isGranted([]string{"moderation:approve"}, "moderation:reject"), ShouldBeFalse)
+		So(isGranted(nil, "news:read"), ShouldBeFalse)
+	})
+
+	Convey("Test isValidPermission", t, func() {
+		for _, p := range []string{"*", "*:*", "user:*", "news:write", "*:read", "moderation:approve", "vfs:*"} {
+			So(isValidPermission(p), ShouldBeTrue)
+		}
+
+		for _, p := range []string{"", "news", "news:", ":write", "News:write", "news:write:all", " news:write"} {
+			So(isValidPermission(p), ShouldBeFalse)
+		}
+	})
+}
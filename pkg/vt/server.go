@@ -17,12 +17,20 @@ const (
 )
 
 const (
-	NSAuth = "auth"
-	NSUser = "user"
-
-	NSCategory = "category"
-	NSNews     = "news"
-	NSTag      = "tag"
+	NSAuth   = "auth"
+	NSUser   = "user"
+	NSRole   = "role"
+	NSAPIKey = "apikey"
+
+	NSCategory   = "category"
+	NSNews       = "news"
+	NSTag        = "tag"
+	NSModeration = "moderation"
+	NSWebhook    = "webhook"
+	NSAudit      = "audit"
+	NSTrash      = "trash"
+
+	NSVFS = "vfs"
 )
 
 var (
@@ -43,14 +51,18 @@ func httpAsRPCError(code int) *zenrpc.Error {
 	return zenrpc.NewStringError(code, http.StatusText(code))
 }
 
-// New returns new zenrpc Server.
-func New(dbo db.DB, logger embedlog.Logger, isDevel bool) zenrpc.Server {
+// New returns new zenrpc Server. Login is protected with loginGuard or with the default one, if it is nil.
+func New(dbo db.DB, logger embedlog.Logger, isDevel bool, loginGuard *LoginGuard) zenrpc.Server {
 	rpc := zenrpc.NewServer(zenrpc.Options{
 		ExposeSMD: true,
 		AllowCORS: true,
 	})
 
 	commonRepo := db.NewCommonRepo(dbo)
+	authService := NewAuthService(dbo, logger)
+	if loginGuard != nil {
+		authService.guard = loginGuard
+	}
 
 	// middleware
 	rpc.Use(
@@ -63,17 +75,25 @@ func New(dbo db.DB, logger embedlog.Logger, isDevel bool) zenrpc.Server {
 		zm.WithSQLLogger(dbo.DB, isDevel, allowDebugFn(), allowDebugFn()),
 		zm.WithTiming(isDevel, allowDebugFn()),
 		zm.WithSentry(zm.DefaultServerName),
-		authMiddleware(&commonRepo, logger),
+		authMiddleware(&commonRepo, authService.guard, logger),
+		auditMiddleware(db.NewAuditRepo(dbo), logger),
+		rbacMiddleware(&commonRepo),
 	)
 
 	// services
 	rpc.RegisterAll(map[string]zenrpc.Invoker{
-		NSAuth: NewAuthService(dbo, logger),
-		NSUser: NewUserService(dbo, logger),
-
-		NSCategory: NewCategoryService(dbo, logger),
-		NSNews:     NewNewsService(dbo, logger),
-		NSTag:      NewTagService(dbo, logger),
+		NSAuth:   authService,
+		NSUser:   NewUserService(dbo, logger),
+		NSRole:   NewRoleService(dbo, logger),
+		NSAPIKey: NewAPIKeyService(dbo, logger),
+
+		NSCategory:   NewCategoryService(dbo, logger),
+		NSNews:       NewNewsService(dbo, logger),
+		NSTag:        NewTagService(dbo, logger),
+		NSModeration: NewModerationService(dbo, logger),
+		NSWebhook:    NewWebhookService(dbo, logger),
+		NSAudit:      NewAuditService(dbo, logger),
+		NSTrash:      NewTrashService(dbo, logger),
 	})
 
 	return rpc
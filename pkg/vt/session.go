@@ -0,0 +1,104 @@
+package vt
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"apisrv/pkg/db"
+)
+
+const (
+	// sessionIdleTimeout and sessionMaxAge are idle and absolute lifetimes of a session.
+	sessionIdleTimeout = 2 * time.Hour
+	sessionMaxAge      = 24 * time.Hour
+	// rememberIdleTimeout and rememberMaxAge are lifetimes of a session with remember flag.
+	rememberIdleTimeout = 7 * 24 * time.Hour
+	rememberMaxAge      = 30 * 24 * time.Hour
+
+	// sessionActivityInterval is a min interval between session last activity updates.
+	sessionActivityInterval = 90 * time.Second
+
+	sessionTokenBytes = 16
+	maxUserAgentLen   = 512
+)
+
+// newSessionToken returns a crypto-random hex token.
+func newSessionToken() string {
+	b := make([]byte, sessionTokenBytes)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// hashToken returns hex encoded SHA-256 of token, only hashes are stored in db.
+func hashToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+// newSession returns a new session of the user with token hash, device metadata and expiration times.
+func newSession(userID int, token string, remember bool, ip, userAgent string, now time.Time) *db.Session {
+	idle, maxAge := sessionIdleTimeout, sessionMaxAge
+	if remember {
+		idle, maxAge = rememberIdleTimeout, rememberMaxAge
+	}
+
+	if len(userAgent) > maxUserAgentLen {
+		userAgent = userAgent[:maxUserAgentLen]
+	}
+
+	return &db.Session{
+		UserID:         userID,
+		TokenHash:      hashToken(token),
+		IP:             ip,
+		UserAgent:      userAgent,
+		Device:         deviceName(userAgent),
+		IdleTimeout:    int(idle.Seconds()),
+		LastActivityAt: now,
+		ExpiresAt:      now.Add(maxAge),
+	}
+}
+
+// devices are user agent substrings and device names, order matters.
+var devices = []struct{ substr, name string }{
+	{"iPhone", "iPhone"},
+	{"iPad", "iPad"},
+	{"Android", "Android"},
+	{"Windows", "Windows"},
+	{"Macintosh", "macOS"},
+	{"CrOS", "ChromeOS"},
+	{"Linux", "Linux"},
+}
+
+// deviceName returns a short device name parsed from user agent or empty string.
+func deviceName(userAgent string) string {
+	for _, d := range devices {
+		if strings.Contains(userAgent, d.substr) {
+			return d.name
+		}
+	}
+	return ""
+}
+
+// authenticate returns active session with enabled user by token or nil. Expired session is deleted.
+func authenticate(ctx context.Context, commonRepo *db.CommonRepo, token string) (*db.Session, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	session, err := commonRepo.SessionByTokenHash(ctx, hashToken(token))
+	if err != nil || session == nil || session.User == nil || session.User.StatusID != db.StatusEnabled {
+		return nil, err
+	}
+
+	now := time.Now()
+	if session.IsExpired(now) {
+		_, err = commonRepo.DeleteSession(ctx, session.ID)
+		return nil, err
+	}
+
+	return session, nil
+}
@@ -0,0 +1,44 @@
+package vt
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSession(t *testing.T) {
+	Convey("Test session token", t, func() {
+		token := newSessionToken()
+		So(token, ShouldHaveLength, 2*sessionTokenBytes)
+		So(newSessionToken(), ShouldNotEqual, token)
+		So(hashToken(token), ShouldHaveLength, 64)
+		So(hashToken(token), ShouldEqual, hashToken(token))
+	})
+
+	Convey("Test newSession expiration", t, func() {
+		now := time.Now()
+		ua := "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15"
+
+		s := newSession(1, "token", false, "127.0.0.1", ua, now)
+		So(s.Device, ShouldEqual, "iPhone")
+		So(s.TokenHash, ShouldEqual, hashToken("token"))
+		So(s.ExpiresAt, ShouldEqual, now.Add(sessionMaxAge))
+		So(s.IsExpired(now), ShouldBeFalse)
+		So(s.IsExpired(now.Add(sessionIdleTimeout)), ShouldBeTrue)
+
+		s = newSession(1, "token", true, "127.0.0.1", ua, now)
+		So(s.IsExpired(now.Add(sessionIdleTimeout)), ShouldBeFalse)
+		So(s.IsExpired(now.Add(rememberIdleTimeout)), ShouldBeTrue)
+
+		s.LastActivityAt = now.Add(rememberMaxAge - time.Hour)
+		So(s.IsExpired(now.Add(rememberMaxAge)), ShouldBeTrue)
+	})
+
+	Convey("Test deviceName", t, func() {
+		So(deviceName("Mozilla/5.0 (Windows NT 10.0; Win64; x64)"), ShouldEqual, "Windows")
+		So(deviceName("Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7)"), ShouldEqual, "macOS")
+		So(deviceName("Mozilla/5.0 (Linux; Android 14; Pixel 8)"), ShouldEqual, "Android")
+		So(deviceName("curl/8.0"), ShouldEqual, "")
+	})
+}
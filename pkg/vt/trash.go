@@ -0,0 +1,363 @@
+package vt
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"apisrv/pkg/db"
+	"apisrv/pkg/webhook"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/vmkteam/embedlog"
+	"github.com/vmkteam/zenrpc/v2"
+)
+
+const (
+	defaultTrashInterval  = time.Hour
+	defaultTrashBatchSize = 100
+)
+
+// TrashConfig is a configuration of trash retention policy.
+type TrashConfig struct {
+	// RetentionDays is a number of days, after which deleted objects are purged automatically, 0 disables auto-purge.
+	RetentionDays int
+	// Interval is an interval of expired trash items check, default is 1h.
+	Interval time.Duration
+	// BatchSize is a max number of trash items read at once, default is 100.
+	BatchSize int
+}
+
+// newTrashConflictError returns 409 error with dependencies, which prevent purge, in data.
+func newTrashConflictError(deps []db.TrashDependency) *zenrpc.Error {
+	return &zenrpc.Error{
+		Code:    http.StatusConflict,
+		Message: "object is referenced by other objects",
+		Data:    NewTrashDependencies(deps),
+	}
+}
+
+// purgeTrashObject permanently deletes soft-deleted object in a transaction, if it has no dependencies.
+// It returns dependencies, which prevent purge.
+func purgeTrashObject(ctx context.Context, dbo db.DB, objectType string, id int) (deps []db.TrashDependency, ok bool, err error) {
+	err = dbo.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		repo := db.NewTrashRepo(tx)
+		if deps, err = repo.TrashDependencies(ctx, objectType, id); err != nil || len(deps) > 0 {
+			return err
+		}
+
+		ok, err = repo.PurgeTrashObject(ctx, objectType, id)
+		return err
+	})
+
+	return deps, ok, err
+}
+
+type TrashService struct {
+	zenrpc.Service
+	embedlog.Logger
+
+	db         db.DB
+	trashRepo  db.TrashRepo
+	commonRepo db.CommonRepo
+	hooks      webhook.Emitter
+}
+
+func NewTrashService(dbo db.DB, logger embedlog.Logger) *TrashService {
+	return &TrashService{
+		db:         dbo,
+		trashRepo:  db.NewTrashRepo(dbo),
+		commonRepo: db.NewCommonRepo(dbo),
+		hooks:      webhook.NewEmitter(dbo),
+		Logger:     logger,
+	}
+}
+
+// isValidType returns validation error, if objects of the type could not be restored or purged.
+func (s TrashService) isValidType(objectType string) error {
+	var v Validator
+	if !db.IsTrashType(objectType) {
+		v.Append("objectType", FieldErrorIncorrect)
+		return v.Error()
+	}
+	return nil
+}
+
+// Count returns count of deleted objects of the type: news, categories, tags, users or vfsFiles.
+//
+//zenrpc:objectType string
+//zenrpc:return int
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+func (s TrashService) Count(ctx context.Context, objectType string) (int, error) {
+	if err := s.isValidType(objectType); err != nil {
+		return 0, err
+	}
+
+	count, err := s.trashRepo.CountTrashObjects(ctx, objectType)
+	if err != nil {
+		return 0, InternalError(err)
+	}
+	return count, nil
+}
+
+// Get returns а list of deleted objects of the type: news, categories, tags, users or vfsFiles, recently deleted first.
+//
+//zenrpc:objectType string
+//zenrpc:viewOps ViewOps
+//zenrpc:return []TrashItem
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+func (s TrashService) Get(ctx context.Context, objectType string, viewOps *ViewOps) ([]TrashItem, error) {
+	if err := s.isValidType(objectType); err != nil {
+		return nil, err
+	}
+
+	list, err := s.trashRepo.TrashObjects(ctx, objectType, viewOps.Pager())
+	if err != nil {
+		return nil, InternalError(err)
+	}
+
+	items := make([]TrashItem, 0, len(list))
+	for i := range list {
+		items = append(items, *NewTrashItem(objectType, &list[i]))
+	}
+	return items, nil
+}
+
+// GetDependencies returns objects, which reference the deleted object and prevent its purge.
+//
+//zenrpc:objectType string
+//zenrpc:id int
+//zenrpc:return []TrashDependency
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+//zenrpc:404 Not Found
+func (s TrashService) GetDependencies(ctx context.Context, objectType string, id int) ([]TrashDependency, error) {
+	if _, err := s.byID(ctx, objectType, id); err != nil {
+		return nil, err
+	}
+
+	deps, err := s.trashRepo.TrashDependencies(ctx, objectType, id)
+	if err != nil {
+		return nil, InternalError(err)
+	}
+	return NewTrashDependencies(deps), nil
+}
+
+func (s TrashService) byID(ctx context.Context, objectType string, id int) (*db.TrashObject, error) {
+	if err := s.isValidType(objectType); err != nil {
+		return nil, err
+	}
+
+	obj, err := s.trashRepo.TrashObjectByID(ctx, objectType, id)
+	if err != nil {
+		return nil, InternalError(err)
+	} else if obj == nil {
+		return nil, ErrNotFound
+	}
+	return obj, nil
+}
+
+// Restore restores the deleted object to its status before deletion, objects deleted before trash tracking are restored as disabled.
+// User could not be restored, if its login is taken by another user.
+//
+//zenrpc:objectType string
+//zenrpc:id int
+//zenrpc:return isRestored
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+//zenrpc:404 Not Found
+func (s TrashService) Restore(ctx context.Context, objectType string, id int) (bool, error) {
+	obj, err := s.byID(ctx, objectType, id)
+	if err != nil {
+		return false, err
+	}
+
+	statusID := db.StatusDisabled
+	if obj.PrevStatusID != nil {
+		statusID = *obj.PrevStatusID
+	}
+
+	if objectType == db.TrashUsers {
+		var v Validator
+		if count, err := s.commonRepo.CountUsers(ctx, &db.UserSearch{Login: &obj.Title}); err != nil {
+			return false, InternalError(err)
+		} else if count > 0 {
+			v.Append("login", FieldErrorUnique)
+			return false, v.Error()
+		}
+	}
+
+	var ok bool
+	err = s.db.RunInTransaction(ctx, func(tx *pg.Tx) (err error) {
+		if ok, err = s.trashRepo.WithTransaction(tx).RestoreTrashObject(ctx, objectType, id, statusID); err != nil || !ok {
+			return err
+		}
+
+		return s.restored(ctx, tx, objectType, id)
+	})
+	if err != nil {
+		return false, InternalError(err)
+	}
+	return ok, nil
+}
+
+// restored saves news revision and emits updated event for restored news, category or tag, news.published event for live news.
+func (s TrashService) restored(ctx context.Context, tx *pg.Tx, objectType string, id int) error {
+	repo, hooks := db.NewNewsRepo(tx), s.hooks.WithTransaction(tx)
+
+	switch objectType {
+	case db.TrashNews:
+		news, err := repo.NewsByID(ctx, id)
+		if err != nil || news == nil {
+			return err
+		}
+
+		deleted := *news
+		deleted.StatusID = db.StatusDeleted
+		if err = addNewsRevisions(ctx, repo, &deleted, news, db.RevisionUpdate); err != nil {
+			return err
+		}
+
+		if err = hooks.Emit(ctx, webhook.EventNewsUpdated, webhook.NewNews(news)); err != nil {
+			return err
+		}
+
+		return hooks.EmitPublished(ctx, &deleted, news)
+	case db.TrashCategories:
+		category, err := repo.CategoryByID(ctx, id)
+		if err != nil || category == nil {
+			return err
+		}
+
+		return hooks.Emit(ctx, webhook.EventCategoryUpdated, webhook.NewCategory(category))
+	case db.TrashTags:
+		tag, err := repo.TagByID(ctx, id)
+		if err != nil || tag == nil {
+			return err
+		}
+
+		return hooks.Emit(ctx, webhook.EventTagUpdated, webhook.NewTag(tag))
+	}
+
+	return nil
+}
+
+// Purge permanently deletes the deleted object. Object, which is referenced by other objects, could not be purged.
+// Files of purged vfsFiles are kept in storage.
+//
+//zenrpc:objectType string
+//zenrpc:id int
+//zenrpc:return isPurged
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+//zenrpc:404 Not Found
+//zenrpc:409 Object Has Dependencies
+func (s TrashService) Purge(ctx context.Context, objectType string, id int) (bool, error) {
+	if _, err := s.byID(ctx, objectType, id); err != nil {
+		return false, err
+	}
+
+	deps, ok, err := purgeTrashObject(ctx, s.db, objectType, id)
+	if err != nil {
+		return false, InternalError(err)
+	} else if len(deps) > 0 {
+		return false, newTrashConflictError(deps)
+	}
+	return ok, nil
+}
+
+// TrashCleaner purges objects deleted more than retention days ago. Objects with dependencies are skipped.
+type TrashCleaner struct {
+	embedlog.Logger
+	cfg  TrashConfig
+	db   db.DB
+	repo db.TrashRepo
+}
+
+func NewTrashCleaner(dbo db.DB, logger embedlog.Logger, cfg TrashConfig) *TrashCleaner {
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultTrashInterval
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultTrashBatchSize
+	}
+
+	return &TrashCleaner{
+		Logger: logger,
+		cfg:    cfg,
+		db:     dbo,
+		repo:   db.NewTrashRepo(dbo),
+	}
+}
+
+// Run purges expired trash items every interval until ctx is done. It returns immediately, if retention is disabled.
+func (c *TrashCleaner) Run(ctx context.Context) {
+	if c.cfg.RetentionDays <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(c.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.Purge(ctx, time.Now()); err != nil {
+				c.Error(ctx, "purge trash", "err", err)
+			}
+		}
+	}
+}
+
+// Purge purges objects deleted before retention days from now. Item purge errors are logged and the item is retried on next run,
+// so a single failing item doesn't block others. It returns only trash items read error.
+func (c *TrashCleaner) Purge(ctx context.Context, now time.Time) error {
+	deletedTo := now.AddDate(0, 0, -c.cfg.RetentionDays)
+	search := &db.TrashItemSearch{DeletedAtTo: &deletedTo, IDFrom: new(int)}
+	sort := db.WithSort(db.NewSortField(db.Columns.TrashItem.ID, false))
+
+	for {
+		items, err := c.repo.TrashItemsByFilters(ctx, search, db.Pager{PageSize: c.cfg.BatchSize}, sort)
+		if err != nil {
+			return fmt.Errorf("read trash items: %w", err)
+		}
+
+		for _, item := range items {
+			if err = c.purge(ctx, item); err != nil {
+				c.Error(ctx, "purge trash item", "objectType", item.ObjectType, "objectId", item.ObjectID, "err", err)
+			}
+		}
+
+		if len(items) < c.cfg.BatchSize {
+			return nil
+		}
+		*search.IDFrom = items[len(items)-1].ID + 1
+	}
+}
+
+// purge purges object of the trash item. Trash item of missing object is deleted.
+func (c *TrashCleaner) purge(ctx context.Context, item db.TrashItem) error {
+	if !db.IsTrashType(item.ObjectType) {
+		c.Print(ctx, "skip trash item of unsupported type", "objectType", item.ObjectType, "objectId", item.ObjectID)
+		return nil
+	}
+
+	deps, ok, err := purgeTrashObject(ctx, c.db, item.ObjectType, item.ObjectID)
+	switch {
+	case err != nil:
+		return err
+	case len(deps) > 0:
+		c.Print(ctx, "skip trash item with dependencies", "objectType", item.ObjectType, "objectId", item.ObjectID, "dependencies", deps)
+	case ok:
+		c.Print(ctx, "trash item purged", "objectType", item.ObjectType, "objectId", item.ObjectID)
+	default:
+		_, err = c.repo.DeleteTrashItem(ctx, item.ID)
+	}
+
+	return err
+}
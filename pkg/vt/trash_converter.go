@@ -0,0 +1,32 @@
+package vt
+
+import (
+	"apisrv/pkg/db"
+)
+
+func NewTrashItem(objectType string, in *db.TrashObject) *TrashItem {
+	if in == nil {
+		return nil
+	}
+
+	item := &TrashItem{
+		ID:         in.ID,
+		ObjectType: objectType,
+		Title:      in.Title,
+		DeletedAt:  in.DeletedAt,
+	}
+
+	if in.PrevStatusID != nil {
+		item.PrevStatus = NewStatus(*in.PrevStatusID)
+	}
+
+	return item
+}
+
+func NewTrashDependencies(in []db.TrashDependency) []TrashDependency {
+	res := make([]TrashDependency, 0, len(in))
+	for _, d := range in {
+		res = append(res, TrashDependency{ObjectType: d.Table, Count: d.Count})
+	}
+	return res
+}
@@ -0,0 +1,24 @@
+package vt
+
+import (
+	"time"
+)
+
+// TrashItem is a soft-deleted object.
+type TrashItem struct {
+	ID int `json:"id"`
+	// ObjectType is one of news, categories, tags, users, vfsFiles.
+	ObjectType string `json:"objectType"`
+	// Title is object title, login for users.
+	Title string `json:"title"`
+	// PrevStatus is a status, which is set on restore. It is empty for objects deleted before trash tracking, they are restored as disabled.
+	PrevStatus *Status `json:"prevStatus"`
+	// DeletedAt is empty for objects deleted before trash tracking, such objects are not purged by retention policy.
+	DeletedAt *time.Time `json:"deletedAt"`
+}
+
+// TrashDependency is a number of objects, which reference the trash item and prevent its purge.
+type TrashDependency struct {
+	ObjectType string `json:"objectType"`
+	Count      int    `json:"count"`
+}
@@ -0,0 +1,147 @@
+package vt
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"apisrv/pkg/db"
+	"apisrv/pkg/db/test"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/vmkteam/embedlog"
+)
+
+func TestTrash(t *testing.T) {
+	Convey("Test trash", t, func() {
+		Convey("Trash types", func() {
+			s := TrashService{}
+			for _, objectType := range db.TrashTypes {
+				So(s.isValidType(objectType), ShouldBeNil)
+			}
+			So(s.isValidType("webhooks"), ShouldNotBeNil)
+			So(s.isValidType(""), ShouldNotBeNil)
+		})
+
+		Convey("Trash item", func() {
+			now := time.Now()
+			prev := db.StatusEnabled
+
+			item := NewTrashItem(db.TrashNews, &db.TrashObject{ID: 1, Title: "News", PrevStatusID: &prev, DeletedAt: &now})
+			So(item.ObjectType, ShouldEqual, db.TrashNews)
+			So(item.PrevStatus.ID, ShouldEqual, db.StatusEnabled)
+			So(item.DeletedAt, ShouldEqual, &now)
+
+			// deleted before trash tracking
+			item = NewTrashItem(db.TrashUsers, &db.TrashObject{ID: 2, Title: "login"})
+			So(item.PrevStatus, ShouldBeNil)
+			So(item.DeletedAt, ShouldBeNil)
+		})
+
+		Convey("Conflict error", func() {
+			err := newTrashConflictError([]db.TrashDependency{{Table: db.Tables.News.Name, Count: 2}})
+			So(err.Code, ShouldEqual, 409)
+			So(err.Data, ShouldResemble, []TrashDependency{{ObjectType: "news", Count: 2}})
+		})
+
+		Convey("Disabled retention", func() {
+			c := NewTrashCleaner(db.DB{}, embedlog.Logger{}, TrashConfig{})
+			So(c.cfg.Interval, ShouldEqual, defaultTrashInterval)
+			So(c.cfg.BatchSize, ShouldEqual, defaultTrashBatchSize)
+			c.Run(context.Background())
+		})
+	})
+}
+
+func TestDB_TrashService(t *testing.T) {
+	Convey("Test TrashService", t, func() {
+		dbo, logger := test.Setup(t)
+		srv, newsSrv := NewTrashService(dbo, logger), NewNewsService(dbo, logger)
+		newsRepo, trashRepo := db.NewNewsRepo(dbo), db.NewTrashRepo(dbo)
+
+		commonRepo := db.NewCommonRepo(dbo)
+		u, err := commonRepo.OneUser(t.Context(), &db.UserSearch{Login: test.Ptr("admin")})
+		So(err, ShouldBeNil)
+		ctx := context.WithValue(t.Context(), userKey, u)
+
+		suffix := time.Now().UnixNano()
+		addCategory := func(statusID int) int {
+			category, err := newsRepo.AddCategory(ctx, &db.Category{Title: fmt.Sprintf("Trash %d", suffix), Slug: fmt.Sprintf("trash-%d", suffix), StatusID: statusID})
+			So(err, ShouldBeNil)
+			return category.ID
+		}
+
+		trashItem := func(id int) *db.TrashItem {
+			item, err := trashRepo.OneTrashItem(ctx, &db.TrashItemSearch{ObjectType: test.Ptr(db.TrashCategories), ObjectID: &id})
+			So(err, ShouldBeNil)
+			return item
+		}
+
+		Convey("Track and restore to previous status", func() {
+			id := addCategory(db.StatusDisabled)
+
+			ok, err := newsRepo.DeleteCategory(ctx, id)
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeTrue)
+
+			// trash item is added by trigger
+			item := trashItem(id)
+			So(item, ShouldNotBeNil)
+			So(item.PrevStatusID, ShouldEqual, db.StatusDisabled)
+
+			list, err := srv.Get(ctx, db.TrashCategories, &ViewOps{PageSize: 10})
+			So(err, ShouldBeNil)
+			So(slices.ContainsFunc(list, func(i TrashItem) bool { return i.ID == id }), ShouldBeTrue)
+
+			ok, err = srv.Restore(ctx, db.TrashCategories, id)
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeTrue)
+
+			category, err := newsRepo.CategoryByID(ctx, id)
+			So(err, ShouldBeNil)
+			So(category.StatusID, ShouldEqual, db.StatusDisabled)
+			So(trashItem(id), ShouldBeNil)
+
+			_, err = srv.Restore(ctx, db.TrashCategories, id)
+			So(err, ShouldEqual, ErrNotFound)
+		})
+
+		Convey("Purge with dependencies", func() {
+			id := addCategory(db.StatusEnabled)
+			news, err := newsSrv.Add(ctx, News{
+				Title:       fmt.Sprintf("Trash %d", suffix),
+				ShortText:   "Trash",
+				CategoryID:  id,
+				PublishedAt: time.Now(),
+				StatusID:    db.StatusEnabled,
+			})
+			So(err, ShouldBeNil)
+
+			ok, err := newsRepo.DeleteCategory(ctx, id)
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeTrue)
+
+			deps, err := srv.GetDependencies(ctx, db.TrashCategories, id)
+			So(err, ShouldBeNil)
+			So(deps, ShouldResemble, []TrashDependency{{ObjectType: db.TrashNews, Count: 1}})
+
+			ok, err = srv.Purge(ctx, db.TrashCategories, id)
+			So(err, ShouldResemble, newTrashConflictError([]db.TrashDependency{{Table: db.Tables.News.Name, Count: 1}}))
+			So(ok, ShouldBeFalse)
+
+			// purge is allowed after dependency is purged
+			_, err = newsSrv.Delete(ctx, news.ID)
+			So(err, ShouldBeNil)
+			ok, err = srv.Purge(ctx, db.TrashNews, news.ID)
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeTrue)
+
+			ok, err = srv.Purge(ctx, db.TrashCategories, id)
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeTrue)
+			So(trashItem(id), ShouldBeNil)
+		})
+	})
+}
@@ -36,6 +36,7 @@ var errorMap = map[string]string{
 	"required":      FieldErrorRequired,
 	"gt":            FieldErrorRequired,
 	"len":           FieldErrorLen,
+	"http_url":      FieldErrorFormat,
 	CustomStatusTag: FieldErrorIncorrect,
 	CustomAliasTag:  FieldErrorFormat,
 }
@@ -1,6 +1,10 @@
 package vt
 
 import (
+	"context"
+	"slices"
+	"strconv"
+
 	"apisrv/pkg/db"
 )
 
@@ -49,7 +53,92 @@ func NewStatus(id int) *Status {
 	return nil
 }
 
+// NewModerationStatus returns suggestion moderation status.
+func NewModerationStatus(id int) *Status {
+	switch id {
+	case db.ModerationPending:
+		return &Status{ID: db.ModerationPending, Alias: "pending", Title: "На модерации"}
+	case db.ModerationApproved:
+		return &Status{ID: db.ModerationApproved, Alias: "approved", Title: "Одобрено"}
+	case db.ModerationRejected:
+		return &Status{ID: db.ModerationRejected, Alias: "rejected", Title: "Отклонено"}
+	}
+	return nil
+}
+
+// NewDeliveryStatus returns webhook delivery status.
+func NewDeliveryStatus(id int) *Status {
+	switch id {
+	case db.DeliveryPending:
+		return &Status{ID: db.DeliveryPending, Alias: "pending", Title: "Ожидает отправки"}
+	case db.DeliveryDelivered:
+		return &Status{ID: db.DeliveryDelivered, Alias: "delivered", Title: "Доставлено"}
+	case db.DeliveryFailed:
+		return &Status{ID: db.DeliveryFailed, Alias: "failed", Title: "Ошибка доставки"}
+	}
+	return nil
+}
+
 type StatusUpdate struct {
 	StatusID  int   `json:"statusId" validate:"required,status"`
 	ObjectIDs []int `json:"ids" validate:"required,gt=0"`
 }
+
+// BulkResult is a result of bulk operation for the object. IsChanged is false if the object already was in the requested state.
+type BulkResult struct {
+	ID        int  `json:"id"`
+	IsChanged bool `json:"isChanged"`
+}
+
+// isValidStatusUpdate validates bulk status change, deleted status is set by DeleteMany only.
+func isValidStatusUpdate(ctx context.Context, statusUpdate StatusUpdate) Validator {
+	var v Validator
+
+	if v.CheckBasic(ctx, statusUpdate); v.HasInternalError() {
+		return v
+	}
+
+	if statusUpdate.StatusID == db.StatusDeleted {
+		v.Append("statusId", FieldErrorIncorrect)
+	}
+
+	return v
+}
+
+// bulkList returns objects fetched by unique ids in order of ids or validation error, if ids are empty or some of them are not found.
+func bulkList[T any](ids []int, fetch func(ids []int) ([]T, error), objectID func(*T) int) ([]T, error) {
+	var v Validator
+	if len(ids) == 0 {
+		v.Append("ids", FieldErrorRequired)
+		return nil, v.Error()
+	}
+
+	list, err := fetch(slices.Compact(slices.Sorted(slices.Values(ids))))
+	if err != nil {
+		return nil, InternalError(err)
+	}
+
+	byID := make(map[int]*T, len(list))
+	for i := range list {
+		byID[objectID(&list[i])] = &list[i]
+	}
+
+	res := make([]T, 0, len(list))
+	for i, id := range ids {
+		if slices.Index(ids, id) != i {
+			continue
+		}
+
+		if obj, ok := byID[id]; ok {
+			res = append(res, *obj)
+		} else {
+			v.Append("ids["+strconv.Itoa(i)+"]", FieldErrorIncorrect)
+		}
+	}
+
+	if v.HasErrors() {
+		return nil, v.Error()
+	}
+
+	return res, nil
+}
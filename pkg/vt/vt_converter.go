@@ -1,6 +1,8 @@
 package vt
 
 import (
+	"time"
+
 	"apisrv/pkg/db"
 )
 
@@ -15,6 +17,9 @@ func NewUser(in *db.User) *User {
 		Login:          in.Login,
 		LastActivityAt: in.LastActivityAt,
 		StatusID:       in.StatusID,
+		RoleIDs:        in.RoleIDs,
+		IsTOTPEnabled:  in.IsTOTPEnabled,
+		IsService:      in.IsService,
 		Status:         NewStatus(in.StatusID),
 	}
 
@@ -31,11 +36,14 @@ func NewUserSummary(in *db.User) *UserSummary {
 		CreatedAt:      in.CreatedAt,
 		Login:          in.Login,
 		LastActivityAt: in.LastActivityAt,
+		RoleIDs:        in.RoleIDs,
+		IsTOTPEnabled:  in.IsTOTPEnabled,
+		IsService:      in.IsService,
 		Status:         NewStatus(in.StatusID),
 	}
 }
 
-func NewUserProfile(in *db.User) *UserProfile {
+func NewUserProfile(in *db.User, permissions []string, isTOTPRequired bool) *UserProfile {
 	if in == nil {
 		return nil
 	}
@@ -46,5 +54,86 @@ func NewUserProfile(in *db.User) *UserProfile {
 		Login:          in.Login,
 		LastActivityAt: in.LastActivityAt,
 		StatusID:       in.StatusID,
+		RoleIDs:        in.RoleIDs,
+		Permissions:    permissions,
+		IsTOTPEnabled:  in.IsTOTPEnabled,
+		IsTOTPRequired: isTOTPRequired,
+	}
+}
+
+func NewRole(in *db.Role) *Role {
+	if in == nil {
+		return nil
+	}
+
+	return &Role{
+		ID:             in.ID,
+		Title:          in.Title,
+		Permissions:    in.Permissions,
+		IsTOTPRequired: in.IsTOTPRequired,
+		StatusID:       in.StatusID,
+		Status:         NewStatus(in.StatusID),
+	}
+}
+
+// NewSession converts db.Session to Session. ExpiresAt is the nearest of absolute and idle expiration times.
+func NewSession(in *db.Session, currentID int) *Session {
+	if in == nil {
+		return nil
+	}
+
+	expiresAt := in.LastActivityAt.Add(time.Duration(in.IdleTimeout) * time.Second)
+	if in.ExpiresAt.Before(expiresAt) {
+		expiresAt = in.ExpiresAt
+	}
+
+	return &Session{
+		ID:             in.ID,
+		IP:             in.IP,
+		UserAgent:      in.UserAgent,
+		Device:         in.Device,
+		CreatedAt:      in.CreatedAt,
+		LastActivityAt: in.LastActivityAt,
+		ExpiresAt:      expiresAt,
+		IsCurrent:      in.ID == currentID,
+	}
+}
+
+func NewLoginAttempt(in *db.LoginAttempt) *LoginAttempt {
+	if in == nil {
+		return nil
+	}
+
+	return &LoginAttempt{
+		ID:        in.ID,
+		Login:     in.Login,
+		UserID:    in.UserID,
+		IP:        in.IP,
+		UserAgent: in.UserAgent,
+		IsSuccess: in.IsSuccess,
+		Reason:    in.Reason,
+		IsCleared: in.IsCleared,
+		CreatedAt: in.CreatedAt,
+	}
+}
+
+func NewAPIKey(in *db.APIKey) *APIKey {
+	if in == nil {
+		return nil
+	}
+
+	return &APIKey{
+		ID:          in.ID,
+		UserID:      in.UserID,
+		Title:       in.Title,
+		Prefix:      in.Prefix,
+		Permissions: in.Permissions,
+		ExpiresAt:   in.ExpiresAt,
+		LastUsedAt:  in.LastUsedAt,
+		LastUsedIP:  in.LastUsedIP,
+		CreatedAt:   in.CreatedAt,
+		StatusID:    in.StatusID,
+		User:        NewUserSummary(in.User),
+		Status:      NewStatus(in.StatusID),
 	}
 }
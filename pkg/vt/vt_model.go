@@ -14,6 +14,9 @@ type User struct {
 	Password       string     `json:"password" validate:"max=64"`
 	LastActivityAt *time.Time `json:"lastActivityAt"`
 	StatusID       int        `json:"statusId" validate:"required,status"`
+	RoleIDs        []int      `json:"roleIds"`
+	IsTOTPEnabled  bool       `json:"isTotpEnabled"`
+	IsService      bool       `json:"isService"`
 
 	Status *Status `json:"status"`
 }
@@ -28,6 +31,13 @@ func (u *User) ToDB() *db.User {
 		Login:          u.Login,
 		LastActivityAt: u.LastActivityAt,
 		StatusID:       u.StatusID,
+		RoleIDs:        u.RoleIDs,
+		RecoveryCodes:  []string{},
+		IsService:      u.IsService,
+	}
+
+	if user.RoleIDs == nil {
+		user.RoleIDs = []int{}
 	}
 
 	return user
@@ -37,6 +47,8 @@ type UserSearch struct {
 	ID                 *int       `json:"id"`
 	Login              *string    `json:"login" validate:"max=64"`
 	StatusID           *int       `json:"statusId" validate:"status"`
+	RoleID             *int       `json:"roleId"`
+	IsService          *bool      `json:"isService"`
 	LastActivityAtFrom *time.Time `json:"lastActivityAtFrom"`
 	LastActivityAtTo   *time.Time `json:"lastActivityAtTo"`
 	IDs                []int      `json:"ids"`
@@ -52,6 +64,8 @@ func (us *UserSearch) ToDB() *db.UserSearch {
 		ID:                 us.ID,
 		LoginILike:         us.Login,
 		StatusID:           us.StatusID,
+		RoleID:             us.RoleID,
+		IsService:          us.IsService,
 		LastActivityAtFrom: us.LastActivityAtFrom,
 		LastActivityAtTo:   us.LastActivityAtTo,
 		IDs:                us.IDs,
@@ -64,6 +78,9 @@ type UserSummary struct {
 	CreatedAt      time.Time  `json:"createdAt"`
 	Login          string     `json:"login"`
 	LastActivityAt *time.Time `json:"lastActivityAt"`
+	RoleIDs        []int      `json:"roleIds"`
+	IsTOTPEnabled  bool       `json:"isTotpEnabled"`
+	IsService      bool       `json:"isService"`
 
 	Status *Status `json:"status"`
 }
@@ -74,4 +91,191 @@ type UserProfile struct {
 	Login          string     `json:"login"`
 	LastActivityAt *time.Time `json:"lastActivityAt"`
 	StatusID       int        `json:"statusId"`
+	RoleIDs        []int      `json:"roleIds"`
+	Permissions    []string   `json:"permissions"`
+	IsTOTPEnabled  bool       `json:"isTotpEnabled"`
+	IsTOTPRequired bool       `json:"isTotpRequired"`
+}
+
+type Role struct {
+	ID             int      `json:"id"`
+	Title          string   `json:"title" validate:"required,max=255"`
+	Permissions    []string `json:"permissions"`
+	IsTOTPRequired bool     `json:"isTotpRequired"`
+	StatusID       int      `json:"statusId" validate:"required,status"`
+
+	Status *Status `json:"status"`
+}
+
+func (r *Role) ToDB() *db.Role {
+	if r == nil {
+		return nil
+	}
+
+	role := &db.Role{
+		ID:             r.ID,
+		Title:          r.Title,
+		Permissions:    r.Permissions,
+		IsTOTPRequired: r.IsTOTPRequired,
+		StatusID:       r.StatusID,
+	}
+
+	if role.Permissions == nil {
+		role.Permissions = []string{}
+	}
+
+	return role
+}
+
+type RoleSearch struct {
+	ID       *int    `json:"id"`
+	Title    *string `json:"title"`
+	StatusID *int    `json:"statusId" validate:"status"`
+	IDs      []int   `json:"ids"`
+}
+
+func (rs *RoleSearch) ToDB() *db.RoleSearch {
+	if rs == nil {
+		return nil
+	}
+
+	return &db.RoleSearch{
+		ID:         rs.ID,
+		TitleILike: rs.Title,
+		StatusID:   rs.StatusID,
+		IDs:        rs.IDs,
+	}
+}
+
+type Session struct {
+	ID             int       `json:"id"`
+	IP             string    `json:"ip"`
+	UserAgent      string    `json:"userAgent"`
+	Device         string    `json:"device"`
+	CreatedAt      time.Time `json:"createdAt"`
+	LastActivityAt time.Time `json:"lastActivityAt"`
+	ExpiresAt      time.Time `json:"expiresAt"`
+	IsCurrent      bool      `json:"isCurrent"`
+}
+
+type LoginAttempt struct {
+	ID        int       `json:"id"`
+	Login     string    `json:"login"`
+	UserID    *int      `json:"userId"`
+	IP        string    `json:"ip"`
+	UserAgent string    `json:"userAgent"`
+	IsSuccess bool      `json:"isSuccess"`
+	Reason    *string   `json:"reason"`
+	IsCleared bool      `json:"isCleared"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type LoginAttemptSearch struct {
+	Login         *string    `json:"login"`
+	UserID        *int       `json:"userId"`
+	IP            *string    `json:"ip"`
+	IsSuccess     *bool      `json:"isSuccess"`
+	CreatedAtFrom *time.Time `json:"createdAtFrom"`
+	CreatedAtTo   *time.Time `json:"createdAtTo"`
+}
+
+func (las *LoginAttemptSearch) ToDB() *db.LoginAttemptSearch {
+	if las == nil {
+		return nil
+	}
+
+	return &db.LoginAttemptSearch{
+		LoginILike:    las.Login,
+		UserID:        las.UserID,
+		IP:            las.IP,
+		IsSuccess:     las.IsSuccess,
+		CreatedAtFrom: las.CreatedAtFrom,
+		CreatedAtTo:   las.CreatedAtTo,
+	}
+}
+
+// LoginResult is a result of login: authentication key or two-factor authentication challenge.
+// RecoveryCodes are returned once, if two-factor authentication was enrolled during login.
+type LoginResult struct {
+	AuthKey       *string         `json:"authKey"`
+	Challenge     *LoginChallenge `json:"challenge"`
+	RecoveryCodes []string        `json:"recoveryCodes,omitempty"`
+}
+
+// LoginChallenge is a short-lived token of the second login step, IsEnrollRequired means that user must enroll TOTP first.
+type LoginChallenge struct {
+	Token            string    `json:"token"`
+	ExpiresAt        time.Time `json:"expiresAt"`
+	IsEnrollRequired bool      `json:"isEnrollRequired"`
+}
+
+// TOTPEnrollment is a TOTP secret and its provisioning URI for authenticator apps.
+type TOTPEnrollment struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+type APIKey struct {
+	ID          int        `json:"id"`
+	UserID      int        `json:"userId" validate:"required"`
+	Title       string     `json:"title" validate:"required,max=255"`
+	Prefix      string     `json:"prefix"`
+	Permissions []string   `json:"permissions"`
+	ExpiresAt   *time.Time `json:"expiresAt"`
+	LastUsedAt  *time.Time `json:"lastUsedAt"`
+	LastUsedIP  *string    `json:"lastUsedIp"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	StatusID    int        `json:"statusId" validate:"required,status"`
+
+	User   *UserSummary `json:"user"`
+	Status *Status      `json:"status"`
+}
+
+func (k *APIKey) ToDB() *db.APIKey {
+	if k == nil {
+		return nil
+	}
+
+	key := &db.APIKey{
+		ID:          k.ID,
+		UserID:      k.UserID,
+		Title:       k.Title,
+		Permissions: k.Permissions,
+		ExpiresAt:   k.ExpiresAt,
+		StatusID:    k.StatusID,
+	}
+
+	if key.Permissions == nil {
+		key.Permissions = []string{}
+	}
+
+	return key
+}
+
+type APIKeySearch struct {
+	ID       *int    `json:"id"`
+	UserID   *int    `json:"userId"`
+	Title    *string `json:"title"`
+	StatusID *int    `json:"statusId" validate:"status"`
+	IDs      []int   `json:"ids"`
+}
+
+func (ks *APIKeySearch) ToDB() *db.APIKeySearch {
+	if ks == nil {
+		return nil
+	}
+
+	return &db.APIKeySearch{
+		ID:         ks.ID,
+		UserID:     ks.UserID,
+		TitleILike: ks.Title,
+		StatusID:   ks.StatusID,
+		IDs:        ks.IDs,
+	}
+}
+
+// APIKeyToken is a created API key with its token, the token is shown only once.
+type APIKeyToken struct {
+	APIKey APIKey `json:"apiKey"`
+	Token  string `json:"token"`
 }
@@ -2,15 +2,15 @@ package vt
 
 import (
 	"context"
-	"crypto/md5"
-	"fmt"
-	"math/rand"
 	"net/http"
+	"slices"
 	"time"
 
 	"apisrv/pkg/db"
 
+	"github.com/go-pg/pg/v10"
 	"github.com/vmkteam/embedlog"
+	zm "github.com/vmkteam/zenrpc-middleware"
 	"github.com/vmkteam/zenrpc/v2"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -20,6 +20,7 @@ type AuthService struct {
 	embedlog.Logger
 
 	commonRepo db.CommonRepo
+	guard      *LoginGuard
 }
 
 var (
@@ -29,80 +30,116 @@ var (
 func NewAuthService(dbo db.DB, logger embedlog.Logger) *AuthService {
 	return &AuthService{
 		commonRepo: db.NewCommonRepo(dbo),
+		guard:      NewLoginGuard(dbo, LoginConfig{}),
 		Logger:     logger,
 	}
 }
 
-// Login authenticates user.
+// Login authenticates user and starts a new session, other user sessions stay active.
+// If user has to pass two-factor authentication, a challenge for LoginTOTP is returned instead of authentication key.
+// Attempts after several failures are delayed progressively and login or IP is locked temporarily after too many failures.
 //
 //zenrpc:login User login
 //zenrpc:password User password
-//zenrpc:remember Remember for week
-//zenrpc:return User authentication key
+//zenrpc:remember Remember for 30 days
+//zenrpc:return User authentication key or two-factor authentication challenge
 //zenrpc:400 Invalid login or password
+//zenrpc:429 Too many login attempts
 //zenrpc:500 Internal Error
-func (s AuthService) Login(ctx context.Context, login, password string, remember bool) (string, error) {
+func (s AuthService) Login(ctx context.Context, login, password string, remember bool) (*LoginResult, error) {
 	if login == "" || password == "" {
-		return "", errInvalidLoginPassword
+		return nil, errInvalidLoginPassword
 	}
+
+	attempt := s.newAttempt(ctx, login)
+	if err := s.checkAttempt(ctx, attempt); err != nil {
+		return nil, err
+	}
+
 	dbu, err := s.commonRepo.EnabledUserByLogin(ctx, login)
 	if err != nil {
-		return "", InternalError(err)
-	} else if dbu == nil {
-		return "", errInvalidLoginPassword
+		return nil, InternalError(err)
 	}
 
-	if ok := s.checkHash(password, dbu.Password); !ok {
-		return "", errInvalidLoginPassword
+	// service accounts use API keys only
+	if dbu == nil || dbu.IsService || !s.checkHash(password, dbu.Password) {
+		if dbu != nil {
+			attempt.UserID = &dbu.ID
+		}
+		s.recordFailure(ctx, attempt)
+		return nil, errInvalidLoginPassword
 	}
 
-	if ok, err := s.commonRepo.AuthenticateUser(ctx, dbu, s.generateAuthKey(dbu, remember)); err != nil || !ok {
-		return "", InternalError(err)
+	isRequired, err := s.guard.isTOTPRequired(ctx, dbu)
+	if err != nil {
+		return nil, InternalError(err)
 	}
 
-	return dbu.AuthKey, nil
+	// second step is required, it is checked and recorded separately
+	if dbu.IsTOTPEnabled || isRequired {
+		s.releaseAttempt(ctx, attempt)
+		return &LoginResult{Challenge: newLoginChallenge(dbu, remember, time.Now())}, nil
+	}
+
+	token, err := s.completeLogin(ctx, dbu, remember, attempt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginResult{AuthKey: &token}, nil
 }
 
-// Logout current user from every session
+// Logout current user from the current session
 //
 //zenrpc:return Successful logout
 //zenrpc:401 Invalid authentication credentials
 //zenrpc:500 Internal Error
 func (s AuthService) Logout(ctx context.Context) (bool, error) {
-	user := UserFromContext(ctx)
-	if user == nil {
+	session := SessionFromContext(ctx)
+	if session == nil {
 		return false, ErrUnauthorized
 	}
 
-	if ok, err := s.commonRepo.AuthenticateUser(ctx, user, ""); err != nil || !ok {
+	if _, err := s.commonRepo.DeleteSession(ctx, session.ID); err != nil {
 		return false, InternalError(err)
 	}
 
 	return true, nil
 }
 
-// Profile is a function that returns current user profile
+// Profile is a function that returns current user profile with effective permissions
 //
 //zenrpc:return UserProfile
 //zenrpc:401 Invalid authentication credentials
+//zenrpc:500 Internal Error
 func (s AuthService) Profile(ctx context.Context) (*UserProfile, error) {
 	user := UserFromContext(ctx)
 	if user == nil {
 		return nil, ErrUnauthorized
 	}
 
-	return NewUserProfile(user), nil
+	permissions, err := userPermissions(ctx, &s.commonRepo, user)
+	if err != nil {
+		return nil, InternalError(err)
+	}
+
+	isTOTPRequired, err := s.guard.isTOTPRequired(ctx, user)
+	if err != nil {
+		return nil, InternalError(err)
+	}
+
+	return NewUserProfile(user, permissions, isTOTPRequired), nil
 }
 
-// ChangePassword changes current user password.
+// ChangePassword changes current user password, revokes all user sessions and starts a new one.
 //
 //zenrpc:password New user password
 //zenrpc:return New user authentication key
 //zenrpc:401 Invalid authentication credentials
 //zenrpc:500 Internal Error
 func (s AuthService) ChangePassword(ctx context.Context, password string) (string, error) {
-	user := UserFromContext(ctx)
-	if user == nil {
+	user, session := UserFromContext(ctx), SessionFromContext(ctx)
+	if user == nil || session == nil {
 		return "", ErrUnauthorized
 	}
 
@@ -111,18 +148,113 @@ func (s AuthService) ChangePassword(ctx context.Context, password string) (strin
 		return "", InternalError(err)
 	}
 	user.Password = p
-	user.AuthKey = s.generateAuthKey(user, false)
 
 	if ok, err := s.commonRepo.UpdateUserPassword(ctx, user); err != nil || !ok {
 		return "", InternalError(err)
 	}
-	return user.AuthKey, nil
+
+	if _, err = s.commonRepo.DeleteUserSessions(ctx, user.ID, 0); err != nil {
+		return "", InternalError(err)
+	}
+
+	// new session keeps remember flag of the current one
+	remember := session.IdleTimeout == int(rememberIdleTimeout.Seconds())
+	token, err := s.startSession(ctx, user, remember)
+	if err != nil {
+		return "", InternalError(err)
+	}
+
+	return token, nil
 }
 
 // VfsAuthToken get auth token for VFS requests
 func (s AuthService) VfsAuthToken(ctx context.Context) (string, error) {
+	req, ok := zenrpc.RequestFromContext(ctx)
+	if !ok || SessionFromContext(ctx) == nil {
+		return "", ErrUnauthorized
+	}
+
+	return req.Header.Get(AuthKey), nil
+}
+
+// Sessions returns active sessions of current user, latest first.
+//
+//zenrpc:return []Session
+//zenrpc:401 Invalid authentication credentials
+//zenrpc:500 Internal Error
+func (s AuthService) Sessions(ctx context.Context) ([]Session, error) {
+	user, current := UserFromContext(ctx), SessionFromContext(ctx)
+	if user == nil || current == nil {
+		return nil, ErrUnauthorized
+	}
+
+	if err := s.commonRepo.DeleteExpiredSessions(ctx, user.ID); err != nil {
+		return nil, InternalError(err)
+	}
+
+	list, err := s.commonRepo.SessionsByFilters(ctx, &db.SessionSearch{UserID: &user.ID}, db.PagerNoLimit, s.commonRepo.DefaultSessionSort())
+	if err != nil {
+		return nil, InternalError(err)
+	}
+
+	sessions := make([]Session, 0, len(list))
+	for i := range list {
+		if session := NewSession(&list[i], current.ID); session != nil {
+			sessions = append(sessions, *session)
+		}
+	}
+	return sessions, nil
+}
+
+// RevokeSession revokes the session of current user by its ID. Revoking the current session is the same as Logout.
+//
+//zenrpc:id Session ID
+//zenrpc:return isRevoked
+//zenrpc:401 Invalid authentication credentials
+//zenrpc:404 Not Found
+//zenrpc:500 Internal Error
+func (s AuthService) RevokeSession(ctx context.Context, id int) (bool, error) {
 	user := UserFromContext(ctx)
-	return user.AuthKey, nil
+	if user == nil {
+		return false, ErrUnauthorized
+	}
+
+	session, err := s.commonRepo.OneSession(ctx, &db.SessionSearch{ID: &id, UserID: &user.ID})
+	if err != nil {
+		return false, InternalError(err)
+	} else if session == nil {
+		return false, ErrNotFound
+	}
+
+	ok, err := s.commonRepo.DeleteSession(ctx, session.ID)
+	if err != nil {
+		return false, InternalError(err)
+	}
+	return ok, nil
+}
+
+// RevokeAllSessions revokes all sessions of current user, the current session is kept if exceptCurrent is set.
+//
+//zenrpc:exceptCurrent Keep the current session
+//zenrpc:return Number of revoked sessions
+//zenrpc:401 Invalid authentication credentials
+//zenrpc:500 Internal Error
+func (s AuthService) RevokeAllSessions(ctx context.Context, exceptCurrent bool) (int, error) {
+	user, current := UserFromContext(ctx), SessionFromContext(ctx)
+	if user == nil || current == nil {
+		return 0, ErrUnauthorized
+	}
+
+	var exceptID int
+	if exceptCurrent {
+		exceptID = current.ID
+	}
+
+	count, err := s.commonRepo.DeleteUserSessions(ctx, user.ID, exceptID)
+	if err != nil {
+		return 0, InternalError(err)
+	}
+	return count, nil
 }
 
 func (s AuthService) checkHash(password, hash string) bool {
@@ -130,22 +262,79 @@ func (s AuthService) checkHash(password, hash string) bool {
 	return err == nil
 }
 
-func (s AuthService) generateAuthKey(u *db.User, remember bool) string {
-	if remember {
-		// valid only on current week
-		key := fmt.Sprintf("%s:%s:%d:%d", u.Login, u.Password, u.ID, time.Now().Weekday())
-		return fmt.Sprintf("%x", md5.Sum([]byte(key)))
+// newAttempt returns login attempt with request metadata.
+func (s AuthService) newAttempt(ctx context.Context, login string) *db.LoginAttempt {
+	return &db.LoginAttempt{Login: login, IP: zm.IPFromContext(ctx), UserAgent: zm.UserAgentFromContext(ctx)}
+}
+
+// checkAttempt returns 429 error and records the attempt, if the login or IP is delayed or locked,
+// otherwise the attempt is reserved as a failure until it is recorded or released.
+func (s AuthService) checkAttempt(ctx context.Context, attempt *db.LoginAttempt) error {
+	reason, retry, err := s.guard.Reserve(ctx, attempt, time.Now())
+	if err != nil {
+		return InternalError(err)
+	} else if reason == "" {
+		return nil
 	}
-	return s.generateRandom(32)
+
+	attempt.Reason = &reason
+	s.recordAttempt(ctx, attempt)
+
+	return newLoginRetryError(reason, retry)
+}
+
+// recordFailure records failed attempt with invalid credentials.
+func (s AuthService) recordFailure(ctx context.Context, attempt *db.LoginAttempt) {
+	reason := db.LoginReasonInvalid
+	attempt.Reason = &reason
+	s.recordAttempt(ctx, attempt)
 }
 
-func (s AuthService) generateRandom(length int) string {
-	var letterRunes = []rune("0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")
-	b := make([]rune, length)
-	for i := range b {
-		b[i] = letterRunes[rand.Intn(len(letterRunes))]
+// releaseAttempt removes reserved attempt, which is neither a success nor a failure, errors are only logged.
+func (s AuthService) releaseAttempt(ctx context.Context, attempt *db.LoginAttempt) {
+	if err := s.guard.Release(ctx, attempt); err != nil {
+		s.Error(ctx, "release login attempt", "login", attempt.Login, "err", err)
 	}
-	return string(b)
+}
+
+// recordAttempt saves login attempt to history, errors are only logged.
+func (s AuthService) recordAttempt(ctx context.Context, attempt *db.LoginAttempt) {
+	if err := s.guard.Record(ctx, attempt); err != nil {
+		s.Error(ctx, "record login attempt", "login", attempt.Login, "err", err)
+	}
+}
+
+// completeLogin deletes expired user sessions, starts a new one and records successful attempt.
+func (s AuthService) completeLogin(ctx context.Context, u *db.User, remember bool, attempt *db.LoginAttempt) (string, error) {
+	if err := s.commonRepo.DeleteExpiredSessions(ctx, u.ID); err != nil {
+		return "", InternalError(err)
+	}
+
+	token, err := s.startSession(ctx, u, remember)
+	if err != nil {
+		return "", InternalError(err)
+	}
+
+	attempt.UserID, attempt.IsSuccess = &u.ID, true
+	s.recordAttempt(ctx, attempt)
+
+	return token, nil
+}
+
+// startSession adds a new user session with request metadata and returns its token.
+func (s AuthService) startSession(ctx context.Context, u *db.User, remember bool) (string, error) {
+	token := newSessionToken()
+	now := time.Now()
+
+	if _, err := s.commonRepo.AddSession(ctx, newSession(u.ID, token, remember, zm.IPFromContext(ctx), zm.UserAgentFromContext(ctx), now)); err != nil {
+		return "", err
+	}
+
+	if _, err := s.commonRepo.UpdateUserActivity(ctx, u); err != nil {
+		return "", err
+	}
+
+	return token, nil
 }
 
 func passwordHash(password string) (string, error) {
@@ -157,11 +346,13 @@ type UserService struct {
 	zenrpc.Service
 	embedlog.Logger
 
+	db         db.DB
 	commonRepo db.CommonRepo
 }
 
 func NewUserService(dbo db.DB, logger embedlog.Logger) *UserService {
 	return &UserService{
+		db:         dbo,
 		commonRepo: db.NewCommonRepo(dbo),
 		Logger:     logger,
 	}
@@ -244,12 +435,23 @@ func (s UserService) byID(ctx context.Context, id int) (*db.User, error) {
 //zenrpc:return User
 //zenrpc:500 Internal Error
 //zenrpc:400 Validation Error
+//zenrpc:403 Roles With Permissions, Which Current User Doesn't Have
 func (s UserService) Add(ctx context.Context, user User) (*User, error) {
 	if ve := s.isValid(ctx, user, false); ve.HasErrors() {
 		return nil, ve.Error()
 	}
 
-	p, err := passwordHash(user.Password)
+	if err := s.checkRoles(ctx, user.RoleIDs); err != nil {
+		return nil, err
+	}
+
+	// service account without password gets a random one, it can't log in anyway
+	password := user.Password
+	if password == "" && user.IsService {
+		password = newSessionToken()
+	}
+
+	p, err := passwordHash(password)
 	if err != nil {
 		return nil, InternalError(err)
 	}
@@ -270,6 +472,7 @@ func (s UserService) Add(ctx context.Context, user User) (*User, error) {
 //zenrpc:return User
 //zenrpc:500 Internal Error
 //zenrpc:400 Validation Error
+//zenrpc:403 User Or Roles With Permissions, Which Current User Doesn't Have
 //zenrpc:404 Not Found
 func (s UserService) Update(ctx context.Context, user User) (bool, error) {
 	orig, err := s.byID(ctx, user.ID)
@@ -281,9 +484,14 @@ func (s UserService) Update(ctx context.Context, user User) (bool, error) {
 		return false, ve.Error()
 	}
 
+	// current user must have all permissions of the user before and after update
+	if err = s.checkRoles(ctx, slices.Concat(orig.RoleIDs, user.RoleIDs)); err != nil {
+		return false, err
+	}
+
 	cur := user.ToDB()
 	cur.Password = orig.Password
-	cur.AuthKey = orig.AuthKey
+	cur.TOTPSecret, cur.IsTOTPEnabled, cur.TOTPCounter, cur.RecoveryCodes = orig.TOTPSecret, orig.IsTOTPEnabled, orig.TOTPCounter, orig.RecoveryCodes
 
 	if user.Password != "" {
 		p, er := passwordHash(user.Password)
@@ -291,13 +499,20 @@ func (s UserService) Update(ctx context.Context, user User) (bool, error) {
 			return false, InternalError(er)
 		}
 		cur.Password = p
-		cur.AuthKey = ""
 	}
 
 	ok, err := s.commonRepo.UpdateUser(ctx, cur)
 	if err != nil {
 		return false, InternalError(err)
 	}
+
+	// password change revokes all user sessions
+	if user.Password != "" {
+		if _, err = s.commonRepo.DeleteUserSessions(ctx, user.ID, 0); err != nil {
+			return false, InternalError(err)
+		}
+	}
+
 	return ok, nil
 }
 
@@ -307,9 +522,15 @@ func (s UserService) Update(ctx context.Context, user User) (bool, error) {
 //zenrpc:return isDeleted
 //zenrpc:500 Internal Error
 //zenrpc:400 Validation Error
+//zenrpc:403 User With Permissions, Which Current User Doesn't Have
 //zenrpc:404 Not Found
 func (s UserService) Delete(ctx context.Context, id int) (bool, error) {
-	if _, err := s.byID(ctx, id); err != nil {
+	user, err := s.byID(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	if err = s.checkUsers(ctx, *user); err != nil {
 		return false, err
 	}
 
@@ -317,9 +538,217 @@ func (s UserService) Delete(ctx context.Context, id int) (bool, error) {
 	if err != nil {
 		return false, InternalError(err)
 	}
+
+	if _, err = s.commonRepo.DeleteUserSessions(ctx, id, 0); err != nil {
+		return false, InternalError(err)
+	}
 	return ok, err
 }
 
+// UpdateStatus sets status of the Users by their IDs in a single transaction.
+//
+//zenrpc:statusUpdate StatusUpdate
+//zenrpc:return []BulkResult
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+//zenrpc:403 User With Permissions, Which Current User Doesn't Have
+func (s UserService) UpdateStatus(ctx context.Context, statusUpdate StatusUpdate) ([]BulkResult, error) {
+	if ve := isValidStatusUpdate(ctx, statusUpdate); ve.HasErrors() {
+		return nil, ve.Error()
+	}
+
+	list, err := s.byIDs(ctx, statusUpdate.ObjectIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.checkUsers(ctx, list...); err != nil {
+		return nil, err
+	}
+
+	results := make([]BulkResult, 0, len(list))
+	err = s.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		repo := s.commonRepo.WithTransaction(tx)
+		for i := range list {
+			user := &list[i]
+			isChanged := user.StatusID != statusUpdate.StatusID
+			results = append(results, BulkResult{ID: user.ID, IsChanged: isChanged})
+			if !isChanged {
+				continue
+			}
+
+			user.StatusID = statusUpdate.StatusID
+			if _, err := repo.UpdateUser(ctx, user, db.WithColumns(db.Columns.User.StatusID)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, InternalError(err)
+	}
+	return results, nil
+}
+
+// DeleteMany deletes the Users by their IDs and their sessions in a single transaction.
+//
+//zenrpc:ids []int
+//zenrpc:return []BulkResult
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+//zenrpc:403 User With Permissions, Which Current User Doesn't Have
+func (s UserService) DeleteMany(ctx context.Context, ids []int) ([]BulkResult, error) {
+	list, err := s.byIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.checkUsers(ctx, list...); err != nil {
+		return nil, err
+	}
+
+	results := make([]BulkResult, 0, len(list))
+	err = s.db.RunInTransaction(ctx, func(tx *pg.Tx) error {
+		repo := s.commonRepo.WithTransaction(tx)
+		for i := range list {
+			id := list[i].ID
+			ok, err := repo.DeleteUser(ctx, id)
+			if err != nil {
+				return err
+			}
+			results = append(results, BulkResult{ID: id, IsChanged: ok})
+
+			if _, err = repo.DeleteUserSessions(ctx, id, 0); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, InternalError(err)
+	}
+	return results, nil
+}
+
+// byIDs returns Users in order of unique ids or validation error, if some of them are not found.
+func (s UserService) byIDs(ctx context.Context, ids []int) ([]db.User, error) {
+	return bulkList(ids, func(ids []int) ([]db.User, error) {
+		return s.commonRepo.UsersByFilters(ctx, &db.UserSearch{IDs: ids}, db.PagerNoLimit)
+	}, func(dto *db.User) int { return dto.ID })
+}
+
+// CountLoginAttempts returns count of login history records according to conditions in search params.
+//
+//zenrpc:search LoginAttemptSearch
+//zenrpc:return int
+//zenrpc:500 Internal Error
+func (s UserService) CountLoginAttempts(ctx context.Context, search *LoginAttemptSearch) (int, error) {
+	count, err := s.commonRepo.CountLoginAttempts(ctx, search.ToDB())
+	if err != nil {
+		return 0, InternalError(err)
+	}
+	return count, nil
+}
+
+// GetLoginAttempts returns а login history according to conditions in search params, latest first.
+//
+//zenrpc:search LoginAttemptSearch
+//zenrpc:viewOps ViewOps
+//zenrpc:return []LoginAttempt
+//zenrpc:500 Internal Error
+func (s UserService) GetLoginAttempts(ctx context.Context, search *LoginAttemptSearch, viewOps *ViewOps) ([]LoginAttempt, error) {
+	list, err := s.commonRepo.LoginAttemptsByFilters(ctx, search.ToDB(), viewOps.Pager(), s.commonRepo.DefaultLoginAttemptSort())
+	if err != nil {
+		return nil, InternalError(err)
+	}
+	attempts := make([]LoginAttempt, 0, len(list))
+	for i := range list {
+		if attempt := NewLoginAttempt(&list[i]); attempt != nil {
+			attempts = append(attempts, *attempt)
+		}
+	}
+	return attempts, nil
+}
+
+// Unlock clears failed login attempts of the login and/or the IP, so they are not delayed or locked anymore.
+//
+//zenrpc:login User login
+//zenrpc:ip IP address
+//zenrpc:return Number of cleared failed attempts
+//zenrpc:400 Validation Error
+//zenrpc:500 Internal Error
+func (s UserService) Unlock(ctx context.Context, login, ip string) (int, error) {
+	if login == "" && ip == "" {
+		var v Validator
+		v.Append("login", FieldErrorRequired)
+		return 0, v.Error()
+	}
+
+	var total int
+	filters := []struct{ column, value string }{
+		{db.Columns.LoginAttempt.Login, login},
+		{db.Columns.LoginAttempt.IP, ip},
+	}
+
+	for _, f := range filters {
+		if f.value == "" {
+			continue
+		}
+
+		count, err := s.commonRepo.ClearFailedLogins(ctx, f.column, f.value)
+		if err != nil {
+			return 0, InternalError(err)
+		}
+		total += count
+	}
+
+	return total, nil
+}
+
+// ResetTOTP disables two-factor authentication of the User and removes its secret and recovery codes, e.g. after device loss.
+//
+//zenrpc:id int
+//zenrpc:return isReset
+//zenrpc:500 Internal Error
+//zenrpc:403 User With Permissions, Which Current User Doesn't Have
+//zenrpc:404 Not Found
+func (s UserService) ResetTOTP(ctx context.Context, id int) (bool, error) {
+	user, err := s.byID(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	if err = s.checkUsers(ctx, *user); err != nil {
+		return false, err
+	}
+
+	resetTOTP(user)
+	ok, err := s.commonRepo.UpdateUserTOTP(ctx, user)
+	if err != nil {
+		return false, InternalError(err)
+	}
+	return ok, nil
+}
+
+// Permissions returns effective permissions of the User granted by its enabled roles.
+//
+//zenrpc:id int
+//zenrpc:return []string
+//zenrpc:500 Internal Error
+//zenrpc:404 Not Found
+func (s UserService) Permissions(ctx context.Context, id int) ([]string, error) {
+	user, err := s.byID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := userPermissions(ctx, &s.commonRepo, user)
+	if err != nil {
+		return nil, InternalError(err)
+	}
+	return permissions, nil
+}
+
 // Validate Verifies that User data is valid.
 //
 //zenrpc:user User
@@ -342,6 +771,27 @@ func (s UserService) Validate(ctx context.Context, user User) ([]FieldError, err
 	return ve.Fields(), nil
 }
 
+// checkRoles returns ErrForbidden, if current user doesn't have all permissions of roles, which are assigned or unassigned.
+func (s UserService) checkRoles(ctx context.Context, roleIDs []int) error {
+	permissions, err := rolesPermissions(ctx, &s.commonRepo, roleIDs)
+	if err != nil {
+		return InternalError(err)
+	}
+
+	return checkGrant(ctx, &s.commonRepo, permissions)
+}
+
+// checkUsers returns ErrForbidden, if current user doesn't have all permissions of users, so their passwords,
+// statuses and two-factor authentication could not be changed to take over accounts with more rights.
+func (s UserService) checkUsers(ctx context.Context, users ...db.User) error {
+	var roleIDs []int
+	for _, u := range users {
+		roleIDs = append(roleIDs, u.RoleIDs...)
+	}
+
+	return s.checkRoles(ctx, roleIDs)
+}
+
 func (s UserService) isValid(ctx context.Context, user User, isUpdate bool) Validator {
 	var v Validator
 
@@ -357,10 +807,216 @@ func (s UserService) isValid(ctx context.Context, user User, isUpdate bool) Vali
 		v.Append("login", FieldErrorUnique)
 	}
 
-	// check empty password for add
-	if !isUpdate && user.Password == "" {
+	// check empty password for add, service account doesn't need it
+	if !isUpdate && !user.IsService && user.Password == "" {
 		v.Append("password", FieldErrorRequired)
 	}
 
+	// check roles exist
+	if len(user.RoleIDs) > 0 {
+		roleIDs := slices.Compact(slices.Sorted(slices.Values(user.RoleIDs)))
+		count, err := s.commonRepo.CountRoles(ctx, &db.RoleSearch{IDs: roleIDs})
+		if err != nil {
+			v.SetInternalError(err)
+		} else if count != len(roleIDs) {
+			v.Append("roleIds", FieldErrorIncorrect)
+		}
+	}
+
+	return v
+}
+
+type RoleService struct {
+	zenrpc.Service
+	embedlog.Logger
+
+	commonRepo db.CommonRepo
+}
+
+func NewRoleService(dbo db.DB, logger embedlog.Logger) *RoleService {
+	return &RoleService{
+		commonRepo: db.NewCommonRepo(dbo),
+		Logger:     logger,
+	}
+}
+
+func (s RoleService) dbSort(ops *ViewOps) db.OpFunc {
+	v := s.commonRepo.DefaultRoleSort()
+	if ops == nil {
+		return v
+	}
+
+	switch ops.SortColumn {
+	case db.Columns.Role.ID, db.Columns.Role.Title, db.Columns.Role.StatusID:
+		v = db.WithSort(db.NewSortField(ops.SortColumn, ops.SortDesc))
+	}
+
+	return v
+}
+
+// Count Roles according to conditions in search params
+//
+//zenrpc:search RoleSearch
+//zenrpc:return int
+//zenrpc:500 Internal Error
+func (s RoleService) Count(ctx context.Context, search *RoleSearch) (int, error) {
+	count, err := s.commonRepo.CountRoles(ctx, search.ToDB())
+	if err != nil {
+		return 0, InternalError(err)
+	}
+	return count, nil
+}
+
+// Get а list of Roles according to conditions in search params
+//
+//zenrpc:search RoleSearch
+//zenrpc:viewOps ViewOps
+//zenrpc:return []Role
+//zenrpc:500 Internal Error
+func (s RoleService) Get(ctx context.Context, search *RoleSearch, viewOps *ViewOps) ([]Role, error) {
+	list, err := s.commonRepo.RolesByFilters(ctx, search.ToDB(), viewOps.Pager(), s.dbSort(viewOps), s.commonRepo.FullRole())
+	if err != nil {
+		return nil, InternalError(err)
+	}
+	roles := make([]Role, 0, len(list))
+	for i := range list {
+		if role := NewRole(&list[i]); role != nil {
+			roles = append(roles, *role)
+		}
+	}
+	return roles, nil
+}
+
+// GetByID returns a Role by its ID.
+//
+//zenrpc:id int
+//zenrpc:return Role
+//zenrpc:500 Internal Error
+//zenrpc:404 Not Found
+func (s RoleService) GetByID(ctx context.Context, id int) (*Role, error) {
+	db, err := s.byID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return NewRole(db), nil
+}
+
+func (s RoleService) byID(ctx context.Context, id int) (*db.Role, error) {
+	db, err := s.commonRepo.RoleByID(ctx, id, s.commonRepo.FullRole())
+	if err != nil {
+		return nil, InternalError(err)
+	} else if db == nil {
+		return nil, ErrNotFound
+	}
+	return db, nil
+}
+
+// Add a Role from the query
+//
+//zenrpc:role Role
+//zenrpc:return Role
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+//zenrpc:403 Permissions, Which Current User Doesn't Have
+func (s RoleService) Add(ctx context.Context, role Role) (*Role, error) {
+	if ve := s.isValid(ctx, role); ve.HasErrors() {
+		return nil, ve.Error()
+	}
+
+	if err := checkGrant(ctx, &s.commonRepo, role.Permissions); err != nil {
+		return nil, err
+	}
+
+	db, err := s.commonRepo.AddRole(ctx, role.ToDB())
+	if err != nil {
+		return nil, InternalError(err)
+	}
+	return NewRole(db), nil
+}
+
+// Update updates the Role data identified by id from the query
+//
+//zenrpc:role Role
+//zenrpc:return Role
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+//zenrpc:403 Permissions, Which Current User Doesn't Have
+//zenrpc:404 Not Found
+func (s RoleService) Update(ctx context.Context, role Role) (bool, error) {
+	orig, err := s.byID(ctx, role.ID)
+	if err != nil {
+		return false, err
+	}
+
+	if ve := s.isValid(ctx, role); ve.HasErrors() {
+		return false, ve.Error()
+	}
+
+	// role, which grants more than current user has, could not be edited
+	if err = checkGrant(ctx, &s.commonRepo, slices.Concat(orig.Permissions, role.Permissions)); err != nil {
+		return false, err
+	}
+
+	ok, err := s.commonRepo.UpdateRole(ctx, role.ToDB())
+	if err != nil {
+		return false, InternalError(err)
+	}
+	return ok, nil
+}
+
+// Delete deletes the Role by its ID. Users lose its permissions.
+//
+//zenrpc:id int
+//zenrpc:return isDeleted
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+//zenrpc:404 Not Found
+func (s RoleService) Delete(ctx context.Context, id int) (bool, error) {
+	if _, err := s.byID(ctx, id); err != nil {
+		return false, err
+	}
+
+	ok, err := s.commonRepo.DeleteRole(ctx, id)
+	if err != nil {
+		return false, InternalError(err)
+	}
+	return ok, err
+}
+
+// Validate Verifies that Role data is valid.
+//
+//zenrpc:role Role
+//zenrpc:return []FieldError
+//zenrpc:500 Internal Error
+func (s RoleService) Validate(ctx context.Context, role Role) ([]FieldError, error) {
+	if role.ID != 0 {
+		if _, err := s.byID(ctx, role.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	ve := s.isValid(ctx, role)
+	if ve.HasInternalError() {
+		return nil, ve.Error()
+	}
+
+	return ve.Fields(), nil
+}
+
+func (s RoleService) isValid(ctx context.Context, role Role) Validator {
+	var v Validator
+
+	if v.CheckBasic(ctx, role); v.HasInternalError() {
+		return v
+	}
+
+	// check permissions format: "*" or "namespace:action"
+	for _, p := range role.Permissions {
+		if !isValidPermission(p) {
+			v.Append("permissions", FieldErrorIncorrect)
+			break
+		}
+	}
+
 	return v
 }
@@ -3,11 +3,13 @@ package vt
 import (
 	"context"
 	"fmt"
+	"slices"
 	"testing"
 	"time"
 
 	"apisrv/pkg/db"
 	"apisrv/pkg/db/test"
+	"apisrv/pkg/webhook"
 
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -19,22 +21,72 @@ func TestDB_AuthService(t *testing.T) {
 		So(srv, ShouldNotBeNil)
 
 		Convey("Positive testing", func() {
-			Convey("Login method with remember password", func() {
-				authKey, err := srv.Login(ctx, "admin", "12345", true)
+			Convey("Login on several devices", func() {
+				res, err := srv.Login(ctx, "admin", "12345", true)
 				So(err, ShouldBeNil)
-				authKey2, err := srv.Login(ctx, "admin", "12345", true)
+				So(res.AuthKey, ShouldNotBeNil)
+				res2, err := srv.Login(ctx, "admin", "12345", true)
 				So(err, ShouldBeNil)
-				So(authKey, ShouldEqual, authKey2)
+				So(res2.AuthKey, ShouldNotBeNil)
+				authKey, authKey2 := *res.AuthKey, *res2.AuthKey
+				So(authKey, ShouldNotEqual, authKey2)
+
+				session, err := authenticate(ctx, &srv.commonRepo, authKey)
+				So(err, ShouldBeNil)
+				So(session, ShouldNotBeNil)
+
+				session2, err := authenticate(ctx, &srv.commonRepo, authKey2)
+				So(err, ShouldBeNil)
+				So(session2, ShouldNotBeNil)
+
+				userCtx := context.WithValue(context.WithValue(ctx, userKey, session.User), sessionKey, session)
+
+				Convey("List sessions", func() {
+					sessions, err := srv.Sessions(userCtx)
+					So(err, ShouldBeNil)
+					So(len(sessions), ShouldBeGreaterThanOrEqualTo, 2)
+				})
+
+				Convey("Revoke other session", func() {
+					ok, err := srv.RevokeSession(userCtx, session2.ID)
+					So(err, ShouldBeNil)
+					So(ok, ShouldBeTrue)
+
+					s, err := authenticate(ctx, &srv.commonRepo, authKey2)
+					So(err, ShouldBeNil)
+					So(s, ShouldBeNil)
+
+					s, err = authenticate(ctx, &srv.commonRepo, authKey)
+					So(err, ShouldBeNil)
+					So(s, ShouldNotBeNil)
+				})
+
+				Convey("Revoke all sessions except current", func() {
+					count, err := srv.RevokeAllSessions(userCtx, true)
+					So(err, ShouldBeNil)
+					So(count, ShouldBeGreaterThanOrEqualTo, 1)
+
+					s, err := authenticate(ctx, &srv.commonRepo, authKey)
+					So(err, ShouldBeNil)
+					So(s, ShouldNotBeNil)
+
+					s, err = authenticate(ctx, &srv.commonRepo, authKey2)
+					So(err, ShouldBeNil)
+					So(s, ShouldBeNil)
+				})
 			})
 
 			Convey("Login without remember password", func() {
-				authKey, err := srv.Login(ctx, "admin", "12345", false)
+				res, err := srv.Login(ctx, "admin", "12345", false)
 				So(err, ShouldBeNil)
+				So(res.AuthKey, ShouldNotBeNil)
+				authKey := *res.AuthKey
 				So(authKey, ShouldHaveLength, 32)
 
-				u, err := srv.commonRepo.EnabledUserByAuthKey(ctx, authKey)
+				session, err := authenticate(ctx, &srv.commonRepo, authKey)
 				So(err, ShouldBeNil)
-				userCtx := context.WithValue(ctx, userKey, u)
+				So(session, ShouldNotBeNil)
+				userCtx := context.WithValue(context.WithValue(ctx, userKey, session.User), sessionKey, session)
 
 				Convey("Get profile", func() {
 					user, err := srv.Profile(userCtx)
@@ -46,6 +98,10 @@ func TestDB_AuthService(t *testing.T) {
 					ok, err := srv.Logout(userCtx)
 					So(err, ShouldBeNil)
 					So(ok, ShouldBeTrue)
+
+					s, err := authenticate(ctx, &srv.commonRepo, authKey)
+					So(err, ShouldBeNil)
+					So(s, ShouldBeNil)
 				})
 			})
 		})
@@ -173,6 +229,318 @@ func TestDB_UserService(t *testing.T) {
 				So(err, ShouldNotBeNil)
 				So(u2, ShouldBeNil)
 			})
+
+			Convey("Grant roles and permissions, which current user doesn't have", func() {
+				dbo, logger := test.Setup(t)
+				roles := NewRoleService(dbo, logger)
+
+				// editor role is seeded by init.sql
+				editor := &db.User{ID: 1, RoleIDs: []int{2}}
+				editorCtx := context.WithValue(ctx, userKey, editor)
+
+				admin, err := srv.Add(editorCtx, User{Login: fmt.Sprintf("admin_%d", time.Now().UnixNano()), Password: "password", StatusID: db.StatusEnabled, RoleIDs: []int{1}})
+				So(err, ShouldEqual, ErrForbidden)
+				So(admin, ShouldBeNil)
+
+				role, err := roles.Add(editorCtx, Role{Title: "root", Permissions: []string{PermissionAll}, StatusID: db.StatusEnabled})
+				So(err, ShouldEqual, ErrForbidden)
+				So(role, ShouldBeNil)
+
+				role, err = roles.Add(editorCtx, Role{Title: "writer", Permissions: []string{"news:write"}, StatusID: db.StatusEnabled})
+				So(err, ShouldBeNil)
+				So(role, ShouldNotBeNil)
+
+				role.Permissions = append(role.Permissions, "user:write")
+				ok, err := roles.Update(editorCtx, *role)
+				So(err, ShouldEqual, ErrForbidden)
+				So(ok, ShouldBeFalse)
+
+				// editor can't take over admin account
+				adminUser, err := db.NewCommonRepo(dbo).OneUser(ctx, &db.UserSearch{Login: test.Ptr("admin")})
+				So(err, ShouldBeNil)
+				admin, err = srv.GetByID(ctx, adminUser.ID)
+				So(err, ShouldBeNil)
+
+				admin.Password = "taken over"
+				ok, err = srv.Update(editorCtx, *admin)
+				So(err, ShouldEqual, ErrForbidden)
+				So(ok, ShouldBeFalse)
+
+				ok, err = srv.ResetTOTP(editorCtx, admin.ID)
+				So(err, ShouldEqual, ErrForbidden)
+				So(ok, ShouldBeFalse)
+
+				_, err = srv.UpdateStatus(editorCtx, StatusUpdate{ObjectIDs: []int{admin.ID}, StatusID: db.StatusDisabled})
+				So(err, ShouldEqual, ErrForbidden)
+			})
+		})
+	})
+}
+
+func TestDB_ModerationService(t *testing.T) {
+	Convey("Test ModerationService", t, func() {
+		dbo, logger := test.Setup(t)
+		srv := NewModerationService(dbo, logger)
+		So(srv, ShouldNotBeNil)
+
+		commonRepo := db.NewCommonRepo(dbo)
+		u, err := commonRepo.OneUser(t.Context(), &db.UserSearch{Login: test.Ptr("admin")})
+		So(err, ShouldBeNil)
+		So(u, ShouldNotBeNil)
+		ctx := context.WithValue(t.Context(), userKey, u)
+
+		suggestion, err := srv.newsRepo.AddSuggestion(ctx, &db.Suggestion{
+			Title:              fmt.Sprintf("Suggestion %d", time.Now().UnixNano()),
+			ShortText:          "Short text",
+			Text:               "Text",
+			CategoryID:         1,
+			Tags:               []string{"Mascots"},
+			IP:                 "127.0.0.1",
+			ModerationStatusID: db.ModerationPending,
+		})
+		So(err, ShouldBeNil)
+
+		Convey("Pending list", func() {
+			list, err := srv.Get(ctx, &SuggestionSearch{ModerationStatusID: test.Ptr(db.ModerationPending)}, nil)
+			So(err, ShouldBeNil)
+			So(len(list), ShouldBeGreaterThan, 0)
+		})
+
+		Convey("Approve", func() {
+			// duplicate tags are ignored
+			approval := SuggestionApproval{ID: suggestion.ID, CategoryID: 1, TagIDs: []int{1, 1}, PublishedAt: time.Now()}
+			news, err := srv.Approve(ctx, approval)
+			So(err, ShouldBeNil)
+			So(news, ShouldNotBeNil)
+			So(news.Title, ShouldEqual, suggestion.Title)
+			So(news.TagIDs, ShouldResemble, []int{1})
+
+			_, err = srv.Approve(ctx, approval)
+			So(err, ShouldNotBeNil)
+
+			item, err := srv.GetByID(ctx, suggestion.ID)
+			So(err, ShouldBeNil)
+			So(item.ModerationStatusID, ShouldEqual, db.ModerationApproved)
+			So(item.NewsID, ShouldResemble, &news.ID)
+			So(item.Decisions, ShouldHaveLength, 1)
+			So(item.Decisions[0].ModerationStatusID, ShouldEqual, db.ModerationApproved)
+		})
+
+		Convey("Reject", func() {
+			ok, err := srv.Reject(ctx, SuggestionRejection{ID: suggestion.ID, Reason: "duplicate"})
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeTrue)
+
+			_, err = srv.Reject(ctx, SuggestionRejection{ID: suggestion.ID, Reason: "duplicate"})
+			So(err, ShouldNotBeNil)
+
+			// rejected suggestion can't be approved, tags are optional
+			_, err = srv.Approve(ctx, SuggestionApproval{ID: suggestion.ID, CategoryID: 1, PublishedAt: time.Now()})
+			So(err, ShouldNotBeNil)
+
+			item, err := srv.GetByID(ctx, suggestion.ID)
+			So(err, ShouldBeNil)
+			So(item.ModerationStatusID, ShouldEqual, db.ModerationRejected)
+			So(item.Decisions, ShouldHaveLength, 1)
+			So(item.Decisions[0].Reason, ShouldResemble, test.Ptr("duplicate"))
+		})
+	})
+}
+
+func TestDB_NewsService_Revisions(t *testing.T) {
+	Convey("Test NewsService revisions", t, func() {
+		dbo, logger := test.Setup(t)
+		srv := NewNewsService(dbo, logger)
+
+		commonRepo := db.NewCommonRepo(dbo)
+		u, err := commonRepo.OneUser(t.Context(), &db.UserSearch{Login: test.Ptr("admin")})
+		So(err, ShouldBeNil)
+		ctx := context.WithValue(t.Context(), userKey, u)
+
+		news, err := srv.Add(ctx, News{
+			Title:       fmt.Sprintf("Revision %d", time.Now().UnixNano()),
+			ShortText:   "First",
+			CategoryID:  1,
+			TagIDs:      []int{1},
+			PublishedAt: time.Now(),
+			StatusID:    db.StatusEnabled,
+		})
+		So(err, ShouldBeNil)
+
+		updated := *news
+		updated.ShortText = "Second"
+		ok, err := srv.Update(ctx, updated)
+		So(err, ShouldBeNil)
+		So(ok, ShouldBeTrue)
+
+		list, err := srv.Revisions(ctx, news.ID)
+		So(err, ShouldBeNil)
+		So(list, ShouldHaveLength, 2)
+		So(list[0].Action, ShouldEqual, db.RevisionUpdate)
+		So(list[0].User.ID, ShouldEqual, u.ID)
+		So(list[1].Action, ShouldEqual, db.RevisionAdd)
+
+		diff, err := srv.RevisionDiff(ctx, list[1].ID, list[0].ID)
+		So(err, ShouldBeNil)
+		So(diff.Fields, ShouldResemble, []string{"shortText"})
+
+		ok, err = srv.RestoreRevision(ctx, list[1].ID)
+		So(err, ShouldBeNil)
+		So(ok, ShouldBeTrue)
+
+		restored, err := srv.GetByID(ctx, news.ID)
+		So(err, ShouldBeNil)
+		So(restored.ShortText, ShouldEqual, "First")
+
+		list, err = srv.Revisions(ctx, news.ID)
+		So(err, ShouldBeNil)
+		So(list, ShouldHaveLength, 3)
+		So(list[0].Action, ShouldEqual, db.RevisionRestore)
+
+		// empty slug keeps the current one
+		restored.Title, restored.Slug = restored.Title+" edited", ""
+		ok, err = srv.Update(ctx, *restored)
+		So(err, ShouldBeNil)
+		So(ok, ShouldBeTrue)
+
+		edited, err := srv.GetByID(ctx, news.ID)
+		So(err, ShouldBeNil)
+		So(edited.Slug, ShouldEqual, news.Slug)
+
+		_, err = srv.Revision(ctx, -1)
+		So(err, ShouldEqual, ErrNotFound)
+	})
+}
+
+func TestDB_TagService_Merge(t *testing.T) {
+	Convey("Test TagService merge and orphans", t, func() {
+		dbo, logger := test.Setup(t)
+		srv, newsSrv := NewTagService(dbo, logger), NewNewsService(dbo, logger)
+		newsRepo, webhookRepo := db.NewNewsRepo(dbo), db.NewWebhookRepo(dbo)
+
+		commonRepo := db.NewCommonRepo(dbo)
+		u, err := commonRepo.OneUser(t.Context(), &db.UserSearch{Login: test.Ptr("admin")})
+		So(err, ShouldBeNil)
+		ctx := context.WithValue(t.Context(), userKey, u)
+
+		suffix := time.Now().UnixNano()
+		addTag := func(name string) int {
+			tag, err := newsRepo.AddTag(ctx, &db.Tag{Name: fmt.Sprintf("%s %d", name, suffix), StatusID: db.StatusEnabled})
+			So(err, ShouldBeNil)
+			return tag.ID
+		}
+
+		addNews := func(tagIDs []int) int {
+			news, err := newsSrv.Add(ctx, News{
+				Title:       fmt.Sprintf("Tags %v %d", tagIDs, suffix),
+				ShortText:   "Tags",
+				CategoryID:  1,
+				TagIDs:      tagIDs,
+				PublishedAt: time.Now(),
+				StatusID:    db.StatusEnabled,
+			})
+			So(err, ShouldBeNil)
+			return news.ID
+		}
+
+		revisions := func(newsID int) int {
+			count, err := newsRepo.CountNewsRevisions(ctx, &db.NewsRevisionSearch{NewsID: &newsID})
+			So(err, ShouldBeNil)
+			return count
+		}
+
+		hook, err := webhookRepo.AddWebhook(ctx, &db.Webhook{
+			Title:    fmt.Sprintf("Tags %d", suffix),
+			URL:      "https://example.com/hook",
+			Secret:   "secret",
+			Events:   []string{string(webhook.EventTagDeleted), string(webhook.EventNewsUpdated)},
+			StatusID: db.StatusEnabled,
+		})
+		So(err, ShouldBeNil)
+		defer func() { _, _ = webhookRepo.DeleteWebhook(ctx, hook.ID) }()
+
+		deliveries := func(event webhook.Event) int {
+			count, err := webhookRepo.CountWebhookDeliveries(ctx, &db.WebhookDeliverySearch{WebhookID: &hook.ID, Event: test.Ptr(string(event))})
+			So(err, ShouldBeNil)
+			return count
+		}
+
+		Convey("Merge tags", func() {
+			source, target := addTag("source"), addTag("target")
+			withBoth, withSource := addNews([]int{source, target}), addNews([]int{source})
+
+			count, err := srv.Merge(ctx, []int{source}, target)
+			So(err, ShouldBeNil)
+			So(count, ShouldEqual, 2)
+
+			// target is not duplicated in news, which already has it
+			news, err := newsRepo.NewsByID(ctx, withBoth)
+			So(err, ShouldBeNil)
+			So(news.TagIDs, ShouldResemble, []int{target})
+
+			news, err = newsRepo.NewsByID(ctx, withSource)
+			So(err, ShouldBeNil)
+			So(news.TagIDs, ShouldResemble, []int{target})
+
+			// source tag is deleted
+			tag, err := newsRepo.TagByID(ctx, source)
+			So(err, ShouldBeNil)
+			So(tag, ShouldBeNil)
+
+			// revisions are saved and events are emitted
+			So(revisions(withBoth), ShouldEqual, 2)
+			So(revisions(withSource), ShouldEqual, 2)
+			So(deliveries(webhook.EventNewsUpdated), ShouldEqual, 2)
+			So(deliveries(webhook.EventTagDeleted), ShouldEqual, 1)
+		})
+
+		Convey("Merge with invalid tags", func() {
+			target := addTag("target")
+
+			_, err := srv.Merge(ctx, []int{target}, target)
+			So(err, ShouldNotBeNil)
+
+			_, err = srv.Merge(ctx, []int{-1}, target)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Fix orphan tags", func() {
+			kept, trashed, orphan := addTag("kept"), addTag("trashed"), addTag("orphan")
+			newsID := addNews([]int{kept, trashed, orphan})
+
+			ok, err := newsRepo.DeleteTag(ctx, trashed)
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeTrue)
+
+			// tag row is missing, e.g. news was saved concurrently with tag purge
+			_, err = dbo.ModelContext(ctx, &db.Tag{ID: orphan}).WherePK().Delete()
+			So(err, ShouldBeNil)
+
+			list, err := srv.GetOrphans(ctx)
+			So(err, ShouldBeNil)
+			So(slices.ContainsFunc(list, func(t OrphanTag) bool { return t.ID == orphan }), ShouldBeTrue)
+			So(slices.ContainsFunc(list, func(t OrphanTag) bool { return t.ID == trashed }), ShouldBeFalse)
+
+			_, err = srv.FixOrphans(ctx)
+			So(err, ShouldBeNil)
+
+			news, err := newsRepo.NewsByID(ctx, newsID)
+			So(err, ShouldBeNil)
+			So(news.TagIDs, ShouldResemble, []int{kept, trashed})
+			So(revisions(newsID), ShouldEqual, 2)
+
+			// restored tag is still linked to news
+			ok, err = NewTrashService(dbo, logger).Restore(ctx, db.TrashTags, trashed)
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeTrue)
+
+			news, err = newsRepo.NewsByID(ctx, newsID)
+			So(err, ShouldBeNil)
+			So(news.TagIDs, ShouldResemble, []int{kept, trashed})
+
+			list, err = srv.GetOrphans(ctx)
+			So(err, ShouldBeNil)
+			So(slices.ContainsFunc(list, func(t OrphanTag) bool { return t.ID == orphan }), ShouldBeFalse)
 		})
 	})
 }
@@ -0,0 +1,51 @@
+package vt
+
+import (
+	"testing"
+
+	"apisrv/pkg/db"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/vmkteam/zenrpc/v2"
+)
+
+func TestBulk(t *testing.T) {
+	fetch := func(ids []int) ([]db.Tag, error) {
+		var list []db.Tag
+		for _, id := range ids {
+			if id < 10 {
+				list = append(list, db.Tag{ID: id})
+			}
+		}
+		return list, nil
+	}
+	tagID := func(t *db.Tag) int { return t.ID }
+
+	Convey("Test bulkList", t, func() {
+		list, err := bulkList([]int{3, 1, 3, 2}, fetch, tagID)
+		So(err, ShouldBeNil)
+		So(list, ShouldResemble, []db.Tag{{ID: 3}, {ID: 1}, {ID: 2}})
+
+		_, err = bulkList(nil, fetch, tagID)
+		So(err, ShouldNotBeNil)
+
+		_, err = bulkList([]int{1, 10, 2, 11}, fetch, tagID)
+		So(err, ShouldNotBeNil)
+
+		var rpcErr *zenrpc.Error
+		So(err, ShouldHaveSameTypeAs, rpcErr)
+		So(err.(*zenrpc.Error).Data, ShouldResemble, []FieldError{{Field: "ids[1]", Error: FieldErrorIncorrect}, {Field: "ids[3]", Error: FieldErrorIncorrect}})
+	})
+
+	Convey("Test isValidStatusUpdate", t, func() {
+		ctx := t.Context()
+		v := isValidStatusUpdate(ctx, StatusUpdate{StatusID: db.StatusDisabled, ObjectIDs: []int{1}})
+		So(v.HasErrors(), ShouldBeFalse)
+
+		v = isValidStatusUpdate(ctx, StatusUpdate{StatusID: db.StatusDeleted, ObjectIDs: []int{1}})
+		So(v.Fields(), ShouldResemble, []FieldError{{Field: "statusId", Error: FieldErrorIncorrect}})
+
+		v = isValidStatusUpdate(ctx, StatusUpdate{StatusID: db.StatusEnabled})
+		So(v.HasErrors(), ShouldBeTrue)
+	})
+}
@@ -11,13 +11,19 @@ import (
 )
 
 var RPC = struct {
-	CategoryService struct{ Count, Get, GetByID, Add, Update, Delete, Validate string }
-	NewsService     struct{ Count, Get, GetByID, Add, Update, Delete, Validate string }
-	TagService      struct{ Count, Get, GetByID, Add, Update, Delete, Validate string }
-	AuthService     struct{ Login, Logout, Profile, ChangePassword, VfsAuthToken string }
-	UserService     struct{ Count, Get, GetByID, Add, Update, Delete, Validate string }
+	APIKeyService     struct{ Count, Get, GetByID, Add, Update, Delete, Validate string }
+	AuditService      struct{ Count, Get string }
+	CategoryService   struct{ Count, Get, GetByID, Add, Update, Delete, UpdateStatus, DeleteMany, Validate string }
+	NewsService       struct{ Count, Get, GetByID, Add, Update, Delete, UpdateStatus, DeleteMany, Revisions, Revision, RevisionDiff, RestoreRevision, Validate string }
+	TagService        struct{ Count, Get, GetByID, Add, Update, Delete, UpdateStatus, DeleteMany, Merge, GetOrphans, FixOrphans, Validate string }
+	ModerationService struct{ Count, Get, GetByID, Approve, Reject string }
+	TrashService      struct{ Count, Get, GetDependencies, Restore, Purge string }
+	AuthService       struct{ LoginTOTP, LoginEnrollTOTP, EnrollTOTP, ConfirmTOTP, GenerateRecoveryCodes, DisableTOTP, Login, Logout, Profile, ChangePassword, VfsAuthToken, Sessions, RevokeSession, RevokeAllSessions string }
+	UserService       struct{ Count, Get, GetByID, Add, Update, Delete, UpdateStatus, DeleteMany, CountLoginAttempts, GetLoginAttempts, Unlock, ResetTOTP, Permissions, Validate string }
+	RoleService       struct{ Count, Get, GetByID, Add, Update, Delete, Validate string }
+	WebhookService    struct{ Events, Count, Get, GetByID, Add, Update, Delete, Validate, CountDeliveries, GetDeliveries, Redeliver string }
 }{
-	CategoryService: struct{ Count, Get, GetByID, Add, Update, Delete, Validate string }{
+	APIKeyService: struct{ Count, Get, GetByID, Add, Update, Delete, Validate string }{
 		Count:    "count",
 		Get:      "get",
 		GetByID:  "getbyid",
@@ -26,32 +32,97 @@ var RPC = struct {
 		Delete:   "delete",
 		Validate: "validate",
 	},
-	NewsService: struct{ Count, Get, GetByID, Add, Update, Delete, Validate string }{
-		Count:    "count",
-		Get:      "get",
-		GetByID:  "getbyid",
-		Add:      "add",
-		Update:   "update",
-		Delete:   "delete",
-		Validate: "validate",
+	AuditService: struct{ Count, Get string }{
+		Count: "count",
+		Get:   "get",
 	},
-	TagService: struct{ Count, Get, GetByID, Add, Update, Delete, Validate string }{
-		Count:    "count",
-		Get:      "get",
-		GetByID:  "getbyid",
-		Add:      "add",
-		Update:   "update",
-		Delete:   "delete",
-		Validate: "validate",
+	CategoryService: struct{ Count, Get, GetByID, Add, Update, Delete, UpdateStatus, DeleteMany, Validate string }{
+		Count:        "count",
+		Get:          "get",
+		GetByID:      "getbyid",
+		Add:          "add",
+		Update:       "update",
+		Delete:       "delete",
+		UpdateStatus: "updatestatus",
+		DeleteMany:   "deletemany",
+		Validate:     "validate",
+	},
+	NewsService: struct{ Count, Get, GetByID, Add, Update, Delete, UpdateStatus, DeleteMany, Revisions, Revision, RevisionDiff, RestoreRevision, Validate string }{
+		Count:           "count",
+		Get:             "get",
+		GetByID:         "getbyid",
+		Add:             "add",
+		Update:          "update",
+		Delete:          "delete",
+		UpdateStatus:    "updatestatus",
+		DeleteMany:      "deletemany",
+		Revisions:       "revisions",
+		Revision:        "revision",
+		RevisionDiff:    "revisiondiff",
+		RestoreRevision: "restorerevision",
+		Validate:        "validate",
+	},
+	TagService: struct{ Count, Get, GetByID, Add, Update, Delete, UpdateStatus, DeleteMany, Merge, GetOrphans, FixOrphans, Validate string }{
+		Count:        "count",
+		Get:          "get",
+		GetByID:      "getbyid",
+		Add:          "add",
+		Update:       "update",
+		Delete:       "delete",
+		UpdateStatus: "updatestatus",
+		DeleteMany:   "deletemany",
+		Merge:        "merge",
+		GetOrphans:   "getorphans",
+		FixOrphans:   "fixorphans",
+		Validate:     "validate",
 	},
-	AuthService: struct{ Login, Logout, Profile, ChangePassword, VfsAuthToken string }{
-		Login:          "login",
-		Logout:         "logout",
-		Profile:        "profile",
-		ChangePassword: "changepassword",
-		VfsAuthToken:   "vfsauthtoken",
+	ModerationService: struct{ Count, Get, GetByID, Approve, Reject string }{
+		Count:   "count",
+		Get:     "get",
+		GetByID: "getbyid",
+		Approve: "approve",
+		Reject:  "reject",
 	},
-	UserService: struct{ Count, Get, GetByID, Add, Update, Delete, Validate string }{
+	TrashService: struct{ Count, Get, GetDependencies, Restore, Purge string }{
+		Count:           "count",
+		Get:             "get",
+		GetDependencies: "getdependencies",
+		Restore:         "restore",
+		Purge:           "purge",
+	},
+	AuthService: struct{ LoginTOTP, LoginEnrollTOTP, EnrollTOTP, ConfirmTOTP, GenerateRecoveryCodes, DisableTOTP, Login, Logout, Profile, ChangePassword, VfsAuthToken, Sessions, RevokeSession, RevokeAllSessions string }{
+		LoginTOTP:             "logintotp",
+		LoginEnrollTOTP:       "loginenrolltotp",
+		EnrollTOTP:            "enrolltotp",
+		ConfirmTOTP:           "confirmtotp",
+		GenerateRecoveryCodes: "generaterecoverycodes",
+		DisableTOTP:           "disabletotp",
+		Login:                 "login",
+		Logout:                "logout",
+		Profile:               "profile",
+		ChangePassword:        "changepassword",
+		VfsAuthToken:          "vfsauthtoken",
+		Sessions:              "sessions",
+		RevokeSession:         "revokesession",
+		RevokeAllSessions:     "revokeallsessions",
+	},
+	UserService: struct{ Count, Get, GetByID, Add, Update, Delete, UpdateStatus, DeleteMany, CountLoginAttempts, GetLoginAttempts, Unlock, ResetTOTP, Permissions, Validate string }{
+		Count:              "count",
+		Get:                "get",
+		GetByID:            "getbyid",
+		Add:                "add",
+		Update:             "update",
+		Delete:             "delete",
+		UpdateStatus:       "updatestatus",
+		DeleteMany:         "deletemany",
+		CountLoginAttempts: "countloginattempts",
+		GetLoginAttempts:   "getloginattempts",
+		Unlock:             "unlock",
+		ResetTOTP:          "resettotp",
+		Permissions:        "permissions",
+		Validate:           "validate",
+	},
+	RoleService: struct{ Count, Get, GetByID, Add, Update, Delete, Validate string }{
 		Count:    "count",
 		Get:      "get",
 		GetByID:  "getbyid",
@@ -60,20 +131,33 @@ var RPC = struct {
 		Delete:   "delete",
 		Validate: "validate",
 	},
+	WebhookService: struct{ Events, Count, Get, GetByID, Add, Update, Delete, Validate, CountDeliveries, GetDeliveries, Redeliver string }{
+		Events:          "events",
+		Count:           "count",
+		Get:             "get",
+		GetByID:         "getbyid",
+		Add:             "add",
+		Update:          "update",
+		Delete:          "delete",
+		Validate:        "validate",
+		CountDeliveries: "countdeliveries",
+		GetDeliveries:   "getdeliveries",
+		Redeliver:       "redeliver",
+	},
 }
 
-func (CategoryService) SMD() smd.ServiceInfo {
+func (APIKeyService) SMD() smd.ServiceInfo {
 	return smd.ServiceInfo{
 		Methods: map[string]smd.Service{
 			"Count": {
-				Description: `Count returns count Categories according to conditions in search params.`,
+				Description: `Count APIKeys according to conditions in search params`,
 				Parameters: []smd.JSONSchema{
 					{
 						Name:        "search",
 						Optional:    true,
-						Description: `CategorySearch`,
+						Description: `APIKeySearch`,
 						Type:        smd.Object,
-						TypeName:    "CategorySearch",
+						TypeName:    "APIKeySearch",
 						Properties: smd.PropertyList{
 							{
 								Name:     "id",
@@ -81,14 +165,14 @@ func (CategoryService) SMD() smd.ServiceInfo {
 								Type:     smd.Integer,
 							},
 							{
-								Name:     "title",
+								Name:     "userId",
 								Optional: true,
-								Type:     smd.String,
+								Type:     smd.Integer,
 							},
 							{
-								Name:     "sort",
+								Name:     "title",
 								Optional: true,
-								Type:     smd.Integer,
+								Type:     smd.String,
 							},
 							{
 								Name:     "statusId",
@@ -114,14 +198,14 @@ func (CategoryService) SMD() smd.ServiceInfo {
 				},
 			},
 			"Get": {
-				Description: `Get returns а list of Categories according to conditions in search params.`,
+				Description: `Get а list of APIKeys according to conditions in search params`,
 				Parameters: []smd.JSONSchema{
 					{
 						Name:        "search",
 						Optional:    true,
-						Description: `CategorySearch`,
+						Description: `APIKeySearch`,
 						Type:        smd.Object,
-						TypeName:    "CategorySearch",
+						TypeName:    "APIKeySearch",
 						Properties: smd.PropertyList{
 							{
 								Name:     "id",
@@ -129,14 +213,14 @@ func (CategoryService) SMD() smd.ServiceInfo {
 								Type:     smd.Integer,
 							},
 							{
-								Name:     "title",
+								Name:     "userId",
 								Optional: true,
-								Type:     smd.String,
+								Type:     smd.Integer,
 							},
 							{
-								Name:     "sort",
+								Name:     "title",
 								Optional: true,
-								Type:     smd.Integer,
+								Type:     smd.String,
 							},
 							{
 								Name:     "statusId",
@@ -183,28 +267,110 @@ func (CategoryService) SMD() smd.ServiceInfo {
 					},
 				},
 				Returns: smd.JSONSchema{
-					Description: `[]CategorySummary`,
+					Description: `[]APIKey`,
 					Type:        smd.Array,
-					TypeName:    "[]CategorySummary",
+					TypeName:    "[]APIKey",
 					Items: map[string]string{
-						"$ref": "#/definitions/CategorySummary",
+						"$ref": "#/definitions/APIKey",
 					},
 					Definitions: map[string]smd.Definition{
-						"CategorySummary": {
+						"APIKey": {
 							Type: "object",
 							Properties: smd.PropertyList{
 								{
 									Name: "id",
 									Type: smd.Integer,
 								},
+								{
+									Name: "userId",
+									Type: smd.Integer,
+								},
 								{
 									Name: "title",
 									Type: smd.String,
 								},
 								{
-									Name:     "sort",
+									Name: "prefix",
+									Type: smd.String,
+								},
+								{
+									Name: "permissions",
+									Type: smd.Array,
+									Items: map[string]string{
+										"type": smd.String,
+									},
+								},
+								{
+									Name:     "expiresAt",
 									Optional: true,
-									Type:     smd.Integer,
+									Type:     smd.String,
+								},
+								{
+									Name:     "lastUsedAt",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name:     "lastUsedIp",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name: "createdAt",
+									Type: smd.String,
+								},
+								{
+									Name: "statusId",
+									Type: smd.Integer,
+								},
+								{
+									Name:     "user",
+									Optional: true,
+									Ref:      "#/definitions/UserSummary",
+									Type:     smd.Object,
+								},
+								{
+									Name:     "status",
+									Optional: true,
+									Ref:      "#/definitions/Status",
+									Type:     smd.Object,
+								},
+							},
+						},
+						"UserSummary": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "createdAt",
+									Type: smd.String,
+								},
+								{
+									Name: "login",
+									Type: smd.String,
+								},
+								{
+									Name:     "lastActivityAt",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name: "roleIds",
+									Type: smd.Array,
+									Items: map[string]string{
+										"type": smd.Integer,
+									},
+								},
+								{
+									Name: "isTotpEnabled",
+									Type: smd.Boolean,
+								},
+								{
+									Name: "isService",
+									Type: smd.Boolean,
 								},
 								{
 									Name:     "status",
@@ -238,7 +404,7 @@ func (CategoryService) SMD() smd.ServiceInfo {
 				},
 			},
 			"GetByID": {
-				Description: `GetByID returns a Category by its ID.`,
+				Description: `GetByID returns a APIKey by its ID.`,
 				Parameters: []smd.JSONSchema{
 					{
 						Name:        "id",
@@ -247,28 +413,63 @@ func (CategoryService) SMD() smd.ServiceInfo {
 					},
 				},
 				Returns: smd.JSONSchema{
-					Description: `Category`,
+					Description: `APIKey`,
 					Optional:    true,
 					Type:        smd.Object,
-					TypeName:    "Category",
+					TypeName:    "APIKey",
 					Properties: smd.PropertyList{
 						{
 							Name: "id",
 							Type: smd.Integer,
 						},
+						{
+							Name: "userId",
+							Type: smd.Integer,
+						},
 						{
 							Name: "title",
 							Type: smd.String,
 						},
 						{
-							Name:     "sort",
+							Name: "prefix",
+							Type: smd.String,
+						},
+						{
+							Name: "permissions",
+							Type: smd.Array,
+							Items: map[string]string{
+								"type": smd.String,
+							},
+						},
+						{
+							Name:     "expiresAt",
 							Optional: true,
-							Type:     smd.Integer,
+							Type:     smd.String,
+						},
+						{
+							Name:     "lastUsedAt",
+							Optional: true,
+							Type:     smd.String,
+						},
+						{
+							Name:     "lastUsedIp",
+							Optional: true,
+							Type:     smd.String,
+						},
+						{
+							Name: "createdAt",
+							Type: smd.String,
 						},
 						{
 							Name: "statusId",
 							Type: smd.Integer,
 						},
+						{
+							Name:     "user",
+							Optional: true,
+							Ref:      "#/definitions/UserSummary",
+							Type:     smd.Object,
+						},
 						{
 							Name:     "status",
 							Optional: true,
@@ -277,6 +478,49 @@ func (CategoryService) SMD() smd.ServiceInfo {
 						},
 					},
 					Definitions: map[string]smd.Definition{
+						"UserSummary": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "createdAt",
+									Type: smd.String,
+								},
+								{
+									Name: "login",
+									Type: smd.String,
+								},
+								{
+									Name:     "lastActivityAt",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name: "roleIds",
+									Type: smd.Array,
+									Items: map[string]string{
+										"type": smd.Integer,
+									},
+								},
+								{
+									Name: "isTotpEnabled",
+									Type: smd.Boolean,
+								},
+								{
+									Name: "isService",
+									Type: smd.Boolean,
+								},
+								{
+									Name:     "status",
+									Optional: true,
+									Ref:      "#/definitions/Status",
+									Type:     smd.Object,
+								},
+							},
+						},
 						"Status": {
 							Type: "object",
 							Properties: smd.PropertyList{
@@ -302,31 +546,66 @@ func (CategoryService) SMD() smd.ServiceInfo {
 				},
 			},
 			"Add": {
-				Description: `Add adds a Category from the query.`,
+				Description: `Add creates an API key of the service account. The key token is returned only once, only its hash is stored.`,
 				Parameters: []smd.JSONSchema{
 					{
-						Name:        "category",
-						Description: `Category`,
+						Name:        "apiKey",
+						Description: `APIKey`,
 						Type:        smd.Object,
-						TypeName:    "Category",
+						TypeName:    "APIKey",
 						Properties: smd.PropertyList{
 							{
 								Name: "id",
 								Type: smd.Integer,
 							},
+							{
+								Name: "userId",
+								Type: smd.Integer,
+							},
 							{
 								Name: "title",
 								Type: smd.String,
 							},
 							{
-								Name:     "sort",
+								Name: "prefix",
+								Type: smd.String,
+							},
+							{
+								Name: "permissions",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.String,
+								},
+							},
+							{
+								Name:     "expiresAt",
 								Optional: true,
-								Type:     smd.Integer,
+								Type:     smd.String,
+							},
+							{
+								Name:     "lastUsedAt",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "lastUsedIp",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name: "createdAt",
+								Type: smd.String,
 							},
 							{
 								Name: "statusId",
 								Type: smd.Integer,
 							},
+							{
+								Name:     "user",
+								Optional: true,
+								Ref:      "#/definitions/UserSummary",
+								Type:     smd.Object,
+							},
 							{
 								Name:     "status",
 								Optional: true,
@@ -335,6 +614,49 @@ func (CategoryService) SMD() smd.ServiceInfo {
 							},
 						},
 						Definitions: map[string]smd.Definition{
+							"UserSummary": {
+								Type: "object",
+								Properties: smd.PropertyList{
+									{
+										Name: "id",
+										Type: smd.Integer,
+									},
+									{
+										Name: "createdAt",
+										Type: smd.String,
+									},
+									{
+										Name: "login",
+										Type: smd.String,
+									},
+									{
+										Name:     "lastActivityAt",
+										Optional: true,
+										Type:     smd.String,
+									},
+									{
+										Name: "roleIds",
+										Type: smd.Array,
+										Items: map[string]string{
+											"type": smd.Integer,
+										},
+									},
+									{
+										Name: "isTotpEnabled",
+										Type: smd.Boolean,
+									},
+									{
+										Name: "isService",
+										Type: smd.Boolean,
+									},
+									{
+										Name:     "status",
+										Optional: true,
+										Ref:      "#/definitions/Status",
+										Type:     smd.Object,
+									},
+								},
+							},
 							"Status": {
 								Type: "object",
 								Properties: smd.PropertyList{
@@ -356,36 +678,128 @@ func (CategoryService) SMD() smd.ServiceInfo {
 					},
 				},
 				Returns: smd.JSONSchema{
-					Description: `Category`,
+					Description: `APIKeyToken`,
 					Optional:    true,
 					Type:        smd.Object,
-					TypeName:    "Category",
+					TypeName:    "APIKeyToken",
 					Properties: smd.PropertyList{
 						{
-							Name: "id",
-							Type: smd.Integer,
+							Name: "apiKey",
+							Ref:  "#/definitions/APIKey",
+							Type: smd.Object,
 						},
 						{
-							Name: "title",
+							Name: "token",
 							Type: smd.String,
 						},
-						{
-							Name:     "sort",
-							Optional: true,
-							Type:     smd.Integer,
-						},
-						{
-							Name: "statusId",
-							Type: smd.Integer,
-						},
-						{
-							Name:     "status",
-							Optional: true,
-							Ref:      "#/definitions/Status",
-							Type:     smd.Object,
-						},
 					},
 					Definitions: map[string]smd.Definition{
+						"APIKey": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "userId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "prefix",
+									Type: smd.String,
+								},
+								{
+									Name: "permissions",
+									Type: smd.Array,
+									Items: map[string]string{
+										"type": smd.String,
+									},
+								},
+								{
+									Name:     "expiresAt",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name:     "lastUsedAt",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name:     "lastUsedIp",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name: "createdAt",
+									Type: smd.String,
+								},
+								{
+									Name: "statusId",
+									Type: smd.Integer,
+								},
+								{
+									Name:     "user",
+									Optional: true,
+									Ref:      "#/definitions/UserSummary",
+									Type:     smd.Object,
+								},
+								{
+									Name:     "status",
+									Optional: true,
+									Ref:      "#/definitions/Status",
+									Type:     smd.Object,
+								},
+							},
+						},
+						"UserSummary": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "createdAt",
+									Type: smd.String,
+								},
+								{
+									Name: "login",
+									Type: smd.String,
+								},
+								{
+									Name:     "lastActivityAt",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name: "roleIds",
+									Type: smd.Array,
+									Items: map[string]string{
+										"type": smd.Integer,
+									},
+								},
+								{
+									Name: "isTotpEnabled",
+									Type: smd.Boolean,
+								},
+								{
+									Name: "isService",
+									Type: smd.Boolean,
+								},
+								{
+									Name:     "status",
+									Optional: true,
+									Ref:      "#/definitions/Status",
+									Type:     smd.Object,
+								},
+							},
+						},
 						"Status": {
 							Type: "object",
 							Properties: smd.PropertyList{
@@ -408,33 +822,70 @@ func (CategoryService) SMD() smd.ServiceInfo {
 				Errors: map[int]string{
 					500: "Internal Error",
 					400: "Validation Error",
+					403: "Service Account Or Permissions, Which Current User Doesn't Have",
 				},
 			},
 			"Update": {
-				Description: `Update updates the Category data identified by id from the query.`,
+				Description: `Update updates title, permissions, expiration time and status of the APIKey identified by id from the query.`,
 				Parameters: []smd.JSONSchema{
 					{
-						Name:     "category",
-						Type:     smd.Object,
-						TypeName: "Category",
+						Name:        "apiKey",
+						Description: `APIKey`,
+						Type:        smd.Object,
+						TypeName:    "APIKey",
 						Properties: smd.PropertyList{
 							{
 								Name: "id",
 								Type: smd.Integer,
 							},
+							{
+								Name: "userId",
+								Type: smd.Integer,
+							},
 							{
 								Name: "title",
 								Type: smd.String,
 							},
 							{
-								Name:     "sort",
+								Name: "prefix",
+								Type: smd.String,
+							},
+							{
+								Name: "permissions",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.String,
+								},
+							},
+							{
+								Name:     "expiresAt",
 								Optional: true,
-								Type:     smd.Integer,
+								Type:     smd.String,
+							},
+							{
+								Name:     "lastUsedAt",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "lastUsedIp",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name: "createdAt",
+								Type: smd.String,
 							},
 							{
 								Name: "statusId",
 								Type: smd.Integer,
 							},
+							{
+								Name:     "user",
+								Optional: true,
+								Ref:      "#/definitions/UserSummary",
+								Type:     smd.Object,
+							},
 							{
 								Name:     "status",
 								Optional: true,
@@ -443,6 +894,49 @@ func (CategoryService) SMD() smd.ServiceInfo {
 							},
 						},
 						Definitions: map[string]smd.Definition{
+							"UserSummary": {
+								Type: "object",
+								Properties: smd.PropertyList{
+									{
+										Name: "id",
+										Type: smd.Integer,
+									},
+									{
+										Name: "createdAt",
+										Type: smd.String,
+									},
+									{
+										Name: "login",
+										Type: smd.String,
+									},
+									{
+										Name:     "lastActivityAt",
+										Optional: true,
+										Type:     smd.String,
+									},
+									{
+										Name: "roleIds",
+										Type: smd.Array,
+										Items: map[string]string{
+											"type": smd.Integer,
+										},
+									},
+									{
+										Name: "isTotpEnabled",
+										Type: smd.Boolean,
+									},
+									{
+										Name: "isService",
+										Type: smd.Boolean,
+									},
+									{
+										Name:     "status",
+										Optional: true,
+										Ref:      "#/definitions/Status",
+										Type:     smd.Object,
+									},
+								},
+							},
 							"Status": {
 								Type: "object",
 								Properties: smd.PropertyList{
@@ -464,18 +958,19 @@ func (CategoryService) SMD() smd.ServiceInfo {
 					},
 				},
 				Returns: smd.JSONSchema{
-					Description: `Category`,
+					Description: `APIKey`,
 					Type:        smd.Boolean,
-					TypeName:    "Category",
+					TypeName:    "APIKey",
 				},
 				Errors: map[int]string{
 					500: "Internal Error",
 					400: "Validation Error",
+					403: "Service Account Or Permissions, Which Current User Doesn't Have",
 					404: "Not Found",
 				},
 			},
 			"Delete": {
-				Description: `Delete deletes the Category by its ID.`,
+				Description: `Delete revokes the APIKey by its ID.`,
 				Parameters: []smd.JSONSchema{
 					{
 						Name:        "id",
@@ -494,31 +989,66 @@ func (CategoryService) SMD() smd.ServiceInfo {
 				},
 			},
 			"Validate": {
-				Description: `Validate verifies that Category data is valid.`,
+				Description: `Validate Verifies that APIKey data is valid.`,
 				Parameters: []smd.JSONSchema{
 					{
-						Name:        "category",
-						Description: `Category`,
+						Name:        "apiKey",
+						Description: `APIKey`,
 						Type:        smd.Object,
-						TypeName:    "Category",
+						TypeName:    "APIKey",
 						Properties: smd.PropertyList{
 							{
 								Name: "id",
 								Type: smd.Integer,
 							},
+							{
+								Name: "userId",
+								Type: smd.Integer,
+							},
 							{
 								Name: "title",
 								Type: smd.String,
 							},
 							{
-								Name:     "sort",
+								Name: "prefix",
+								Type: smd.String,
+							},
+							{
+								Name: "permissions",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.String,
+								},
+							},
+							{
+								Name:     "expiresAt",
 								Optional: true,
-								Type:     smd.Integer,
+								Type:     smd.String,
+							},
+							{
+								Name:     "lastUsedAt",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "lastUsedIp",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name: "createdAt",
+								Type: smd.String,
 							},
 							{
 								Name: "statusId",
 								Type: smd.Integer,
 							},
+							{
+								Name:     "user",
+								Optional: true,
+								Ref:      "#/definitions/UserSummary",
+								Type:     smd.Object,
+							},
 							{
 								Name:     "status",
 								Optional: true,
@@ -527,6 +1057,49 @@ func (CategoryService) SMD() smd.ServiceInfo {
 							},
 						},
 						Definitions: map[string]smd.Definition{
+							"UserSummary": {
+								Type: "object",
+								Properties: smd.PropertyList{
+									{
+										Name: "id",
+										Type: smd.Integer,
+									},
+									{
+										Name: "createdAt",
+										Type: smd.String,
+									},
+									{
+										Name: "login",
+										Type: smd.String,
+									},
+									{
+										Name:     "lastActivityAt",
+										Optional: true,
+										Type:     smd.String,
+									},
+									{
+										Name: "roleIds",
+										Type: smd.Array,
+										Items: map[string]string{
+											"type": smd.Integer,
+										},
+									},
+									{
+										Name: "isTotpEnabled",
+										Type: smd.Boolean,
+									},
+									{
+										Name: "isService",
+										Type: smd.Boolean,
+									},
+									{
+										Name:     "status",
+										Optional: true,
+										Ref:      "#/definitions/Status",
+										Type:     smd.Object,
+									},
+								},
+							},
 							"Status": {
 								Type: "object",
 								Properties: smd.PropertyList{
@@ -601,14 +1174,14 @@ func (CategoryService) SMD() smd.ServiceInfo {
 }
 
 // Invoke is as generated code from zenrpc cmd
-func (s CategoryService) Invoke(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
+func (s APIKeyService) Invoke(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
 	resp := zenrpc.Response{}
 	var err error
 
 	switch method {
-	case RPC.CategoryService.Count:
+	case RPC.APIKeyService.Count:
 		var args = struct {
-			Search *CategorySearch `json:"search"`
+			Search *APIKeySearch `json:"search"`
 		}{}
 
 		if zenrpc.IsArray(params) {
@@ -625,10 +1198,10 @@ func (s CategoryService) Invoke(ctx context.Context, method string, params json.
 
 		resp.Set(s.Count(ctx, args.Search))
 
-	case RPC.CategoryService.Get:
+	case RPC.APIKeyService.Get:
 		var args = struct {
-			Search  *CategorySearch `json:"search"`
-			ViewOps *ViewOps        `json:"viewOps"`
+			Search  *APIKeySearch `json:"search"`
+			ViewOps *ViewOps      `json:"viewOps"`
 		}{}
 
 		if zenrpc.IsArray(params) {
@@ -645,7 +1218,7 @@ func (s CategoryService) Invoke(ctx context.Context, method string, params json.
 
 		resp.Set(s.Get(ctx, args.Search, args.ViewOps))
 
-	case RPC.CategoryService.GetByID:
+	case RPC.APIKeyService.GetByID:
 		var args = struct {
 			Id int `json:"id"`
 		}{}
@@ -664,13 +1237,13 @@ func (s CategoryService) Invoke(ctx context.Context, method string, params json.
 
 		resp.Set(s.GetByID(ctx, args.Id))
 
-	case RPC.CategoryService.Add:
+	case RPC.APIKeyService.Add:
 		var args = struct {
-			Category Category `json:"category"`
+			ApiKey APIKey `json:"apiKey"`
 		}{}
 
 		if zenrpc.IsArray(params) {
-			if params, err = zenrpc.ConvertToObject([]string{"category"}, params); err != nil {
+			if params, err = zenrpc.ConvertToObject([]string{"apiKey"}, params); err != nil {
 				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
 			}
 		}
@@ -681,15 +1254,15 @@ func (s CategoryService) Invoke(ctx context.Context, method string, params json.
 			}
 		}
 
-		resp.Set(s.Add(ctx, args.Category))
+		resp.Set(s.Add(ctx, args.ApiKey))
 
-	case RPC.CategoryService.Update:
+	case RPC.APIKeyService.Update:
 		var args = struct {
-			Category Category `json:"category"`
+			ApiKey APIKey `json:"apiKey"`
 		}{}
 
 		if zenrpc.IsArray(params) {
-			if params, err = zenrpc.ConvertToObject([]string{"category"}, params); err != nil {
+			if params, err = zenrpc.ConvertToObject([]string{"apiKey"}, params); err != nil {
 				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
 			}
 		}
@@ -700,9 +1273,9 @@ func (s CategoryService) Invoke(ctx context.Context, method string, params json.
 			}
 		}
 
-		resp.Set(s.Update(ctx, args.Category))
+		resp.Set(s.Update(ctx, args.ApiKey))
 
-	case RPC.CategoryService.Delete:
+	case RPC.APIKeyService.Delete:
 		var args = struct {
 			Id int `json:"id"`
 		}{}
@@ -721,13 +1294,13 @@ func (s CategoryService) Invoke(ctx context.Context, method string, params json.
 
 		resp.Set(s.Delete(ctx, args.Id))
 
-	case RPC.CategoryService.Validate:
+	case RPC.APIKeyService.Validate:
 		var args = struct {
-			Category Category `json:"category"`
+			ApiKey APIKey `json:"apiKey"`
 		}{}
 
 		if zenrpc.IsArray(params) {
-			if params, err = zenrpc.ConvertToObject([]string{"category"}, params); err != nil {
+			if params, err = zenrpc.ConvertToObject([]string{"apiKey"}, params); err != nil {
 				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
 			}
 		}
@@ -738,7 +1311,7 @@ func (s CategoryService) Invoke(ctx context.Context, method string, params json.
 			}
 		}
 
-		resp.Set(s.Validate(ctx, args.Category))
+		resp.Set(s.Validate(ctx, args.ApiKey))
 
 	default:
 		resp = zenrpc.NewResponseError(nil, zenrpc.MethodNotFound, "", nil)
@@ -747,78 +1320,51 @@ func (s CategoryService) Invoke(ctx context.Context, method string, params json.
 	return resp
 }
 
-func (NewsService) SMD() smd.ServiceInfo {
+func (AuditService) SMD() smd.ServiceInfo {
 	return smd.ServiceInfo{
 		Methods: map[string]smd.Service{
 			"Count": {
-				Description: `Count returns count News according to conditions in search params.`,
+				Description: `Count returns count of audit log records according to conditions in search params.`,
 				Parameters: []smd.JSONSchema{
 					{
 						Name:        "search",
 						Optional:    true,
-						Description: `NewsSearch`,
+						Description: `AuditLogSearch`,
 						Type:        smd.Object,
-						TypeName:    "NewsSearch",
+						TypeName:    "AuditLogSearch",
 						Properties: smd.PropertyList{
 							{
-								Name:     "id",
+								Name:     "userId",
 								Optional: true,
 								Type:     smd.Integer,
 							},
 							{
-								Name:     "title",
+								Name:     "namespace",
 								Optional: true,
 								Type:     smd.String,
 							},
 							{
-								Name:     "shortText",
+								Name:     "method",
 								Optional: true,
 								Type:     smd.String,
 							},
 							{
-								Name:     "content",
+								Name:     "entityId",
 								Optional: true,
-								Type:     smd.String,
+								Type:     smd.Integer,
 							},
 							{
-								Name:     "author",
+								Name:     "isSuccess",
 								Optional: true,
-								Type:     smd.String,
+								Type:     smd.Boolean,
 							},
 							{
-								Name:     "categoryId",
+								Name:     "createdAtFrom",
 								Optional: true,
-								Type:     smd.Integer,
+								Type:     smd.String,
 							},
 							{
-								Name:     "publishedAt",
-								Optional: true,
-								Type:     smd.String,
-							},
-							{
-								Name:     "createdAt",
-								Optional: true,
-								Type:     smd.String,
-							},
-							{
-								Name:     "statusId",
-								Optional: true,
-								Type:     smd.Integer,
-							},
-							{
-								Name: "ids",
-								Type: smd.Array,
-								Items: map[string]string{
-									"type": smd.Integer,
-								},
-							},
-							{
-								Name:     "tagId",
-								Optional: true,
-								Type:     smd.Integer,
-							},
-							{
-								Name:     "publishedBefore",
+								Name:     "createdAtTo",
 								Optional: true,
 								Type:     smd.String,
 							},
@@ -834,74 +1380,47 @@ func (NewsService) SMD() smd.ServiceInfo {
 				},
 			},
 			"Get": {
-				Description: `Get returns а list of News according to conditions in search params.`,
+				Description: `Get returns а list of audit log records according to conditions in search params, latest first.`,
 				Parameters: []smd.JSONSchema{
 					{
 						Name:        "search",
 						Optional:    true,
-						Description: `NewsSearch`,
+						Description: `AuditLogSearch`,
 						Type:        smd.Object,
-						TypeName:    "NewsSearch",
+						TypeName:    "AuditLogSearch",
 						Properties: smd.PropertyList{
 							{
-								Name:     "id",
+								Name:     "userId",
 								Optional: true,
 								Type:     smd.Integer,
 							},
 							{
-								Name:     "title",
-								Optional: true,
-								Type:     smd.String,
-							},
-							{
-								Name:     "shortText",
-								Optional: true,
-								Type:     smd.String,
-							},
-							{
-								Name:     "content",
+								Name:     "namespace",
 								Optional: true,
 								Type:     smd.String,
 							},
 							{
-								Name:     "author",
+								Name:     "method",
 								Optional: true,
 								Type:     smd.String,
 							},
 							{
-								Name:     "categoryId",
+								Name:     "entityId",
 								Optional: true,
 								Type:     smd.Integer,
 							},
 							{
-								Name:     "publishedAt",
+								Name:     "isSuccess",
 								Optional: true,
-								Type:     smd.String,
+								Type:     smd.Boolean,
 							},
 							{
-								Name:     "createdAt",
+								Name:     "createdAtFrom",
 								Optional: true,
 								Type:     smd.String,
 							},
 							{
-								Name:     "statusId",
-								Optional: true,
-								Type:     smd.Integer,
-							},
-							{
-								Name: "ids",
-								Type: smd.Array,
-								Items: map[string]string{
-									"type": smd.Integer,
-								},
-							},
-							{
-								Name:     "tagId",
-								Optional: true,
-								Type:     smd.Integer,
-							},
-							{
-								Name:     "publishedBefore",
+								Name:     "createdAtTo",
 								Optional: true,
 								Type:     smd.String,
 							},
@@ -938,14 +1457,14 @@ func (NewsService) SMD() smd.ServiceInfo {
 					},
 				},
 				Returns: smd.JSONSchema{
-					Description: `[]NewsSummary`,
+					Description: `[]AuditLog`,
 					Type:        smd.Array,
-					TypeName:    "[]NewsSummary",
+					TypeName:    "[]AuditLog",
 					Items: map[string]string{
-						"$ref": "#/definitions/NewsSummary",
+						"$ref": "#/definitions/AuditLog",
 					},
 					Definitions: map[string]smd.Definition{
-						"NewsSummary": {
+						"AuditLog": {
 							Type: "object",
 							Properties: smd.PropertyList{
 								{
@@ -953,74 +1472,60 @@ func (NewsService) SMD() smd.ServiceInfo {
 									Type: smd.Integer,
 								},
 								{
-									Name: "title",
-									Type: smd.String,
+									Name:     "userId",
+									Optional: true,
+									Type:     smd.Integer,
 								},
 								{
-									Name: "shortText",
+									Name: "ip",
 									Type: smd.String,
 								},
 								{
-									Name:     "content",
-									Optional: true,
-									Type:     smd.String,
-								},
-								{
-									Name:     "author",
-									Optional: true,
-									Type:     smd.String,
+									Name: "namespace",
+									Type: smd.String,
 								},
 								{
-									Name: "categoryId",
-									Type: smd.Integer,
+									Name: "method",
+									Type: smd.String,
 								},
 								{
-									Name: "publishedAt",
-									Type: smd.String,
+									Name:     "entityId",
+									Optional: true,
+									Type:     smd.Integer,
 								},
 								{
-									Name: "createdAt",
-									Type: smd.String,
+									Name:        "params",
+									Optional:    true,
+									Description: `Params is a JSON of method params with redacted secrets.`,
+									Type:        smd.String,
 								},
 								{
-									Name:     "category",
-									Optional: true,
-									Ref:      "#/definitions/CategorySummary",
-									Type:     smd.Object,
+									Name: "isSuccess",
+									Type: smd.Boolean,
 								},
 								{
-									Name:     "status",
+									Name:     "errorCode",
 									Optional: true,
-									Ref:      "#/definitions/Status",
-									Type:     smd.Object,
+									Type:     smd.Integer,
 								},
-							},
-						},
-						"CategorySummary": {
-							Type: "object",
-							Properties: smd.PropertyList{
 								{
-									Name: "id",
-									Type: smd.Integer,
+									Name:        "duration",
+									Description: `Duration is a method call duration in milliseconds.`,
+									Type:        smd.Integer,
 								},
 								{
-									Name: "title",
+									Name: "createdAt",
 									Type: smd.String,
 								},
 								{
-									Name:     "sort",
-									Optional: true,
-									Type:     smd.Integer,
-								},
-								{
-									Name:     "status",
+									Name:     "user",
 									Optional: true,
-									Ref:      "#/definitions/Status",
+									Ref:      "#/definitions/UserSummary",
 									Type:     smd.Object,
 								},
 							},
 						},
-						"Status": {
+						"UserSummary": {
 							Type: "object",
 							Properties: smd.PropertyList{
 								{
@@ -1028,110 +1533,32 @@ func (NewsService) SMD() smd.ServiceInfo {
 									Type: smd.Integer,
 								},
 								{
-									Name: "alias",
+									Name: "createdAt",
 									Type: smd.String,
 								},
 								{
-									Name: "title",
+									Name: "login",
 									Type: smd.String,
 								},
-							},
-						},
-					},
-				},
-				Errors: map[int]string{
-					500: "Internal Error",
-				},
-			},
-			"GetByID": {
-				Description: `GetByID returns a News by its ID.`,
-				Parameters: []smd.JSONSchema{
-					{
-						Name:        "id",
-						Description: `int`,
-						Type:        smd.Integer,
-					},
-				},
-				Returns: smd.JSONSchema{
-					Description: `News`,
-					Optional:    true,
-					Type:        smd.Object,
-					TypeName:    "News",
-					Properties: smd.PropertyList{
-						{
-							Name: "id",
-							Type: smd.Integer,
-						},
-						{
-							Name: "title",
-							Type: smd.String,
-						},
-						{
-							Name: "shortText",
-							Type: smd.String,
-						},
-						{
-							Name:     "content",
-							Optional: true,
-							Type:     smd.String,
-						},
-						{
-							Name:     "author",
-							Optional: true,
-							Type:     smd.String,
-						},
-						{
-							Name: "categoryId",
-							Type: smd.Integer,
-						},
-						{
-							Name: "tagIds",
-							Type: smd.Array,
-							Items: map[string]string{
-								"type": smd.Integer,
-							},
-						},
-						{
-							Name: "publishedAt",
-							Type: smd.String,
-						},
-						{
-							Name: "createdAt",
-							Type: smd.String,
-						},
-						{
-							Name: "statusId",
-							Type: smd.Integer,
-						},
-						{
-							Name:     "category",
-							Optional: true,
-							Ref:      "#/definitions/CategorySummary",
-							Type:     smd.Object,
-						},
-						{
-							Name:     "status",
-							Optional: true,
-							Ref:      "#/definitions/Status",
-							Type:     smd.Object,
-						},
-					},
-					Definitions: map[string]smd.Definition{
-						"CategorySummary": {
-							Type: "object",
-							Properties: smd.PropertyList{
 								{
-									Name: "id",
-									Type: smd.Integer,
+									Name:     "lastActivityAt",
+									Optional: true,
+									Type:     smd.String,
 								},
 								{
-									Name: "title",
-									Type: smd.String,
+									Name: "roleIds",
+									Type: smd.Array,
+									Items: map[string]string{
+										"type": smd.Integer,
+									},
 								},
 								{
-									Name:     "sort",
-									Optional: true,
-									Type:     smd.Integer,
+									Name: "isTotpEnabled",
+									Type: smd.Boolean,
+								},
+								{
+									Name: "isService",
+									Type: smd.Boolean,
 								},
 								{
 									Name:     "status",
@@ -1162,68 +1589,5827 @@ func (NewsService) SMD() smd.ServiceInfo {
 				},
 				Errors: map[int]string{
 					500: "Internal Error",
-					404: "Not Found",
 				},
 			},
-			"Add": {
-				Description: `Add adds a News from the query.`,
-				Parameters: []smd.JSONSchema{
-					{
-						Name:        "news",
-						Description: `News`,
-						Type:        smd.Object,
-						TypeName:    "News",
-						Properties: smd.PropertyList{
-							{
-								Name: "id",
-								Type: smd.Integer,
-							},
-							{
-								Name: "title",
-								Type: smd.String,
-							},
-							{
-								Name: "shortText",
-								Type: smd.String,
-							},
-							{
-								Name:     "content",
-								Optional: true,
-								Type:     smd.String,
-							},
-							{
-								Name:     "author",
-								Optional: true,
-								Type:     smd.String,
-							},
-							{
+		},
+	}
+}
+
+// Invoke is as generated code from zenrpc cmd
+func (s AuditService) Invoke(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
+	resp := zenrpc.Response{}
+	var err error
+
+	switch method {
+	case RPC.AuditService.Count:
+		var args = struct {
+			Search *AuditLogSearch `json:"search"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"search"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Count(ctx, args.Search))
+
+	case RPC.AuditService.Get:
+		var args = struct {
+			Search  *AuditLogSearch `json:"search"`
+			ViewOps *ViewOps        `json:"viewOps"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"search", "viewOps"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Get(ctx, args.Search, args.ViewOps))
+
+	default:
+		resp = zenrpc.NewResponseError(nil, zenrpc.MethodNotFound, "", nil)
+	}
+
+	return resp
+}
+
+func (CategoryService) SMD() smd.ServiceInfo {
+	return smd.ServiceInfo{
+		Methods: map[string]smd.Service{
+			"Count": {
+				Description: `Count returns count Categories according to conditions in search params.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "search",
+						Optional:    true,
+						Description: `CategorySearch`,
+						Type:        smd.Object,
+						TypeName:    "CategorySearch",
+						Properties: smd.PropertyList{
+							{
+								Name:     "id",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "title",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "sort",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "statusId",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name: "ids",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `int`,
+					Type:        smd.Integer,
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+				},
+			},
+			"Get": {
+				Description: `Get returns а list of Categories according to conditions in search params.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "search",
+						Optional:    true,
+						Description: `CategorySearch`,
+						Type:        smd.Object,
+						TypeName:    "CategorySearch",
+						Properties: smd.PropertyList{
+							{
+								Name:     "id",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "title",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "sort",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "statusId",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name: "ids",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+						},
+					},
+					{
+						Name:        "viewOps",
+						Optional:    true,
+						Description: `ViewOps`,
+						Type:        smd.Object,
+						TypeName:    "ViewOps",
+						Properties: smd.PropertyList{
+							{
+								Name:        "page",
+								Description: `page number, default - 1`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "pageSize",
+								Description: `items count per page, max - 500`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "sortColumn",
+								Description: `sort by column name`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "sortDesc",
+								Description: `descending sort`,
+								Type:        smd.Boolean,
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `[]CategorySummary`,
+					Type:        smd.Array,
+					TypeName:    "[]CategorySummary",
+					Items: map[string]string{
+						"$ref": "#/definitions/CategorySummary",
+					},
+					Definitions: map[string]smd.Definition{
+						"CategorySummary": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "slug",
+									Type: smd.String,
+								},
+								{
+									Name:     "sort",
+									Optional: true,
+									Type:     smd.Integer,
+								},
+								{
+									Name:     "status",
+									Optional: true,
+									Ref:      "#/definitions/Status",
+									Type:     smd.Object,
+								},
+							},
+						},
+						"Status": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "alias",
+									Type: smd.String,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+				},
+			},
+			"GetByID": {
+				Description: `GetByID returns a Category by its ID.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `int`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `Category`,
+					Optional:    true,
+					Type:        smd.Object,
+					TypeName:    "Category",
+					Properties: smd.PropertyList{
+						{
+							Name: "id",
+							Type: smd.Integer,
+						},
+						{
+							Name: "title",
+							Type: smd.String,
+						},
+						{
+							Name: "slug",
+							Type: smd.String,
+						},
+						{
+							Name:     "sort",
+							Optional: true,
+							Type:     smd.Integer,
+						},
+						{
+							Name: "statusId",
+							Type: smd.Integer,
+						},
+						{
+							Name:     "status",
+							Optional: true,
+							Ref:      "#/definitions/Status",
+							Type:     smd.Object,
+						},
+					},
+					Definitions: map[string]smd.Definition{
+						"Status": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "alias",
+									Type: smd.String,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					404: "Not Found",
+				},
+			},
+			"Add": {
+				Description: `Add adds a Category from the query.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "category",
+						Description: `Category`,
+						Type:        smd.Object,
+						TypeName:    "Category",
+						Properties: smd.PropertyList{
+							{
+								Name: "id",
+								Type: smd.Integer,
+							},
+							{
+								Name: "title",
+								Type: smd.String,
+							},
+							{
+								Name: "slug",
+								Type: smd.String,
+							},
+							{
+								Name:     "sort",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name: "statusId",
+								Type: smd.Integer,
+							},
+							{
+								Name:     "status",
+								Optional: true,
+								Ref:      "#/definitions/Status",
+								Type:     smd.Object,
+							},
+						},
+						Definitions: map[string]smd.Definition{
+							"Status": {
+								Type: "object",
+								Properties: smd.PropertyList{
+									{
+										Name: "id",
+										Type: smd.Integer,
+									},
+									{
+										Name: "alias",
+										Type: smd.String,
+									},
+									{
+										Name: "title",
+										Type: smd.String,
+									},
+								},
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `Category`,
+					Optional:    true,
+					Type:        smd.Object,
+					TypeName:    "Category",
+					Properties: smd.PropertyList{
+						{
+							Name: "id",
+							Type: smd.Integer,
+						},
+						{
+							Name: "title",
+							Type: smd.String,
+						},
+						{
+							Name: "slug",
+							Type: smd.String,
+						},
+						{
+							Name:     "sort",
+							Optional: true,
+							Type:     smd.Integer,
+						},
+						{
+							Name: "statusId",
+							Type: smd.Integer,
+						},
+						{
+							Name:     "status",
+							Optional: true,
+							Ref:      "#/definitions/Status",
+							Type:     smd.Object,
+						},
+					},
+					Definitions: map[string]smd.Definition{
+						"Status": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "alias",
+									Type: smd.String,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+				},
+			},
+			"Update": {
+				Description: `Update updates the Category data identified by id from the query.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:     "category",
+						Type:     smd.Object,
+						TypeName: "Category",
+						Properties: smd.PropertyList{
+							{
+								Name: "id",
+								Type: smd.Integer,
+							},
+							{
+								Name: "title",
+								Type: smd.String,
+							},
+							{
+								Name: "slug",
+								Type: smd.String,
+							},
+							{
+								Name:     "sort",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name: "statusId",
+								Type: smd.Integer,
+							},
+							{
+								Name:     "status",
+								Optional: true,
+								Ref:      "#/definitions/Status",
+								Type:     smd.Object,
+							},
+						},
+						Definitions: map[string]smd.Definition{
+							"Status": {
+								Type: "object",
+								Properties: smd.PropertyList{
+									{
+										Name: "id",
+										Type: smd.Integer,
+									},
+									{
+										Name: "alias",
+										Type: smd.String,
+									},
+									{
+										Name: "title",
+										Type: smd.String,
+									},
+								},
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `Category`,
+					Type:        smd.Boolean,
+					TypeName:    "Category",
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+					404: "Not Found",
+				},
+			},
+			"Delete": {
+				Description: `Delete deletes the Category by its ID.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `int`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `isDeleted`,
+					Type:        smd.Boolean,
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+					404: "Not Found",
+				},
+			},
+			"UpdateStatus": {
+				Description: `UpdateStatus sets status of the Categories by their IDs in a single transaction.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "statusUpdate",
+						Description: `StatusUpdate`,
+						Type:        smd.Object,
+						TypeName:    "StatusUpdate",
+						Properties: smd.PropertyList{
+							{
+								Name: "statusId",
+								Type: smd.Integer,
+							},
+							{
+								Name: "ids",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `[]BulkResult`,
+					Type:        smd.Array,
+					TypeName:    "[]BulkResult",
+					Items: map[string]string{
+						"$ref": "#/definitions/BulkResult",
+					},
+					Definitions: map[string]smd.Definition{
+						"BulkResult": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "isChanged",
+									Type: smd.Boolean,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+				},
+			},
+			"DeleteMany": {
+				Description: `DeleteMany deletes the Categories by their IDs in a single transaction.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "ids",
+						Description: `[]int`,
+						Type:        smd.Array,
+						TypeName:    "[]",
+						Items: map[string]string{
+							"type": smd.Integer,
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `[]BulkResult`,
+					Type:        smd.Array,
+					TypeName:    "[]BulkResult",
+					Items: map[string]string{
+						"$ref": "#/definitions/BulkResult",
+					},
+					Definitions: map[string]smd.Definition{
+						"BulkResult": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "isChanged",
+									Type: smd.Boolean,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+				},
+			},
+			"Validate": {
+				Description: `Validate verifies that Category data is valid.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "category",
+						Description: `Category`,
+						Type:        smd.Object,
+						TypeName:    "Category",
+						Properties: smd.PropertyList{
+							{
+								Name: "id",
+								Type: smd.Integer,
+							},
+							{
+								Name: "title",
+								Type: smd.String,
+							},
+							{
+								Name: "slug",
+								Type: smd.String,
+							},
+							{
+								Name:     "sort",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name: "statusId",
+								Type: smd.Integer,
+							},
+							{
+								Name:     "status",
+								Optional: true,
+								Ref:      "#/definitions/Status",
+								Type:     smd.Object,
+							},
+						},
+						Definitions: map[string]smd.Definition{
+							"Status": {
+								Type: "object",
+								Properties: smd.PropertyList{
+									{
+										Name: "id",
+										Type: smd.Integer,
+									},
+									{
+										Name: "alias",
+										Type: smd.String,
+									},
+									{
+										Name: "title",
+										Type: smd.String,
+									},
+								},
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `[]FieldError`,
+					Type:        smd.Array,
+					TypeName:    "[]FieldError",
+					Items: map[string]string{
+						"$ref": "#/definitions/FieldError",
+					},
+					Definitions: map[string]smd.Definition{
+						"FieldError": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "field",
+									Type: smd.String,
+								},
+								{
+									Name: "error",
+									Type: smd.String,
+								},
+								{
+									Name:        "constraint",
+									Optional:    true,
+									Description: `Help with generating an error message.`,
+									Ref:         "#/definitions/FieldErrorConstraint",
+									Type:        smd.Object,
+								},
+							},
+						},
+						"FieldErrorConstraint": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name:        "max",
+									Description: `Max value for field.`,
+									Type:        smd.Integer,
+								},
+								{
+									Name:        "min",
+									Description: `Min value for field.`,
+									Type:        smd.Integer,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+				},
+			},
+		},
+	}
+}
+
+// Invoke is as generated code from zenrpc cmd
+func (s CategoryService) Invoke(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
+	resp := zenrpc.Response{}
+	var err error
+
+	switch method {
+	case RPC.CategoryService.Count:
+		var args = struct {
+			Search *CategorySearch `json:"search"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"search"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Count(ctx, args.Search))
+
+	case RPC.CategoryService.Get:
+		var args = struct {
+			Search  *CategorySearch `json:"search"`
+			ViewOps *ViewOps        `json:"viewOps"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"search", "viewOps"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Get(ctx, args.Search, args.ViewOps))
+
+	case RPC.CategoryService.GetByID:
+		var args = struct {
+			Id int `json:"id"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"id"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.GetByID(ctx, args.Id))
+
+	case RPC.CategoryService.Add:
+		var args = struct {
+			Category Category `json:"category"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"category"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Add(ctx, args.Category))
+
+	case RPC.CategoryService.Update:
+		var args = struct {
+			Category Category `json:"category"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"category"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Update(ctx, args.Category))
+
+	case RPC.CategoryService.Delete:
+		var args = struct {
+			Id int `json:"id"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"id"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Delete(ctx, args.Id))
+
+	case RPC.CategoryService.UpdateStatus:
+		var args = struct {
+			StatusUpdate StatusUpdate `json:"statusUpdate"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"statusUpdate"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.UpdateStatus(ctx, args.StatusUpdate))
+
+	case RPC.CategoryService.DeleteMany:
+		var args = struct {
+			Ids []int `json:"ids"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"ids"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.DeleteMany(ctx, args.Ids))
+
+	case RPC.CategoryService.Validate:
+		var args = struct {
+			Category Category `json:"category"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"category"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Validate(ctx, args.Category))
+
+	default:
+		resp = zenrpc.NewResponseError(nil, zenrpc.MethodNotFound, "", nil)
+	}
+
+	return resp
+}
+
+func (NewsService) SMD() smd.ServiceInfo {
+	return smd.ServiceInfo{
+		Methods: map[string]smd.Service{
+			"Count": {
+				Description: `Count returns count News according to conditions in search params.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "search",
+						Optional:    true,
+						Description: `NewsSearch`,
+						Type:        smd.Object,
+						TypeName:    "NewsSearch",
+						Properties: smd.PropertyList{
+							{
+								Name:     "id",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "title",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "shortText",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "content",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "author",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "categoryId",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "publishedAt",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "createdAt",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "statusId",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name: "ids",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+							{
+								Name:     "tagId",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "publishedBefore",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:        "query",
+								Optional:    true,
+								Description: `full-text search query over title, shortText and content`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "categoryIds",
+								Description: `news from any of the categories`,
+								Type:        smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+							{
+								Name:        "tagIds",
+								Description: `news tagged with any (default) or all of the tags, see tagMatch`,
+								Type:        smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+							{
+								Name:     "tagMatch",
+								Optional: true,
+								Type:     smd.String,
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `int`,
+					Type:        smd.Integer,
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+				},
+			},
+			"Get": {
+				Description: `Get returns а list of News according to conditions in search params.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "search",
+						Optional:    true,
+						Description: `NewsSearch`,
+						Type:        smd.Object,
+						TypeName:    "NewsSearch",
+						Properties: smd.PropertyList{
+							{
+								Name:     "id",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "title",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "shortText",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "content",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "author",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "categoryId",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "publishedAt",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "createdAt",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "statusId",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name: "ids",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+							{
+								Name:     "tagId",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "publishedBefore",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:        "query",
+								Optional:    true,
+								Description: `full-text search query over title, shortText and content`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "categoryIds",
+								Description: `news from any of the categories`,
+								Type:        smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+							{
+								Name:        "tagIds",
+								Description: `news tagged with any (default) or all of the tags, see tagMatch`,
+								Type:        smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+							{
+								Name:     "tagMatch",
+								Optional: true,
+								Type:     smd.String,
+							},
+						},
+					},
+					{
+						Name:        "viewOps",
+						Optional:    true,
+						Description: `ViewOps`,
+						Type:        smd.Object,
+						TypeName:    "ViewOps",
+						Properties: smd.PropertyList{
+							{
+								Name:        "page",
+								Description: `page number, default - 1`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "pageSize",
+								Description: `items count per page, max - 500`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "sortColumn",
+								Description: `sort by column name`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "sortDesc",
+								Description: `descending sort`,
+								Type:        smd.Boolean,
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `[]NewsSummary`,
+					Type:        smd.Array,
+					TypeName:    "[]NewsSummary",
+					Items: map[string]string{
+						"$ref": "#/definitions/NewsSummary",
+					},
+					Definitions: map[string]smd.Definition{
+						"NewsSummary": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "slug",
+									Type: smd.String,
+								},
+								{
+									Name: "shortText",
+									Type: smd.String,
+								},
+								{
+									Name:     "content",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name:     "author",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name: "categoryId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "publishedAt",
+									Type: smd.String,
+								},
+								{
+									Name: "createdAt",
+									Type: smd.String,
+								},
+								{
+									Name:     "category",
+									Optional: true,
+									Ref:      "#/definitions/CategorySummary",
+									Type:     smd.Object,
+								},
+								{
+									Name:     "status",
+									Optional: true,
+									Ref:      "#/definitions/Status",
+									Type:     smd.Object,
+								},
+							},
+						},
+						"CategorySummary": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "slug",
+									Type: smd.String,
+								},
+								{
+									Name:     "sort",
+									Optional: true,
+									Type:     smd.Integer,
+								},
+								{
+									Name:     "status",
+									Optional: true,
+									Ref:      "#/definitions/Status",
+									Type:     smd.Object,
+								},
+							},
+						},
+						"Status": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "alias",
+									Type: smd.String,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+				},
+			},
+			"GetByID": {
+				Description: `GetByID returns a News by its ID.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `int`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `News`,
+					Optional:    true,
+					Type:        smd.Object,
+					TypeName:    "News",
+					Properties: smd.PropertyList{
+						{
+							Name: "id",
+							Type: smd.Integer,
+						},
+						{
+							Name: "title",
+							Type: smd.String,
+						},
+						{
+							Name: "slug",
+							Type: smd.String,
+						},
+						{
+							Name: "shortText",
+							Type: smd.String,
+						},
+						{
+							Name:     "content",
+							Optional: true,
+							Type:     smd.String,
+						},
+						{
+							Name:     "author",
+							Optional: true,
+							Type:     smd.String,
+						},
+						{
+							Name: "categoryId",
+							Type: smd.Integer,
+						},
+						{
+							Name: "tagIds",
+							Type: smd.Array,
+							Items: map[string]string{
+								"type": smd.Integer,
+							},
+						},
+						{
+							Name: "publishedAt",
+							Type: smd.String,
+						},
+						{
+							Name: "createdAt",
+							Type: smd.String,
+						},
+						{
+							Name: "statusId",
+							Type: smd.Integer,
+						},
+						{
+							Name:     "category",
+							Optional: true,
+							Ref:      "#/definitions/CategorySummary",
+							Type:     smd.Object,
+						},
+						{
+							Name:     "status",
+							Optional: true,
+							Ref:      "#/definitions/Status",
+							Type:     smd.Object,
+						},
+					},
+					Definitions: map[string]smd.Definition{
+						"CategorySummary": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "slug",
+									Type: smd.String,
+								},
+								{
+									Name:     "sort",
+									Optional: true,
+									Type:     smd.Integer,
+								},
+								{
+									Name:     "status",
+									Optional: true,
+									Ref:      "#/definitions/Status",
+									Type:     smd.Object,
+								},
+							},
+						},
+						"Status": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "alias",
+									Type: smd.String,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					404: "Not Found",
+				},
+			},
+			"Add": {
+				Description: `Add adds a News from the query.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "news",
+						Description: `News`,
+						Type:        smd.Object,
+						TypeName:    "News",
+						Properties: smd.PropertyList{
+							{
+								Name: "id",
+								Type: smd.Integer,
+							},
+							{
+								Name: "title",
+								Type: smd.String,
+							},
+							{
+								Name: "slug",
+								Type: smd.String,
+							},
+							{
+								Name: "shortText",
+								Type: smd.String,
+							},
+							{
+								Name:     "content",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "author",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name: "categoryId",
+								Type: smd.Integer,
+							},
+							{
+								Name: "tagIds",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+							{
+								Name: "publishedAt",
+								Type: smd.String,
+							},
+							{
+								Name: "createdAt",
+								Type: smd.String,
+							},
+							{
+								Name: "statusId",
+								Type: smd.Integer,
+							},
+							{
+								Name:     "category",
+								Optional: true,
+								Ref:      "#/definitions/CategorySummary",
+								Type:     smd.Object,
+							},
+							{
+								Name:     "status",
+								Optional: true,
+								Ref:      "#/definitions/Status",
+								Type:     smd.Object,
+							},
+						},
+						Definitions: map[string]smd.Definition{
+							"CategorySummary": {
+								Type: "object",
+								Properties: smd.PropertyList{
+									{
+										Name: "id",
+										Type: smd.Integer,
+									},
+									{
+										Name: "title",
+										Type: smd.String,
+									},
+									{
+										Name: "slug",
+										Type: smd.String,
+									},
+									{
+										Name:     "sort",
+										Optional: true,
+										Type:     smd.Integer,
+									},
+									{
+										Name:     "status",
+										Optional: true,
+										Ref:      "#/definitions/Status",
+										Type:     smd.Object,
+									},
+								},
+							},
+							"Status": {
+								Type: "object",
+								Properties: smd.PropertyList{
+									{
+										Name: "id",
+										Type: smd.Integer,
+									},
+									{
+										Name: "alias",
+										Type: smd.String,
+									},
+									{
+										Name: "title",
+										Type: smd.String,
+									},
+								},
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `News`,
+					Optional:    true,
+					Type:        smd.Object,
+					TypeName:    "News",
+					Properties: smd.PropertyList{
+						{
+							Name: "id",
+							Type: smd.Integer,
+						},
+						{
+							Name: "title",
+							Type: smd.String,
+						},
+						{
+							Name: "slug",
+							Type: smd.String,
+						},
+						{
+							Name: "shortText",
+							Type: smd.String,
+						},
+						{
+							Name:     "content",
+							Optional: true,
+							Type:     smd.String,
+						},
+						{
+							Name:     "author",
+							Optional: true,
+							Type:     smd.String,
+						},
+						{
+							Name: "categoryId",
+							Type: smd.Integer,
+						},
+						{
+							Name: "tagIds",
+							Type: smd.Array,
+							Items: map[string]string{
+								"type": smd.Integer,
+							},
+						},
+						{
+							Name: "publishedAt",
+							Type: smd.String,
+						},
+						{
+							Name: "createdAt",
+							Type: smd.String,
+						},
+						{
+							Name: "statusId",
+							Type: smd.Integer,
+						},
+						{
+							Name:     "category",
+							Optional: true,
+							Ref:      "#/definitions/CategorySummary",
+							Type:     smd.Object,
+						},
+						{
+							Name:     "status",
+							Optional: true,
+							Ref:      "#/definitions/Status",
+							Type:     smd.Object,
+						},
+					},
+					Definitions: map[string]smd.Definition{
+						"CategorySummary": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "slug",
+									Type: smd.String,
+								},
+								{
+									Name:     "sort",
+									Optional: true,
+									Type:     smd.Integer,
+								},
+								{
+									Name:     "status",
+									Optional: true,
+									Ref:      "#/definitions/Status",
+									Type:     smd.Object,
+								},
+							},
+						},
+						"Status": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "alias",
+									Type: smd.String,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+				},
+			},
+			"Update": {
+				Description: `Update updates the News data identified by id from the query.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:     "news",
+						Type:     smd.Object,
+						TypeName: "News",
+						Properties: smd.PropertyList{
+							{
+								Name: "id",
+								Type: smd.Integer,
+							},
+							{
+								Name: "title",
+								Type: smd.String,
+							},
+							{
+								Name: "slug",
+								Type: smd.String,
+							},
+							{
+								Name: "shortText",
+								Type: smd.String,
+							},
+							{
+								Name:     "content",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "author",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name: "categoryId",
+								Type: smd.Integer,
+							},
+							{
+								Name: "tagIds",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+							{
+								Name: "publishedAt",
+								Type: smd.String,
+							},
+							{
+								Name: "createdAt",
+								Type: smd.String,
+							},
+							{
+								Name: "statusId",
+								Type: smd.Integer,
+							},
+							{
+								Name:     "category",
+								Optional: true,
+								Ref:      "#/definitions/CategorySummary",
+								Type:     smd.Object,
+							},
+							{
+								Name:     "status",
+								Optional: true,
+								Ref:      "#/definitions/Status",
+								Type:     smd.Object,
+							},
+						},
+						Definitions: map[string]smd.Definition{
+							"CategorySummary": {
+								Type: "object",
+								Properties: smd.PropertyList{
+									{
+										Name: "id",
+										Type: smd.Integer,
+									},
+									{
+										Name: "title",
+										Type: smd.String,
+									},
+									{
+										Name: "slug",
+										Type: smd.String,
+									},
+									{
+										Name:     "sort",
+										Optional: true,
+										Type:     smd.Integer,
+									},
+									{
+										Name:     "status",
+										Optional: true,
+										Ref:      "#/definitions/Status",
+										Type:     smd.Object,
+									},
+								},
+							},
+							"Status": {
+								Type: "object",
+								Properties: smd.PropertyList{
+									{
+										Name: "id",
+										Type: smd.Integer,
+									},
+									{
+										Name: "alias",
+										Type: smd.String,
+									},
+									{
+										Name: "title",
+										Type: smd.String,
+									},
+								},
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `News`,
+					Type:        smd.Boolean,
+					TypeName:    "News",
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+					404: "Not Found",
+				},
+			},
+			"Delete": {
+				Description: `Delete deletes the News by its ID.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `int`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `isDeleted`,
+					Type:        smd.Boolean,
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+					404: "Not Found",
+				},
+			},
+			"UpdateStatus": {
+				Description: `UpdateStatus sets status of the News by their IDs in a single transaction, a revision is saved for every changed News.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "statusUpdate",
+						Description: `StatusUpdate`,
+						Type:        smd.Object,
+						TypeName:    "StatusUpdate",
+						Properties: smd.PropertyList{
+							{
+								Name: "statusId",
+								Type: smd.Integer,
+							},
+							{
+								Name: "ids",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `[]BulkResult`,
+					Type:        smd.Array,
+					TypeName:    "[]BulkResult",
+					Items: map[string]string{
+						"$ref": "#/definitions/BulkResult",
+					},
+					Definitions: map[string]smd.Definition{
+						"BulkResult": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "isChanged",
+									Type: smd.Boolean,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+				},
+			},
+			"DeleteMany": {
+				Description: `DeleteMany deletes the News by their IDs in a single transaction.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "ids",
+						Description: `[]int`,
+						Type:        smd.Array,
+						TypeName:    "[]",
+						Items: map[string]string{
+							"type": smd.Integer,
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `[]BulkResult`,
+					Type:        smd.Array,
+					TypeName:    "[]BulkResult",
+					Items: map[string]string{
+						"$ref": "#/definitions/BulkResult",
+					},
+					Definitions: map[string]smd.Definition{
+						"BulkResult": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "isChanged",
+									Type: smd.Boolean,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+				},
+			},
+			"Revisions": {
+				Description: `Revisions returns a list of the News revisions, latest first.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `int`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `[]NewsRevisionSummary`,
+					Type:        smd.Array,
+					TypeName:    "[]NewsRevisionSummary",
+					Items: map[string]string{
+						"$ref": "#/definitions/NewsRevisionSummary",
+					},
+					Definitions: map[string]smd.Definition{
+						"NewsRevisionSummary": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "newsId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "action",
+									Type: smd.String,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "createdAt",
+									Type: smd.String,
+								},
+								{
+									Name:     "user",
+									Optional: true,
+									Ref:      "#/definitions/UserSummary",
+									Type:     smd.Object,
+								},
+							},
+						},
+						"UserSummary": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "createdAt",
+									Type: smd.String,
+								},
+								{
+									Name: "login",
+									Type: smd.String,
+								},
+								{
+									Name:     "lastActivityAt",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name: "roleIds",
+									Type: smd.Array,
+									Items: map[string]string{
+										"type": smd.Integer,
+									},
+								},
+								{
+									Name: "isTotpEnabled",
+									Type: smd.Boolean,
+								},
+								{
+									Name: "isService",
+									Type: smd.Boolean,
+								},
+								{
+									Name:     "status",
+									Optional: true,
+									Ref:      "#/definitions/Status",
+									Type:     smd.Object,
+								},
+							},
+						},
+						"Status": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "alias",
+									Type: smd.String,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+				},
+			},
+			"Revision": {
+				Description: `Revision returns a News revision by its ID.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "revId",
+						Description: `int`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `NewsRevision`,
+					Optional:    true,
+					Type:        smd.Object,
+					TypeName:    "NewsRevision",
+					Properties: smd.PropertyList{
+						{
+							Name: "id",
+							Type: smd.Integer,
+						},
+						{
+							Name: "newsId",
+							Type: smd.Integer,
+						},
+						{
+							Name:     "userId",
+							Optional: true,
+							Type:     smd.Integer,
+						},
+						{
+							Name: "action",
+							Type: smd.String,
+						},
+						{
+							Name: "title",
+							Type: smd.String,
+						},
+						{
+							Name: "slug",
+							Type: smd.String,
+						},
+						{
+							Name: "shortText",
+							Type: smd.String,
+						},
+						{
+							Name:     "content",
+							Optional: true,
+							Type:     smd.String,
+						},
+						{
+							Name:     "author",
+							Optional: true,
+							Type:     smd.String,
+						},
+						{
+							Name: "categoryId",
+							Type: smd.Integer,
+						},
+						{
+							Name: "tagIds",
+							Type: smd.Array,
+							Items: map[string]string{
+								"type": smd.Integer,
+							},
+						},
+						{
+							Name: "publishedAt",
+							Type: smd.String,
+						},
+						{
+							Name: "newsStatusId",
+							Type: smd.Integer,
+						},
+						{
+							Name: "createdAt",
+							Type: smd.String,
+						},
+						{
+							Name:     "user",
+							Optional: true,
+							Ref:      "#/definitions/UserSummary",
+							Type:     smd.Object,
+						},
+						{
+							Name:     "newsStatus",
+							Optional: true,
+							Ref:      "#/definitions/Status",
+							Type:     smd.Object,
+						},
+					},
+					Definitions: map[string]smd.Definition{
+						"UserSummary": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "createdAt",
+									Type: smd.String,
+								},
+								{
+									Name: "login",
+									Type: smd.String,
+								},
+								{
+									Name:     "lastActivityAt",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name: "roleIds",
+									Type: smd.Array,
+									Items: map[string]string{
+										"type": smd.Integer,
+									},
+								},
+								{
+									Name: "isTotpEnabled",
+									Type: smd.Boolean,
+								},
+								{
+									Name: "isService",
+									Type: smd.Boolean,
+								},
+								{
+									Name:     "status",
+									Optional: true,
+									Ref:      "#/definitions/Status",
+									Type:     smd.Object,
+								},
+							},
+						},
+						"Status": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "alias",
+									Type: smd.String,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					404: "Not Found",
+				},
+			},
+			"RevisionDiff": {
+				Description: `RevisionDiff returns two revisions of the same News with a list of fields changed from fromRevId to toRevId.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "fromRevId",
+						Description: `int`,
+						Type:        smd.Integer,
+					},
+					{
+						Name:        "toRevId",
+						Description: `int`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `NewsRevisionDiff`,
+					Optional:    true,
+					Type:        smd.Object,
+					TypeName:    "NewsRevisionDiff",
+					Properties: smd.PropertyList{
+						{
+							Name:     "from",
+							Optional: true,
+							Ref:      "#/definitions/NewsRevision",
+							Type:     smd.Object,
+						},
+						{
+							Name:     "to",
+							Optional: true,
+							Ref:      "#/definitions/NewsRevision",
+							Type:     smd.Object,
+						},
+						{
+							Name:        "fields",
+							Description: `Fields is a list of changed fields: title, slug, shortText, content, author, categoryId, tagIds, publishedAt, newsStatusId.`,
+							Type:        smd.Array,
+							Items: map[string]string{
+								"type": smd.String,
+							},
+						},
+					},
+					Definitions: map[string]smd.Definition{
+						"NewsRevision": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "newsId",
+									Type: smd.Integer,
+								},
+								{
+									Name:     "userId",
+									Optional: true,
+									Type:     smd.Integer,
+								},
+								{
+									Name: "action",
+									Type: smd.String,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "slug",
+									Type: smd.String,
+								},
+								{
+									Name: "shortText",
+									Type: smd.String,
+								},
+								{
+									Name:     "content",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name:     "author",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name: "categoryId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "tagIds",
+									Type: smd.Array,
+									Items: map[string]string{
+										"type": smd.Integer,
+									},
+								},
+								{
+									Name: "publishedAt",
+									Type: smd.String,
+								},
+								{
+									Name: "newsStatusId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "createdAt",
+									Type: smd.String,
+								},
+								{
+									Name:     "user",
+									Optional: true,
+									Ref:      "#/definitions/UserSummary",
+									Type:     smd.Object,
+								},
+								{
+									Name:     "newsStatus",
+									Optional: true,
+									Ref:      "#/definitions/Status",
+									Type:     smd.Object,
+								},
+							},
+						},
+						"UserSummary": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "createdAt",
+									Type: smd.String,
+								},
+								{
+									Name: "login",
+									Type: smd.String,
+								},
+								{
+									Name:     "lastActivityAt",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name: "roleIds",
+									Type: smd.Array,
+									Items: map[string]string{
+										"type": smd.Integer,
+									},
+								},
+								{
+									Name: "isTotpEnabled",
+									Type: smd.Boolean,
+								},
+								{
+									Name: "isService",
+									Type: smd.Boolean,
+								},
+								{
+									Name:     "status",
+									Optional: true,
+									Ref:      "#/definitions/Status",
+									Type:     smd.Object,
+								},
+							},
+						},
+						"Status": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "alias",
+									Type: smd.String,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+					404: "Not Found",
+				},
+			},
+			"RestoreRevision": {
+				Description: `RestoreRevision restores the News data from its revision. Deleted news and revisions of deleted state can't be restored.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "revId",
+						Description: `int`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `isRestored`,
+					Type:        smd.Boolean,
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+					404: "Not Found",
+				},
+			},
+			"Validate": {
+				Description: `Validate verifies that News data is valid.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "news",
+						Description: `News`,
+						Type:        smd.Object,
+						TypeName:    "News",
+						Properties: smd.PropertyList{
+							{
+								Name: "id",
+								Type: smd.Integer,
+							},
+							{
+								Name: "title",
+								Type: smd.String,
+							},
+							{
+								Name: "slug",
+								Type: smd.String,
+							},
+							{
+								Name: "shortText",
+								Type: smd.String,
+							},
+							{
+								Name:     "content",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "author",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name: "categoryId",
+								Type: smd.Integer,
+							},
+							{
+								Name: "tagIds",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+							{
+								Name: "publishedAt",
+								Type: smd.String,
+							},
+							{
+								Name: "createdAt",
+								Type: smd.String,
+							},
+							{
+								Name: "statusId",
+								Type: smd.Integer,
+							},
+							{
+								Name:     "category",
+								Optional: true,
+								Ref:      "#/definitions/CategorySummary",
+								Type:     smd.Object,
+							},
+							{
+								Name:     "status",
+								Optional: true,
+								Ref:      "#/definitions/Status",
+								Type:     smd.Object,
+							},
+						},
+						Definitions: map[string]smd.Definition{
+							"CategorySummary": {
+								Type: "object",
+								Properties: smd.PropertyList{
+									{
+										Name: "id",
+										Type: smd.Integer,
+									},
+									{
+										Name: "title",
+										Type: smd.String,
+									},
+									{
+										Name: "slug",
+										Type: smd.String,
+									},
+									{
+										Name:     "sort",
+										Optional: true,
+										Type:     smd.Integer,
+									},
+									{
+										Name:     "status",
+										Optional: true,
+										Ref:      "#/definitions/Status",
+										Type:     smd.Object,
+									},
+								},
+							},
+							"Status": {
+								Type: "object",
+								Properties: smd.PropertyList{
+									{
+										Name: "id",
+										Type: smd.Integer,
+									},
+									{
+										Name: "alias",
+										Type: smd.String,
+									},
+									{
+										Name: "title",
+										Type: smd.String,
+									},
+								},
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `[]FieldError`,
+					Type:        smd.Array,
+					TypeName:    "[]FieldError",
+					Items: map[string]string{
+						"$ref": "#/definitions/FieldError",
+					},
+					Definitions: map[string]smd.Definition{
+						"FieldError": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "field",
+									Type: smd.String,
+								},
+								{
+									Name: "error",
+									Type: smd.String,
+								},
+								{
+									Name:        "constraint",
+									Optional:    true,
+									Description: `Help with generating an error message.`,
+									Ref:         "#/definitions/FieldErrorConstraint",
+									Type:        smd.Object,
+								},
+							},
+						},
+						"FieldErrorConstraint": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name:        "max",
+									Description: `Max value for field.`,
+									Type:        smd.Integer,
+								},
+								{
+									Name:        "min",
+									Description: `Min value for field.`,
+									Type:        smd.Integer,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+				},
+			},
+		},
+	}
+}
+
+// Invoke is as generated code from zenrpc cmd
+func (s NewsService) Invoke(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
+	resp := zenrpc.Response{}
+	var err error
+
+	switch method {
+	case RPC.NewsService.Count:
+		var args = struct {
+			Search *NewsSearch `json:"search"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"search"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Count(ctx, args.Search))
+
+	case RPC.NewsService.Get:
+		var args = struct {
+			Search  *NewsSearch `json:"search"`
+			ViewOps *ViewOps    `json:"viewOps"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"search", "viewOps"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Get(ctx, args.Search, args.ViewOps))
+
+	case RPC.NewsService.GetByID:
+		var args = struct {
+			Id int `json:"id"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"id"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.GetByID(ctx, args.Id))
+
+	case RPC.NewsService.Add:
+		var args = struct {
+			News News `json:"news"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"news"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Add(ctx, args.News))
+
+	case RPC.NewsService.Update:
+		var args = struct {
+			News News `json:"news"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"news"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Update(ctx, args.News))
+
+	case RPC.NewsService.Delete:
+		var args = struct {
+			Id int `json:"id"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"id"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Delete(ctx, args.Id))
+
+	case RPC.NewsService.UpdateStatus:
+		var args = struct {
+			StatusUpdate StatusUpdate `json:"statusUpdate"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"statusUpdate"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.UpdateStatus(ctx, args.StatusUpdate))
+
+	case RPC.NewsService.DeleteMany:
+		var args = struct {
+			Ids []int `json:"ids"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"ids"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.DeleteMany(ctx, args.Ids))
+
+	case RPC.NewsService.Revisions:
+		var args = struct {
+			Id int `json:"id"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"id"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Revisions(ctx, args.Id))
+
+	case RPC.NewsService.Revision:
+		var args = struct {
+			RevId int `json:"revId"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"revId"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Revision(ctx, args.RevId))
+
+	case RPC.NewsService.RevisionDiff:
+		var args = struct {
+			FromRevId int `json:"fromRevId"`
+			ToRevId   int `json:"toRevId"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"fromRevId", "toRevId"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.RevisionDiff(ctx, args.FromRevId, args.ToRevId))
+
+	case RPC.NewsService.RestoreRevision:
+		var args = struct {
+			RevId int `json:"revId"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"revId"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.RestoreRevision(ctx, args.RevId))
+
+	case RPC.NewsService.Validate:
+		var args = struct {
+			News News `json:"news"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"news"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Validate(ctx, args.News))
+
+	default:
+		resp = zenrpc.NewResponseError(nil, zenrpc.MethodNotFound, "", nil)
+	}
+
+	return resp
+}
+
+func (TagService) SMD() smd.ServiceInfo {
+	return smd.ServiceInfo{
+		Methods: map[string]smd.Service{
+			"Count": {
+				Description: `Count returns count Tags according to conditions in search params.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "search",
+						Optional:    true,
+						Description: `TagSearch`,
+						Type:        smd.Object,
+						TypeName:    "TagSearch",
+						Properties: smd.PropertyList{
+							{
+								Name:     "id",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "name",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "statusId",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name: "ids",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `int`,
+					Type:        smd.Integer,
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+				},
+			},
+			"Get": {
+				Description: `Get returns а list of Tags according to conditions in search params.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "search",
+						Optional:    true,
+						Description: `TagSearch`,
+						Type:        smd.Object,
+						TypeName:    "TagSearch",
+						Properties: smd.PropertyList{
+							{
+								Name:     "id",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "name",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "statusId",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name: "ids",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+						},
+					},
+					{
+						Name:        "viewOps",
+						Optional:    true,
+						Description: `ViewOps`,
+						Type:        smd.Object,
+						TypeName:    "ViewOps",
+						Properties: smd.PropertyList{
+							{
+								Name:        "page",
+								Description: `page number, default - 1`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "pageSize",
+								Description: `items count per page, max - 500`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "sortColumn",
+								Description: `sort by column name`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "sortDesc",
+								Description: `descending sort`,
+								Type:        smd.Boolean,
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `[]TagSummary`,
+					Type:        smd.Array,
+					TypeName:    "[]TagSummary",
+					Items: map[string]string{
+						"$ref": "#/definitions/TagSummary",
+					},
+					Definitions: map[string]smd.Definition{
+						"TagSummary": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "name",
+									Type: smd.String,
+								},
+								{
+									Name:     "status",
+									Optional: true,
+									Ref:      "#/definitions/Status",
+									Type:     smd.Object,
+								},
+							},
+						},
+						"Status": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "alias",
+									Type: smd.String,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+				},
+			},
+			"GetByID": {
+				Description: `GetByID returns a Tag by its ID.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `int`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `Tag`,
+					Optional:    true,
+					Type:        smd.Object,
+					TypeName:    "Tag",
+					Properties: smd.PropertyList{
+						{
+							Name: "id",
+							Type: smd.Integer,
+						},
+						{
+							Name: "name",
+							Type: smd.String,
+						},
+						{
+							Name: "statusId",
+							Type: smd.Integer,
+						},
+						{
+							Name:     "status",
+							Optional: true,
+							Ref:      "#/definitions/Status",
+							Type:     smd.Object,
+						},
+					},
+					Definitions: map[string]smd.Definition{
+						"Status": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "alias",
+									Type: smd.String,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					404: "Not Found",
+				},
+			},
+			"Add": {
+				Description: `Add adds a Tag from the query.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "tag",
+						Description: `Tag`,
+						Type:        smd.Object,
+						TypeName:    "Tag",
+						Properties: smd.PropertyList{
+							{
+								Name: "id",
+								Type: smd.Integer,
+							},
+							{
+								Name: "name",
+								Type: smd.String,
+							},
+							{
+								Name: "statusId",
+								Type: smd.Integer,
+							},
+							{
+								Name:     "status",
+								Optional: true,
+								Ref:      "#/definitions/Status",
+								Type:     smd.Object,
+							},
+						},
+						Definitions: map[string]smd.Definition{
+							"Status": {
+								Type: "object",
+								Properties: smd.PropertyList{
+									{
+										Name: "id",
+										Type: smd.Integer,
+									},
+									{
+										Name: "alias",
+										Type: smd.String,
+									},
+									{
+										Name: "title",
+										Type: smd.String,
+									},
+								},
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `Tag`,
+					Optional:    true,
+					Type:        smd.Object,
+					TypeName:    "Tag",
+					Properties: smd.PropertyList{
+						{
+							Name: "id",
+							Type: smd.Integer,
+						},
+						{
+							Name: "name",
+							Type: smd.String,
+						},
+						{
+							Name: "statusId",
+							Type: smd.Integer,
+						},
+						{
+							Name:     "status",
+							Optional: true,
+							Ref:      "#/definitions/Status",
+							Type:     smd.Object,
+						},
+					},
+					Definitions: map[string]smd.Definition{
+						"Status": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "alias",
+									Type: smd.String,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+				},
+			},
+			"Update": {
+				Description: `Update updates the Tag data identified by id from the query.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:     "tag",
+						Type:     smd.Object,
+						TypeName: "Tag",
+						Properties: smd.PropertyList{
+							{
+								Name: "id",
+								Type: smd.Integer,
+							},
+							{
+								Name: "name",
+								Type: smd.String,
+							},
+							{
+								Name: "statusId",
+								Type: smd.Integer,
+							},
+							{
+								Name:     "status",
+								Optional: true,
+								Ref:      "#/definitions/Status",
+								Type:     smd.Object,
+							},
+						},
+						Definitions: map[string]smd.Definition{
+							"Status": {
+								Type: "object",
+								Properties: smd.PropertyList{
+									{
+										Name: "id",
+										Type: smd.Integer,
+									},
+									{
+										Name: "alias",
+										Type: smd.String,
+									},
+									{
+										Name: "title",
+										Type: smd.String,
+									},
+								},
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `Tag`,
+					Type:        smd.Boolean,
+					TypeName:    "Tag",
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+					404: "Not Found",
+				},
+			},
+			"Delete": {
+				Description: `Delete deletes the Tag by its ID.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `int`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `isDeleted`,
+					Type:        smd.Boolean,
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+					404: "Not Found",
+				},
+			},
+			"UpdateStatus": {
+				Description: `UpdateStatus sets status of the Tags by their IDs in a single transaction.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "statusUpdate",
+						Description: `StatusUpdate`,
+						Type:        smd.Object,
+						TypeName:    "StatusUpdate",
+						Properties: smd.PropertyList{
+							{
+								Name: "statusId",
+								Type: smd.Integer,
+							},
+							{
+								Name: "ids",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `[]BulkResult`,
+					Type:        smd.Array,
+					TypeName:    "[]BulkResult",
+					Items: map[string]string{
+						"$ref": "#/definitions/BulkResult",
+					},
+					Definitions: map[string]smd.Definition{
+						"BulkResult": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "isChanged",
+									Type: smd.Boolean,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+				},
+			},
+			"DeleteMany": {
+				Description: `DeleteMany deletes the Tags by their IDs in a single transaction.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "ids",
+						Description: `[]int`,
+						Type:        smd.Array,
+						TypeName:    "[]",
+						Items: map[string]string{
+							"type": smd.Integer,
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `[]BulkResult`,
+					Type:        smd.Array,
+					TypeName:    "[]BulkResult",
+					Items: map[string]string{
+						"$ref": "#/definitions/BulkResult",
+					},
+					Definitions: map[string]smd.Definition{
+						"BulkResult": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "isChanged",
+									Type: smd.Boolean,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+				},
+			},
+			"Merge": {
+				Description: `Merge replaces source tags with the target tag in tagIds of all news and deletes source tags.
+Revisions are saved and events are emitted for changed news. It returns the number of changed news.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "sourceIds",
+						Description: `[]int`,
+						Type:        smd.Array,
+						TypeName:    "[]",
+						Items: map[string]string{
+							"type": smd.Integer,
+						},
+					},
+					{
+						Name:        "targetId",
+						Description: `int`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `number of changed news`,
+					Type:        smd.Integer,
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+				},
+			},
+			"GetOrphans": {
+				Description: `GetOrphans returns missing tags, which are used in news tagIds. Deleted tags in trash are not orphans.`,
+				Parameters:  []smd.JSONSchema{},
+				Returns: smd.JSONSchema{
+					Description: `[]OrphanTag`,
+					Type:        smd.Array,
+					TypeName:    "[]OrphanTag",
+					Items: map[string]string{
+						"$ref": "#/definitions/OrphanTag",
+					},
+					Definitions: map[string]smd.Definition{
+						"OrphanTag": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name:        "newsCount",
+									Description: `NewsCount is a number of news with the tag, including deleted news.`,
+									Type:        smd.Integer,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+				},
+			},
+			"FixOrphans": {
+				Description: `FixOrphans removes missing tags from news tagIds.
+Revisions are saved and events are emitted for changed news. It returns removed tags.`,
+				Parameters: []smd.JSONSchema{},
+				Returns: smd.JSONSchema{
+					Description: `[]OrphanTag`,
+					Type:        smd.Array,
+					TypeName:    "[]OrphanTag",
+					Items: map[string]string{
+						"$ref": "#/definitions/OrphanTag",
+					},
+					Definitions: map[string]smd.Definition{
+						"OrphanTag": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name:        "newsCount",
+									Description: `NewsCount is a number of news with the tag, including deleted news.`,
+									Type:        smd.Integer,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+				},
+			},
+			"Validate": {
+				Description: `Validate verifies that Tag data is valid.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "tag",
+						Description: `Tag`,
+						Type:        smd.Object,
+						TypeName:    "Tag",
+						Properties: smd.PropertyList{
+							{
+								Name: "id",
+								Type: smd.Integer,
+							},
+							{
+								Name: "name",
+								Type: smd.String,
+							},
+							{
+								Name: "statusId",
+								Type: smd.Integer,
+							},
+							{
+								Name:     "status",
+								Optional: true,
+								Ref:      "#/definitions/Status",
+								Type:     smd.Object,
+							},
+						},
+						Definitions: map[string]smd.Definition{
+							"Status": {
+								Type: "object",
+								Properties: smd.PropertyList{
+									{
+										Name: "id",
+										Type: smd.Integer,
+									},
+									{
+										Name: "alias",
+										Type: smd.String,
+									},
+									{
+										Name: "title",
+										Type: smd.String,
+									},
+								},
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `[]FieldError`,
+					Type:        smd.Array,
+					TypeName:    "[]FieldError",
+					Items: map[string]string{
+						"$ref": "#/definitions/FieldError",
+					},
+					Definitions: map[string]smd.Definition{
+						"FieldError": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "field",
+									Type: smd.String,
+								},
+								{
+									Name: "error",
+									Type: smd.String,
+								},
+								{
+									Name:        "constraint",
+									Optional:    true,
+									Description: `Help with generating an error message.`,
+									Ref:         "#/definitions/FieldErrorConstraint",
+									Type:        smd.Object,
+								},
+							},
+						},
+						"FieldErrorConstraint": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name:        "max",
+									Description: `Max value for field.`,
+									Type:        smd.Integer,
+								},
+								{
+									Name:        "min",
+									Description: `Min value for field.`,
+									Type:        smd.Integer,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+				},
+			},
+		},
+	}
+}
+
+// Invoke is as generated code from zenrpc cmd
+func (s TagService) Invoke(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
+	resp := zenrpc.Response{}
+	var err error
+
+	switch method {
+	case RPC.TagService.Count:
+		var args = struct {
+			Search *TagSearch `json:"search"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"search"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Count(ctx, args.Search))
+
+	case RPC.TagService.Get:
+		var args = struct {
+			Search  *TagSearch `json:"search"`
+			ViewOps *ViewOps   `json:"viewOps"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"search", "viewOps"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Get(ctx, args.Search, args.ViewOps))
+
+	case RPC.TagService.GetByID:
+		var args = struct {
+			Id int `json:"id"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"id"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.GetByID(ctx, args.Id))
+
+	case RPC.TagService.Add:
+		var args = struct {
+			Tag Tag `json:"tag"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"tag"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Add(ctx, args.Tag))
+
+	case RPC.TagService.Update:
+		var args = struct {
+			Tag Tag `json:"tag"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"tag"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Update(ctx, args.Tag))
+
+	case RPC.TagService.Delete:
+		var args = struct {
+			Id int `json:"id"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"id"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Delete(ctx, args.Id))
+
+	case RPC.TagService.UpdateStatus:
+		var args = struct {
+			StatusUpdate StatusUpdate `json:"statusUpdate"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"statusUpdate"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.UpdateStatus(ctx, args.StatusUpdate))
+
+	case RPC.TagService.DeleteMany:
+		var args = struct {
+			Ids []int `json:"ids"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"ids"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.DeleteMany(ctx, args.Ids))
+
+	case RPC.TagService.Merge:
+		var args = struct {
+			SourceIds []int `json:"sourceIds"`
+			TargetId  int   `json:"targetId"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"sourceIds", "targetId"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Merge(ctx, args.SourceIds, args.TargetId))
+
+	case RPC.TagService.GetOrphans:
+		resp.Set(s.GetOrphans(ctx))
+
+	case RPC.TagService.FixOrphans:
+		resp.Set(s.FixOrphans(ctx))
+
+	case RPC.TagService.Validate:
+		var args = struct {
+			Tag Tag `json:"tag"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"tag"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Validate(ctx, args.Tag))
+
+	default:
+		resp = zenrpc.NewResponseError(nil, zenrpc.MethodNotFound, "", nil)
+	}
+
+	return resp
+}
+
+func (ModerationService) SMD() smd.ServiceInfo {
+	return smd.ServiceInfo{
+		Methods: map[string]smd.Service{
+			"Count": {
+				Description: `Count returns count Suggestions according to conditions in search params.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "search",
+						Optional:    true,
+						Description: `SuggestionSearch`,
+						Type:        smd.Object,
+						TypeName:    "SuggestionSearch",
+						Properties: smd.PropertyList{
+							{
+								Name:     "id",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "title",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "categoryId",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "ip",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "moderationStatusId",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "createdAtFrom",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "createdAtTo",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name: "ids",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `int`,
+					Type:        smd.Integer,
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+				},
+			},
+			"Get": {
+				Description: `Get returns а list of Suggestions according to conditions in search params. Pending suggestions have moderationStatusId=1.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "search",
+						Optional:    true,
+						Description: `SuggestionSearch`,
+						Type:        smd.Object,
+						TypeName:    "SuggestionSearch",
+						Properties: smd.PropertyList{
+							{
+								Name:     "id",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "title",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "categoryId",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "ip",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "moderationStatusId",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "createdAtFrom",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "createdAtTo",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name: "ids",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+						},
+					},
+					{
+						Name:        "viewOps",
+						Optional:    true,
+						Description: `ViewOps`,
+						Type:        smd.Object,
+						TypeName:    "ViewOps",
+						Properties: smd.PropertyList{
+							{
+								Name:        "page",
+								Description: `page number, default - 1`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "pageSize",
+								Description: `items count per page, max - 500`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "sortColumn",
+								Description: `sort by column name`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "sortDesc",
+								Description: `descending sort`,
+								Type:        smd.Boolean,
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `[]SuggestionSummary`,
+					Type:        smd.Array,
+					TypeName:    "[]SuggestionSummary",
+					Items: map[string]string{
+						"$ref": "#/definitions/SuggestionSummary",
+					},
+					Definitions: map[string]smd.Definition{
+						"SuggestionSummary": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "shortText",
+									Type: smd.String,
+								},
+								{
+									Name: "categoryId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "tags",
+									Type: smd.Array,
+									Items: map[string]string{
+										"type": smd.String,
+									},
+								},
+								{
+									Name: "ip",
+									Type: smd.String,
+								},
+								{
+									Name:     "contact",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name:     "newsId",
+									Optional: true,
+									Type:     smd.Integer,
+								},
+								{
+									Name: "createdAt",
+									Type: smd.String,
+								},
+								{
+									Name:     "category",
+									Optional: true,
+									Ref:      "#/definitions/CategorySummary",
+									Type:     smd.Object,
+								},
+								{
+									Name:     "moderationStatus",
+									Optional: true,
+									Ref:      "#/definitions/Status",
+									Type:     smd.Object,
+								},
+							},
+						},
+						"CategorySummary": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "slug",
+									Type: smd.String,
+								},
+								{
+									Name:     "sort",
+									Optional: true,
+									Type:     smd.Integer,
+								},
+								{
+									Name:     "status",
+									Optional: true,
+									Ref:      "#/definitions/Status",
+									Type:     smd.Object,
+								},
+							},
+						},
+						"Status": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "alias",
+									Type: smd.String,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+				},
+			},
+			"GetByID": {
+				Description: `GetByID returns a Suggestion by its ID with its moderation decisions, latest first.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `int`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `Suggestion`,
+					Optional:    true,
+					Type:        smd.Object,
+					TypeName:    "Suggestion",
+					Properties: smd.PropertyList{
+						{
+							Name: "id",
+							Type: smd.Integer,
+						},
+						{
+							Name: "title",
+							Type: smd.String,
+						},
+						{
+							Name: "shortText",
+							Type: smd.String,
+						},
+						{
+							Name: "text",
+							Type: smd.String,
+						},
+						{
+							Name: "categoryId",
+							Type: smd.Integer,
+						},
+						{
+							Name: "tags",
+							Type: smd.Array,
+							Items: map[string]string{
+								"type": smd.String,
+							},
+						},
+						{
+							Name: "ip",
+							Type: smd.String,
+						},
+						{
+							Name:     "contact",
+							Optional: true,
+							Type:     smd.String,
+						},
+						{
+							Name:     "newsId",
+							Optional: true,
+							Type:     smd.Integer,
+						},
+						{
+							Name: "createdAt",
+							Type: smd.String,
+						},
+						{
+							Name: "moderationStatusId",
+							Type: smd.Integer,
+						},
+						{
+							Name:     "category",
+							Optional: true,
+							Ref:      "#/definitions/CategorySummary",
+							Type:     smd.Object,
+						},
+						{
+							Name:     "moderationStatus",
+							Optional: true,
+							Ref:      "#/definitions/Status",
+							Type:     smd.Object,
+						},
+						{
+							Name: "decisions",
+							Type: smd.Array,
+							Items: map[string]string{
+								"$ref": "#/definitions/SuggestionDecision",
+							},
+						},
+					},
+					Definitions: map[string]smd.Definition{
+						"CategorySummary": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "slug",
+									Type: smd.String,
+								},
+								{
+									Name:     "sort",
+									Optional: true,
+									Type:     smd.Integer,
+								},
+								{
+									Name:     "status",
+									Optional: true,
+									Ref:      "#/definitions/Status",
+									Type:     smd.Object,
+								},
+							},
+						},
+						"Status": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "alias",
+									Type: smd.String,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+						"SuggestionDecision": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "userId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "moderationStatusId",
+									Type: smd.Integer,
+								},
+								{
+									Name:     "reason",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name:     "newsId",
+									Optional: true,
+									Type:     smd.Integer,
+								},
+								{
+									Name: "createdAt",
+									Type: smd.String,
+								},
+								{
+									Name:     "user",
+									Optional: true,
+									Ref:      "#/definitions/UserSummary",
+									Type:     smd.Object,
+								},
+								{
+									Name:     "moderationStatus",
+									Optional: true,
+									Ref:      "#/definitions/Status",
+									Type:     smd.Object,
+								},
+							},
+						},
+						"UserSummary": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "createdAt",
+									Type: smd.String,
+								},
+								{
+									Name: "login",
+									Type: smd.String,
+								},
+								{
+									Name:     "lastActivityAt",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name: "roleIds",
+									Type: smd.Array,
+									Items: map[string]string{
+										"type": smd.Integer,
+									},
+								},
+								{
+									Name: "isTotpEnabled",
+									Type: smd.Boolean,
+								},
+								{
+									Name: "isService",
+									Type: smd.Boolean,
+								},
+								{
+									Name:     "status",
+									Optional: true,
+									Ref:      "#/definitions/Status",
+									Type:     smd.Object,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					404: "Not Found",
+				},
+			},
+			"Approve": {
+				Description: `Approve publishes the pending Suggestion as a News with chosen category, optional tags and publishedAt.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "approval",
+						Description: `SuggestionApproval`,
+						Type:        smd.Object,
+						TypeName:    "SuggestionApproval",
+						Properties: smd.PropertyList{
+							{
+								Name: "id",
+								Type: smd.Integer,
+							},
+							{
 								Name: "categoryId",
 								Type: smd.Integer,
 							},
 							{
-								Name: "tagIds",
-								Type: smd.Array,
-								Items: map[string]string{
-									"type": smd.Integer,
-								},
+								Name: "tagIds",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+							{
+								Name: "publishedAt",
+								Type: smd.String,
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `News`,
+					Optional:    true,
+					Type:        smd.Object,
+					TypeName:    "News",
+					Properties: smd.PropertyList{
+						{
+							Name: "id",
+							Type: smd.Integer,
+						},
+						{
+							Name: "title",
+							Type: smd.String,
+						},
+						{
+							Name: "slug",
+							Type: smd.String,
+						},
+						{
+							Name: "shortText",
+							Type: smd.String,
+						},
+						{
+							Name:     "content",
+							Optional: true,
+							Type:     smd.String,
+						},
+						{
+							Name:     "author",
+							Optional: true,
+							Type:     smd.String,
+						},
+						{
+							Name: "categoryId",
+							Type: smd.Integer,
+						},
+						{
+							Name: "tagIds",
+							Type: smd.Array,
+							Items: map[string]string{
+								"type": smd.Integer,
+							},
+						},
+						{
+							Name: "publishedAt",
+							Type: smd.String,
+						},
+						{
+							Name: "createdAt",
+							Type: smd.String,
+						},
+						{
+							Name: "statusId",
+							Type: smd.Integer,
+						},
+						{
+							Name:     "category",
+							Optional: true,
+							Ref:      "#/definitions/CategorySummary",
+							Type:     smd.Object,
+						},
+						{
+							Name:     "status",
+							Optional: true,
+							Ref:      "#/definitions/Status",
+							Type:     smd.Object,
+						},
+					},
+					Definitions: map[string]smd.Definition{
+						"CategorySummary": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "slug",
+									Type: smd.String,
+								},
+								{
+									Name:     "sort",
+									Optional: true,
+									Type:     smd.Integer,
+								},
+								{
+									Name:     "status",
+									Optional: true,
+									Ref:      "#/definitions/Status",
+									Type:     smd.Object,
+								},
+							},
+						},
+						"Status": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "alias",
+									Type: smd.String,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+					404: "Not Found",
+				},
+			},
+			"Reject": {
+				Description: `Reject rejects the pending Suggestion with a reason.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "rejection",
+						Description: `SuggestionRejection`,
+						Type:        smd.Object,
+						TypeName:    "SuggestionRejection",
+						Properties: smd.PropertyList{
+							{
+								Name: "id",
+								Type: smd.Integer,
+							},
+							{
+								Name: "reason",
+								Type: smd.String,
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `isRejected`,
+					Type:        smd.Boolean,
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+					404: "Not Found",
+				},
+			},
+		},
+	}
+}
+
+// Invoke is as generated code from zenrpc cmd
+func (s ModerationService) Invoke(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
+	resp := zenrpc.Response{}
+	var err error
+
+	switch method {
+	case RPC.ModerationService.Count:
+		var args = struct {
+			Search *SuggestionSearch `json:"search"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"search"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Count(ctx, args.Search))
+
+	case RPC.ModerationService.Get:
+		var args = struct {
+			Search  *SuggestionSearch `json:"search"`
+			ViewOps *ViewOps          `json:"viewOps"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"search", "viewOps"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Get(ctx, args.Search, args.ViewOps))
+
+	case RPC.ModerationService.GetByID:
+		var args = struct {
+			Id int `json:"id"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"id"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.GetByID(ctx, args.Id))
+
+	case RPC.ModerationService.Approve:
+		var args = struct {
+			Approval SuggestionApproval `json:"approval"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"approval"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Approve(ctx, args.Approval))
+
+	case RPC.ModerationService.Reject:
+		var args = struct {
+			Rejection SuggestionRejection `json:"rejection"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"rejection"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Reject(ctx, args.Rejection))
+
+	default:
+		resp = zenrpc.NewResponseError(nil, zenrpc.MethodNotFound, "", nil)
+	}
+
+	return resp
+}
+
+func (TrashService) SMD() smd.ServiceInfo {
+	return smd.ServiceInfo{
+		Methods: map[string]smd.Service{
+			"Count": {
+				Description: `Count returns count of deleted objects of the type: news, categories, tags, users or vfsFiles.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "objectType",
+						Description: `string`,
+						Type:        smd.String,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `int`,
+					Type:        smd.Integer,
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+				},
+			},
+			"Get": {
+				Description: `Get returns а list of deleted objects of the type: news, categories, tags, users or vfsFiles, recently deleted first.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "objectType",
+						Description: `string`,
+						Type:        smd.String,
+					},
+					{
+						Name:        "viewOps",
+						Optional:    true,
+						Description: `ViewOps`,
+						Type:        smd.Object,
+						TypeName:    "ViewOps",
+						Properties: smd.PropertyList{
+							{
+								Name:        "page",
+								Description: `page number, default - 1`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "pageSize",
+								Description: `items count per page, max - 500`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "sortColumn",
+								Description: `sort by column name`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "sortDesc",
+								Description: `descending sort`,
+								Type:        smd.Boolean,
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `[]TrashItem`,
+					Type:        smd.Array,
+					TypeName:    "[]TrashItem",
+					Items: map[string]string{
+						"$ref": "#/definitions/TrashItem",
+					},
+					Definitions: map[string]smd.Definition{
+						"TrashItem": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name:        "objectType",
+									Description: `ObjectType is one of news, categories, tags, users, vfsFiles.`,
+									Type:        smd.String,
+								},
+								{
+									Name:        "title",
+									Description: `Title is object title, login for users.`,
+									Type:        smd.String,
+								},
+								{
+									Name:        "prevStatus",
+									Optional:    true,
+									Description: `PrevStatus is a status, which is set on restore. It is empty for objects deleted before trash tracking, they are restored as disabled.`,
+									Ref:         "#/definitions/Status",
+									Type:        smd.Object,
+								},
+								{
+									Name:        "deletedAt",
+									Optional:    true,
+									Description: `DeletedAt is empty for objects deleted before trash tracking, such objects are not purged by retention policy.`,
+									Type:        smd.String,
+								},
+							},
+						},
+						"Status": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "alias",
+									Type: smd.String,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+				},
+			},
+			"GetDependencies": {
+				Description: `GetDependencies returns objects, which reference the deleted object and prevent its purge.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "objectType",
+						Description: `string`,
+						Type:        smd.String,
+					},
+					{
+						Name:        "id",
+						Description: `int`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `[]TrashDependency`,
+					Type:        smd.Array,
+					TypeName:    "[]TrashDependency",
+					Items: map[string]string{
+						"$ref": "#/definitions/TrashDependency",
+					},
+					Definitions: map[string]smd.Definition{
+						"TrashDependency": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "objectType",
+									Type: smd.String,
+								},
+								{
+									Name: "count",
+									Type: smd.Integer,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+					404: "Not Found",
+				},
+			},
+			"Restore": {
+				Description: `Restore restores the deleted object to its status before deletion, objects deleted before trash tracking are restored as disabled.
+User could not be restored, if its login is taken by another user.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "objectType",
+						Description: `string`,
+						Type:        smd.String,
+					},
+					{
+						Name:        "id",
+						Description: `int`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `isRestored`,
+					Type:        smd.Boolean,
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+					404: "Not Found",
+				},
+			},
+			"Purge": {
+				Description: `Purge permanently deletes the deleted object. Object, which is referenced by other objects, could not be purged.
+Files of purged vfsFiles are kept in storage.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "objectType",
+						Description: `string`,
+						Type:        smd.String,
+					},
+					{
+						Name:        "id",
+						Description: `int`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `isPurged`,
+					Type:        smd.Boolean,
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+					404: "Not Found",
+					409: "Object Has Dependencies",
+				},
+			},
+		},
+	}
+}
+
+// Invoke is as generated code from zenrpc cmd
+func (s TrashService) Invoke(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
+	resp := zenrpc.Response{}
+	var err error
+
+	switch method {
+	case RPC.TrashService.Count:
+		var args = struct {
+			ObjectType string `json:"objectType"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"objectType"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Count(ctx, args.ObjectType))
+
+	case RPC.TrashService.Get:
+		var args = struct {
+			ObjectType string   `json:"objectType"`
+			ViewOps    *ViewOps `json:"viewOps"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"objectType", "viewOps"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Get(ctx, args.ObjectType, args.ViewOps))
+
+	case RPC.TrashService.GetDependencies:
+		var args = struct {
+			ObjectType string `json:"objectType"`
+			Id         int    `json:"id"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"objectType", "id"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.GetDependencies(ctx, args.ObjectType, args.Id))
+
+	case RPC.TrashService.Restore:
+		var args = struct {
+			ObjectType string `json:"objectType"`
+			Id         int    `json:"id"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"objectType", "id"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Restore(ctx, args.ObjectType, args.Id))
+
+	case RPC.TrashService.Purge:
+		var args = struct {
+			ObjectType string `json:"objectType"`
+			Id         int    `json:"id"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"objectType", "id"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Purge(ctx, args.ObjectType, args.Id))
+
+	default:
+		resp = zenrpc.NewResponseError(nil, zenrpc.MethodNotFound, "", nil)
+	}
+
+	return resp
+}
+
+func (AuthService) SMD() smd.ServiceInfo {
+	return smd.ServiceInfo{
+		Methods: map[string]smd.Service{
+			"LoginTOTP": {
+				Description: `LoginTOTP completes login with two-factor authentication code or recovery code and starts a new session.
+If enrollment is required, the code of the secret from LoginEnrollTOTP enables two-factor authentication
+and recovery codes are returned.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "token",
+						Description: `Challenge token from Login`,
+						Type:        smd.String,
+					},
+					{
+						Name:        "code",
+						Description: `TOTP code or recovery code`,
+						Type:        smd.String,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `User authentication key`,
+					Optional:    true,
+					Type:        smd.Object,
+					TypeName:    "LoginResult",
+					Properties: smd.PropertyList{
+						{
+							Name:     "authKey",
+							Optional: true,
+							Type:     smd.String,
+						},
+						{
+							Name:     "challenge",
+							Optional: true,
+							Ref:      "#/definitions/LoginChallenge",
+							Type:     smd.Object,
+						},
+						{
+							Name: "recoveryCodes",
+							Type: smd.Array,
+							Items: map[string]string{
+								"type": smd.String,
+							},
+						},
+					},
+					Definitions: map[string]smd.Definition{
+						"LoginChallenge": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "token",
+									Type: smd.String,
+								},
+								{
+									Name: "expiresAt",
+									Type: smd.String,
+								},
+								{
+									Name: "isEnrollRequired",
+									Type: smd.Boolean,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					400: "Invalid challenge or code",
+					429: "Too many login attempts",
+					500: "Internal Error",
+				},
+			},
+			"LoginEnrollTOTP": {
+				Description: `LoginEnrollTOTP generates a new TOTP secret for the user, who must enroll two-factor authentication during login.
+Enrollment is completed by LoginTOTP with the code of the secret.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "token",
+						Description: `Challenge token from Login`,
+						Type:        smd.String,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `TOTPEnrollment`,
+					Optional:    true,
+					Type:        smd.Object,
+					TypeName:    "TOTPEnrollment",
+					Properties: smd.PropertyList{
+						{
+							Name: "secret",
+							Type: smd.String,
+						},
+						{
+							Name: "uri",
+							Type: smd.String,
+						},
+					},
+				},
+				Errors: map[int]string{
+					400: "Invalid challenge or two-factor authentication is already enabled",
+					500: "Internal Error",
+				},
+			},
+			"EnrollTOTP": {
+				Description: `EnrollTOTP generates a new TOTP secret for current user. Enrollment is completed by ConfirmTOTP.`,
+				Parameters:  []smd.JSONSchema{},
+				Returns: smd.JSONSchema{
+					Description: `TOTPEnrollment`,
+					Optional:    true,
+					Type:        smd.Object,
+					TypeName:    "TOTPEnrollment",
+					Properties: smd.PropertyList{
+						{
+							Name: "secret",
+							Type: smd.String,
+						},
+						{
+							Name: "uri",
+							Type: smd.String,
+						},
+					},
+				},
+				Errors: map[int]string{
+					400: "Two-factor authentication is already enabled",
+					401: "Invalid authentication credentials",
+					500: "Internal Error",
+				},
+			},
+			"ConfirmTOTP": {
+				Description: `ConfirmTOTP enables two-factor authentication for current user with the code of enrolled secret.
+It returns one-time recovery codes, which are shown only once.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "code",
+						Description: `TOTP code`,
+						Type:        smd.String,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `Recovery codes`,
+					Type:        smd.Array,
+					TypeName:    "[]",
+					Items: map[string]string{
+						"type": smd.String,
+					},
+				},
+				Errors: map[int]string{
+					400: "Invalid code or two-factor authentication is not enrolled",
+					401: "Invalid authentication credentials",
+					500: "Internal Error",
+				},
+			},
+			"GenerateRecoveryCodes": {
+				Description: `GenerateRecoveryCodes replaces recovery codes of current user with new ones.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "code",
+						Description: `TOTP code or recovery code`,
+						Type:        smd.String,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `Recovery codes`,
+					Type:        smd.Array,
+					TypeName:    "[]",
+					Items: map[string]string{
+						"type": smd.String,
+					},
+				},
+				Errors: map[int]string{
+					400: "Invalid code or two-factor authentication is not enabled",
+					401: "Invalid authentication credentials",
+					500: "Internal Error",
+				},
+			},
+			"DisableTOTP": {
+				Description: `DisableTOTP disables two-factor authentication of current user, if it is not required by config or user roles.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "code",
+						Description: `TOTP code or recovery code`,
+						Type:        smd.String,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `isDisabled`,
+					Type:        smd.Boolean,
+				},
+				Errors: map[int]string{
+					400: "Invalid code or two-factor authentication is not enabled",
+					401: "Invalid authentication credentials",
+					403: "Two-factor authentication is required",
+					500: "Internal Error",
+				},
+			},
+			"Login": {
+				Description: `Login authenticates user and starts a new session, other user sessions stay active.
+If user has to pass two-factor authentication, a challenge for LoginTOTP is returned instead of authentication key.
+Attempts after several failures are delayed progressively and login or IP is locked temporarily after too many failures.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "login",
+						Description: `User login`,
+						Type:        smd.String,
+					},
+					{
+						Name:        "password",
+						Description: `User password`,
+						Type:        smd.String,
+					},
+					{
+						Name:        "remember",
+						Description: `Remember for 30 days`,
+						Type:        smd.Boolean,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `User authentication key or two-factor authentication challenge`,
+					Optional:    true,
+					Type:        smd.Object,
+					TypeName:    "LoginResult",
+					Properties: smd.PropertyList{
+						{
+							Name:     "authKey",
+							Optional: true,
+							Type:     smd.String,
+						},
+						{
+							Name:     "challenge",
+							Optional: true,
+							Ref:      "#/definitions/LoginChallenge",
+							Type:     smd.Object,
+						},
+						{
+							Name: "recoveryCodes",
+							Type: smd.Array,
+							Items: map[string]string{
+								"type": smd.String,
+							},
+						},
+					},
+					Definitions: map[string]smd.Definition{
+						"LoginChallenge": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "token",
+									Type: smd.String,
+								},
+								{
+									Name: "expiresAt",
+									Type: smd.String,
+								},
+								{
+									Name: "isEnrollRequired",
+									Type: smd.Boolean,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					400: "Invalid login or password",
+					429: "Too many login attempts",
+					500: "Internal Error",
+				},
+			},
+			"Logout": {
+				Description: `Logout current user from the current session`,
+				Parameters:  []smd.JSONSchema{},
+				Returns: smd.JSONSchema{
+					Description: `Successful logout`,
+					Type:        smd.Boolean,
+				},
+				Errors: map[int]string{
+					401: "Invalid authentication credentials",
+					500: "Internal Error",
+				},
+			},
+			"Profile": {
+				Description: `Profile is a function that returns current user profile with effective permissions`,
+				Parameters:  []smd.JSONSchema{},
+				Returns: smd.JSONSchema{
+					Description: `UserProfile`,
+					Optional:    true,
+					Type:        smd.Object,
+					TypeName:    "UserProfile",
+					Properties: smd.PropertyList{
+						{
+							Name: "id",
+							Type: smd.Integer,
+						},
+						{
+							Name: "createdAt",
+							Type: smd.String,
+						},
+						{
+							Name: "login",
+							Type: smd.String,
+						},
+						{
+							Name:     "lastActivityAt",
+							Optional: true,
+							Type:     smd.String,
+						},
+						{
+							Name: "statusId",
+							Type: smd.Integer,
+						},
+						{
+							Name: "roleIds",
+							Type: smd.Array,
+							Items: map[string]string{
+								"type": smd.Integer,
+							},
+						},
+						{
+							Name: "permissions",
+							Type: smd.Array,
+							Items: map[string]string{
+								"type": smd.String,
+							},
+						},
+						{
+							Name: "isTotpEnabled",
+							Type: smd.Boolean,
+						},
+						{
+							Name: "isTotpRequired",
+							Type: smd.Boolean,
+						},
+					},
+				},
+				Errors: map[int]string{
+					401: "Invalid authentication credentials",
+					500: "Internal Error",
+				},
+			},
+			"ChangePassword": {
+				Description: `ChangePassword changes current user password, revokes all user sessions and starts a new one.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "password",
+						Description: `New user password`,
+						Type:        smd.String,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `New user authentication key`,
+					Type:        smd.String,
+				},
+				Errors: map[int]string{
+					401: "Invalid authentication credentials",
+					500: "Internal Error",
+				},
+			},
+			"VfsAuthToken": {
+				Description: `VfsAuthToken get auth token for VFS requests`,
+				Parameters:  []smd.JSONSchema{},
+				Returns: smd.JSONSchema{
+					Type: smd.String,
+				},
+			},
+			"Sessions": {
+				Description: `Sessions returns active sessions of current user, latest first.`,
+				Parameters:  []smd.JSONSchema{},
+				Returns: smd.JSONSchema{
+					Description: `[]Session`,
+					Type:        smd.Array,
+					TypeName:    "[]Session",
+					Items: map[string]string{
+						"$ref": "#/definitions/Session",
+					},
+					Definitions: map[string]smd.Definition{
+						"Session": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "ip",
+									Type: smd.String,
+								},
+								{
+									Name: "userAgent",
+									Type: smd.String,
+								},
+								{
+									Name: "device",
+									Type: smd.String,
+								},
+								{
+									Name: "createdAt",
+									Type: smd.String,
+								},
+								{
+									Name: "lastActivityAt",
+									Type: smd.String,
+								},
+								{
+									Name: "expiresAt",
+									Type: smd.String,
+								},
+								{
+									Name: "isCurrent",
+									Type: smd.Boolean,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					401: "Invalid authentication credentials",
+					500: "Internal Error",
+				},
+			},
+			"RevokeSession": {
+				Description: `RevokeSession revokes the session of current user by its ID. Revoking the current session is the same as Logout.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `Session ID`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `isRevoked`,
+					Type:        smd.Boolean,
+				},
+				Errors: map[int]string{
+					401: "Invalid authentication credentials",
+					404: "Not Found",
+					500: "Internal Error",
+				},
+			},
+			"RevokeAllSessions": {
+				Description: `RevokeAllSessions revokes all sessions of current user, the current session is kept if exceptCurrent is set.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "exceptCurrent",
+						Description: `Keep the current session`,
+						Type:        smd.Boolean,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `Number of revoked sessions`,
+					Type:        smd.Integer,
+				},
+				Errors: map[int]string{
+					401: "Invalid authentication credentials",
+					500: "Internal Error",
+				},
+			},
+		},
+	}
+}
+
+// Invoke is as generated code from zenrpc cmd
+func (s AuthService) Invoke(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
+	resp := zenrpc.Response{}
+	var err error
+
+	switch method {
+	case RPC.AuthService.LoginTOTP:
+		var args = struct {
+			Token string `json:"token"`
+			Code  string `json:"code"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"token", "code"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.LoginTOTP(ctx, args.Token, args.Code))
+
+	case RPC.AuthService.LoginEnrollTOTP:
+		var args = struct {
+			Token string `json:"token"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"token"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.LoginEnrollTOTP(ctx, args.Token))
+
+	case RPC.AuthService.EnrollTOTP:
+		resp.Set(s.EnrollTOTP(ctx))
+
+	case RPC.AuthService.ConfirmTOTP:
+		var args = struct {
+			Code string `json:"code"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"code"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.ConfirmTOTP(ctx, args.Code))
+
+	case RPC.AuthService.GenerateRecoveryCodes:
+		var args = struct {
+			Code string `json:"code"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"code"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.GenerateRecoveryCodes(ctx, args.Code))
+
+	case RPC.AuthService.DisableTOTP:
+		var args = struct {
+			Code string `json:"code"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"code"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.DisableTOTP(ctx, args.Code))
+
+	case RPC.AuthService.Login:
+		var args = struct {
+			Login    string `json:"login"`
+			Password string `json:"password"`
+			Remember bool   `json:"remember"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"login", "password", "remember"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Login(ctx, args.Login, args.Password, args.Remember))
+
+	case RPC.AuthService.Logout:
+		resp.Set(s.Logout(ctx))
+
+	case RPC.AuthService.Profile:
+		resp.Set(s.Profile(ctx))
+
+	case RPC.AuthService.ChangePassword:
+		var args = struct {
+			Password string `json:"password"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"password"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.ChangePassword(ctx, args.Password))
+
+	case RPC.AuthService.VfsAuthToken:
+		resp.Set(s.VfsAuthToken(ctx))
+
+	case RPC.AuthService.Sessions:
+		resp.Set(s.Sessions(ctx))
+
+	case RPC.AuthService.RevokeSession:
+		var args = struct {
+			Id int `json:"id"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"id"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.RevokeSession(ctx, args.Id))
+
+	case RPC.AuthService.RevokeAllSessions:
+		var args = struct {
+			ExceptCurrent bool `json:"exceptCurrent"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"exceptCurrent"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.RevokeAllSessions(ctx, args.ExceptCurrent))
+
+	default:
+		resp = zenrpc.NewResponseError(nil, zenrpc.MethodNotFound, "", nil)
+	}
+
+	return resp
+}
+
+func (UserService) SMD() smd.ServiceInfo {
+	return smd.ServiceInfo{
+		Methods: map[string]smd.Service{
+			"Count": {
+				Description: `Count Users according to conditions in search params`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "search",
+						Optional:    true,
+						Description: `UserSearch`,
+						Type:        smd.Object,
+						TypeName:    "UserSearch",
+						Properties: smd.PropertyList{
+							{
+								Name:     "id",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "login",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "statusId",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "roleId",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "isService",
+								Optional: true,
+								Type:     smd.Boolean,
+							},
+							{
+								Name:     "lastActivityAtFrom",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "lastActivityAtTo",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name: "ids",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+							{
+								Name:     "notId",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `int`,
+					Type:        smd.Integer,
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+				},
+			},
+			"Get": {
+				Description: `Get а list of Users according to conditions in search params`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "search",
+						Optional:    true,
+						Description: `UserSearch`,
+						Type:        smd.Object,
+						TypeName:    "UserSearch",
+						Properties: smd.PropertyList{
+							{
+								Name:     "id",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "login",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "statusId",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "roleId",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "isService",
+								Optional: true,
+								Type:     smd.Boolean,
+							},
+							{
+								Name:     "lastActivityAtFrom",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "lastActivityAtTo",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name: "ids",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+							{
+								Name:     "notId",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+						},
+					},
+					{
+						Name:        "viewOps",
+						Optional:    true,
+						Description: `ViewOps`,
+						Type:        smd.Object,
+						TypeName:    "ViewOps",
+						Properties: smd.PropertyList{
+							{
+								Name:        "page",
+								Description: `page number, default - 1`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "pageSize",
+								Description: `items count per page, max - 500`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "sortColumn",
+								Description: `sort by column name`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "sortDesc",
+								Description: `descending sort`,
+								Type:        smd.Boolean,
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `[]UserSummary`,
+					Type:        smd.Array,
+					TypeName:    "[]UserSummary",
+					Items: map[string]string{
+						"$ref": "#/definitions/UserSummary",
+					},
+					Definitions: map[string]smd.Definition{
+						"UserSummary": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "createdAt",
+									Type: smd.String,
+								},
+								{
+									Name: "login",
+									Type: smd.String,
+								},
+								{
+									Name:     "lastActivityAt",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name: "roleIds",
+									Type: smd.Array,
+									Items: map[string]string{
+										"type": smd.Integer,
+									},
+								},
+								{
+									Name: "isTotpEnabled",
+									Type: smd.Boolean,
+								},
+								{
+									Name: "isService",
+									Type: smd.Boolean,
+								},
+								{
+									Name:     "status",
+									Optional: true,
+									Ref:      "#/definitions/Status",
+									Type:     smd.Object,
+								},
+							},
+						},
+						"Status": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "alias",
+									Type: smd.String,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+				},
+			},
+			"GetByID": {
+				Description: `GetByID returns a User by its ID.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `int`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `User`,
+					Optional:    true,
+					Type:        smd.Object,
+					TypeName:    "User",
+					Properties: smd.PropertyList{
+						{
+							Name: "id",
+							Type: smd.Integer,
+						},
+						{
+							Name: "createdAt",
+							Type: smd.String,
+						},
+						{
+							Name: "login",
+							Type: smd.String,
+						},
+						{
+							Name: "password",
+							Type: smd.String,
+						},
+						{
+							Name:     "lastActivityAt",
+							Optional: true,
+							Type:     smd.String,
+						},
+						{
+							Name: "statusId",
+							Type: smd.Integer,
+						},
+						{
+							Name: "roleIds",
+							Type: smd.Array,
+							Items: map[string]string{
+								"type": smd.Integer,
+							},
+						},
+						{
+							Name: "isTotpEnabled",
+							Type: smd.Boolean,
+						},
+						{
+							Name: "isService",
+							Type: smd.Boolean,
+						},
+						{
+							Name:     "status",
+							Optional: true,
+							Ref:      "#/definitions/Status",
+							Type:     smd.Object,
+						},
+					},
+					Definitions: map[string]smd.Definition{
+						"Status": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "alias",
+									Type: smd.String,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					404: "Not Found",
+				},
+			},
+			"Add": {
+				Description: `Add a User from the query`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "user",
+						Description: `User`,
+						Type:        smd.Object,
+						TypeName:    "User",
+						Properties: smd.PropertyList{
+							{
+								Name: "id",
+								Type: smd.Integer,
+							},
+							{
+								Name: "createdAt",
+								Type: smd.String,
 							},
 							{
-								Name: "publishedAt",
+								Name: "login",
 								Type: smd.String,
 							},
 							{
-								Name: "createdAt",
+								Name: "password",
 								Type: smd.String,
 							},
+							{
+								Name:     "lastActivityAt",
+								Optional: true,
+								Type:     smd.String,
+							},
 							{
 								Name: "statusId",
 								Type: smd.Integer,
 							},
 							{
-								Name:     "category",
-								Optional: true,
-								Ref:      "#/definitions/CategorySummary",
-								Type:     smd.Object,
+								Name: "roleIds",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+							{
+								Name: "isTotpEnabled",
+								Type: smd.Boolean,
+							},
+							{
+								Name: "isService",
+								Type: smd.Boolean,
 							},
 							{
 								Name:     "status",
@@ -1233,30 +7419,6 @@ func (NewsService) SMD() smd.ServiceInfo {
 							},
 						},
 						Definitions: map[string]smd.Definition{
-							"CategorySummary": {
-								Type: "object",
-								Properties: smd.PropertyList{
-									{
-										Name: "id",
-										Type: smd.Integer,
-									},
-									{
-										Name: "title",
-										Type: smd.String,
-									},
-									{
-										Name:     "sort",
-										Optional: true,
-										Type:     smd.Integer,
-									},
-									{
-										Name:     "status",
-										Optional: true,
-										Ref:      "#/definitions/Status",
-										Type:     smd.Object,
-									},
-								},
-							},
 							"Status": {
 								Type: "object",
 								Properties: smd.PropertyList{
@@ -1278,61 +7440,50 @@ func (NewsService) SMD() smd.ServiceInfo {
 					},
 				},
 				Returns: smd.JSONSchema{
-					Description: `News`,
+					Description: `User`,
 					Optional:    true,
 					Type:        smd.Object,
-					TypeName:    "News",
+					TypeName:    "User",
 					Properties: smd.PropertyList{
 						{
 							Name: "id",
 							Type: smd.Integer,
 						},
 						{
-							Name: "title",
+							Name: "createdAt",
 							Type: smd.String,
 						},
 						{
-							Name: "shortText",
+							Name: "login",
 							Type: smd.String,
 						},
 						{
-							Name:     "content",
-							Optional: true,
-							Type:     smd.String,
+							Name: "password",
+							Type: smd.String,
 						},
 						{
-							Name:     "author",
+							Name:     "lastActivityAt",
 							Optional: true,
 							Type:     smd.String,
 						},
 						{
-							Name: "categoryId",
+							Name: "statusId",
 							Type: smd.Integer,
 						},
 						{
-							Name: "tagIds",
+							Name: "roleIds",
 							Type: smd.Array,
 							Items: map[string]string{
 								"type": smd.Integer,
 							},
 						},
 						{
-							Name: "publishedAt",
-							Type: smd.String,
-						},
-						{
-							Name: "createdAt",
-							Type: smd.String,
-						},
-						{
-							Name: "statusId",
-							Type: smd.Integer,
+							Name: "isTotpEnabled",
+							Type: smd.Boolean,
 						},
 						{
-							Name:     "category",
-							Optional: true,
-							Ref:      "#/definitions/CategorySummary",
-							Type:     smd.Object,
+							Name: "isService",
+							Type: smd.Boolean,
 						},
 						{
 							Name:     "status",
@@ -1342,7 +7493,7 @@ func (NewsService) SMD() smd.ServiceInfo {
 						},
 					},
 					Definitions: map[string]smd.Definition{
-						"CategorySummary": {
+						"Status": {
 							Type: "object",
 							Properties: smd.PropertyList{
 								{
@@ -1350,23 +7501,205 @@ func (NewsService) SMD() smd.ServiceInfo {
 									Type: smd.Integer,
 								},
 								{
-									Name: "title",
+									Name: "alias",
 									Type: smd.String,
 								},
 								{
-									Name:     "sort",
-									Optional: true,
-									Type:     smd.Integer,
+									Name: "title",
+									Type: smd.String,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+					403: "Roles With Permissions, Which Current User Doesn't Have",
+				},
+			},
+			"Update": {
+				Description: `Update updates the User data identified by id from the query`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:     "user",
+						Type:     smd.Object,
+						TypeName: "User",
+						Properties: smd.PropertyList{
+							{
+								Name: "id",
+								Type: smd.Integer,
+							},
+							{
+								Name: "createdAt",
+								Type: smd.String,
+							},
+							{
+								Name: "login",
+								Type: smd.String,
+							},
+							{
+								Name: "password",
+								Type: smd.String,
+							},
+							{
+								Name:     "lastActivityAt",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name: "statusId",
+								Type: smd.Integer,
+							},
+							{
+								Name: "roleIds",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+							{
+								Name: "isTotpEnabled",
+								Type: smd.Boolean,
+							},
+							{
+								Name: "isService",
+								Type: smd.Boolean,
+							},
+							{
+								Name:     "status",
+								Optional: true,
+								Ref:      "#/definitions/Status",
+								Type:     smd.Object,
+							},
+						},
+						Definitions: map[string]smd.Definition{
+							"Status": {
+								Type: "object",
+								Properties: smd.PropertyList{
+									{
+										Name: "id",
+										Type: smd.Integer,
+									},
+									{
+										Name: "alias",
+										Type: smd.String,
+									},
+									{
+										Name: "title",
+										Type: smd.String,
+									},
 								},
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `User`,
+					Type:        smd.Boolean,
+					TypeName:    "User",
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+					403: "User Or Roles With Permissions, Which Current User Doesn't Have",
+					404: "Not Found",
+				},
+			},
+			"Delete": {
+				Description: `Delete deletes the User by its ID.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `int`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `isDeleted`,
+					Type:        smd.Boolean,
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+					403: "User With Permissions, Which Current User Doesn't Have",
+					404: "Not Found",
+				},
+			},
+			"UpdateStatus": {
+				Description: `UpdateStatus sets status of the Users by their IDs in a single transaction.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "statusUpdate",
+						Description: `StatusUpdate`,
+						Type:        smd.Object,
+						TypeName:    "StatusUpdate",
+						Properties: smd.PropertyList{
+							{
+								Name: "statusId",
+								Type: smd.Integer,
+							},
+							{
+								Name: "ids",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.Integer,
+								},
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `[]BulkResult`,
+					Type:        smd.Array,
+					TypeName:    "[]BulkResult",
+					Items: map[string]string{
+						"$ref": "#/definitions/BulkResult",
+					},
+					Definitions: map[string]smd.Definition{
+						"BulkResult": {
+							Type: "object",
+							Properties: smd.PropertyList{
 								{
-									Name:     "status",
-									Optional: true,
-									Ref:      "#/definitions/Status",
-									Type:     smd.Object,
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "isChanged",
+									Type: smd.Boolean,
 								},
 							},
 						},
-						"Status": {
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					400: "Validation Error",
+					403: "User With Permissions, Which Current User Doesn't Have",
+				},
+			},
+			"DeleteMany": {
+				Description: `DeleteMany deletes the Users by their IDs and their sessions in a single transaction.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "ids",
+						Description: `[]int`,
+						Type:        smd.Array,
+						TypeName:    "[]",
+						Items: map[string]string{
+							"type": smd.Integer,
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `[]BulkResult`,
+					Type:        smd.Array,
+					TypeName:    "[]BulkResult",
+					Items: map[string]string{
+						"$ref": "#/definitions/BulkResult",
+					},
+					Definitions: map[string]smd.Definition{
+						"BulkResult": {
 							Type: "object",
 							Properties: smd.PropertyList{
 								{
@@ -1374,12 +7707,8 @@ func (NewsService) SMD() smd.ServiceInfo {
 									Type: smd.Integer,
 								},
 								{
-									Name: "alias",
-									Type: smd.String,
-								},
-								{
-									Name: "title",
-									Type: smd.String,
+									Name: "isChanged",
+									Type: smd.Boolean,
 								},
 							},
 						},
@@ -1388,132 +7717,233 @@ func (NewsService) SMD() smd.ServiceInfo {
 				Errors: map[int]string{
 					500: "Internal Error",
 					400: "Validation Error",
+					403: "User With Permissions, Which Current User Doesn't Have",
 				},
 			},
-			"Update": {
-				Description: `Update updates the News data identified by id from the query.`,
+			"CountLoginAttempts": {
+				Description: `CountLoginAttempts returns count of login history records according to conditions in search params.`,
 				Parameters: []smd.JSONSchema{
 					{
-						Name:     "news",
-						Type:     smd.Object,
-						TypeName: "News",
+						Name:        "search",
+						Optional:    true,
+						Description: `LoginAttemptSearch`,
+						Type:        smd.Object,
+						TypeName:    "LoginAttemptSearch",
 						Properties: smd.PropertyList{
 							{
-								Name: "id",
-								Type: smd.Integer,
+								Name:     "login",
+								Optional: true,
+								Type:     smd.String,
 							},
 							{
-								Name: "title",
-								Type: smd.String,
+								Name:     "userId",
+								Optional: true,
+								Type:     smd.Integer,
 							},
 							{
-								Name: "shortText",
-								Type: smd.String,
+								Name:     "ip",
+								Optional: true,
+								Type:     smd.String,
 							},
 							{
-								Name:     "content",
+								Name:     "isSuccess",
 								Optional: true,
-								Type:     smd.String,
+								Type:     smd.Boolean,
 							},
 							{
-								Name:     "author",
+								Name:     "createdAtFrom",
 								Optional: true,
 								Type:     smd.String,
 							},
 							{
-								Name: "categoryId",
-								Type: smd.Integer,
+								Name:     "createdAtTo",
+								Optional: true,
+								Type:     smd.String,
 							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `int`,
+					Type:        smd.Integer,
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+				},
+			},
+			"GetLoginAttempts": {
+				Description: `GetLoginAttempts returns а login history according to conditions in search params, latest first.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "search",
+						Optional:    true,
+						Description: `LoginAttemptSearch`,
+						Type:        smd.Object,
+						TypeName:    "LoginAttemptSearch",
+						Properties: smd.PropertyList{
 							{
-								Name: "tagIds",
-								Type: smd.Array,
-								Items: map[string]string{
-									"type": smd.Integer,
-								},
+								Name:     "login",
+								Optional: true,
+								Type:     smd.String,
 							},
 							{
-								Name: "publishedAt",
-								Type: smd.String,
+								Name:     "userId",
+								Optional: true,
+								Type:     smd.Integer,
 							},
 							{
-								Name: "createdAt",
-								Type: smd.String,
+								Name:     "ip",
+								Optional: true,
+								Type:     smd.String,
 							},
 							{
-								Name: "statusId",
-								Type: smd.Integer,
+								Name:     "isSuccess",
+								Optional: true,
+								Type:     smd.Boolean,
 							},
 							{
-								Name:     "category",
+								Name:     "createdAtFrom",
 								Optional: true,
-								Ref:      "#/definitions/CategorySummary",
-								Type:     smd.Object,
+								Type:     smd.String,
 							},
 							{
-								Name:     "status",
+								Name:     "createdAtTo",
 								Optional: true,
-								Ref:      "#/definitions/Status",
-								Type:     smd.Object,
+								Type:     smd.String,
 							},
 						},
-						Definitions: map[string]smd.Definition{
-							"CategorySummary": {
-								Type: "object",
-								Properties: smd.PropertyList{
-									{
-										Name: "id",
-										Type: smd.Integer,
-									},
-									{
-										Name: "title",
-										Type: smd.String,
-									},
-									{
-										Name:     "sort",
-										Optional: true,
-										Type:     smd.Integer,
-									},
-									{
-										Name:     "status",
-										Optional: true,
-										Ref:      "#/definitions/Status",
-										Type:     smd.Object,
-									},
-								},
+					},
+					{
+						Name:        "viewOps",
+						Optional:    true,
+						Description: `ViewOps`,
+						Type:        smd.Object,
+						TypeName:    "ViewOps",
+						Properties: smd.PropertyList{
+							{
+								Name:        "page",
+								Description: `page number, default - 1`,
+								Type:        smd.Integer,
 							},
-							"Status": {
-								Type: "object",
-								Properties: smd.PropertyList{
-									{
-										Name: "id",
-										Type: smd.Integer,
-									},
-									{
-										Name: "alias",
-										Type: smd.String,
-									},
-									{
-										Name: "title",
-										Type: smd.String,
-									},
+							{
+								Name:        "pageSize",
+								Description: `items count per page, max - 500`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "sortColumn",
+								Description: `sort by column name`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "sortDesc",
+								Description: `descending sort`,
+								Type:        smd.Boolean,
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `[]LoginAttempt`,
+					Type:        smd.Array,
+					TypeName:    "[]LoginAttempt",
+					Items: map[string]string{
+						"$ref": "#/definitions/LoginAttempt",
+					},
+					Definitions: map[string]smd.Definition{
+						"LoginAttempt": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "login",
+									Type: smd.String,
+								},
+								{
+									Name:     "userId",
+									Optional: true,
+									Type:     smd.Integer,
+								},
+								{
+									Name: "ip",
+									Type: smd.String,
+								},
+								{
+									Name: "userAgent",
+									Type: smd.String,
+								},
+								{
+									Name: "isSuccess",
+									Type: smd.Boolean,
+								},
+								{
+									Name:     "reason",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name: "isCleared",
+									Type: smd.Boolean,
+								},
+								{
+									Name: "createdAt",
+									Type: smd.String,
 								},
 							},
 						},
 					},
 				},
+				Errors: map[int]string{
+					500: "Internal Error",
+				},
+			},
+			"Unlock": {
+				Description: `Unlock clears failed login attempts of the login and/or the IP, so they are not delayed or locked anymore.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "login",
+						Description: `User login`,
+						Type:        smd.String,
+					},
+					{
+						Name:        "ip",
+						Description: `IP address`,
+						Type:        smd.String,
+					},
+				},
 				Returns: smd.JSONSchema{
-					Description: `News`,
+					Description: `Number of cleared failed attempts`,
+					Type:        smd.Integer,
+				},
+				Errors: map[int]string{
+					400: "Validation Error",
+					500: "Internal Error",
+				},
+			},
+			"ResetTOTP": {
+				Description: `ResetTOTP disables two-factor authentication of the User and removes its secret and recovery codes, e.g. after device loss.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `int`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `isReset`,
 					Type:        smd.Boolean,
-					TypeName:    "News",
 				},
 				Errors: map[int]string{
 					500: "Internal Error",
-					400: "Validation Error",
+					403: "User With Permissions, Which Current User Doesn't Have",
 					404: "Not Found",
 				},
 			},
-			"Delete": {
-				Description: `Delete deletes the News by its ID.`,
+			"Permissions": {
+				Description: `Permissions returns effective permissions of the User granted by its enabled roles.`,
 				Parameters: []smd.JSONSchema{
 					{
 						Name:        "id",
@@ -1522,74 +7952,66 @@ func (NewsService) SMD() smd.ServiceInfo {
 					},
 				},
 				Returns: smd.JSONSchema{
-					Description: `isDeleted`,
-					Type:        smd.Boolean,
+					Description: `[]string`,
+					Type:        smd.Array,
+					TypeName:    "[]",
+					Items: map[string]string{
+						"type": smd.String,
+					},
 				},
 				Errors: map[int]string{
 					500: "Internal Error",
-					400: "Validation Error",
 					404: "Not Found",
 				},
 			},
 			"Validate": {
-				Description: `Validate verifies that News data is valid.`,
+				Description: `Validate Verifies that User data is valid.`,
 				Parameters: []smd.JSONSchema{
 					{
-						Name:        "news",
-						Description: `News`,
+						Name:        "user",
+						Description: `User`,
 						Type:        smd.Object,
-						TypeName:    "News",
+						TypeName:    "User",
 						Properties: smd.PropertyList{
 							{
 								Name: "id",
 								Type: smd.Integer,
 							},
 							{
-								Name: "title",
+								Name: "createdAt",
 								Type: smd.String,
 							},
 							{
-								Name: "shortText",
+								Name: "login",
 								Type: smd.String,
 							},
 							{
-								Name:     "content",
-								Optional: true,
-								Type:     smd.String,
+								Name: "password",
+								Type: smd.String,
 							},
 							{
-								Name:     "author",
+								Name:     "lastActivityAt",
 								Optional: true,
 								Type:     smd.String,
 							},
 							{
-								Name: "categoryId",
+								Name: "statusId",
 								Type: smd.Integer,
 							},
 							{
-								Name: "tagIds",
+								Name: "roleIds",
 								Type: smd.Array,
 								Items: map[string]string{
 									"type": smd.Integer,
 								},
 							},
 							{
-								Name: "publishedAt",
-								Type: smd.String,
-							},
-							{
-								Name: "createdAt",
-								Type: smd.String,
-							},
-							{
-								Name: "statusId",
-								Type: smd.Integer,
+								Name: "isTotpEnabled",
+								Type: smd.Boolean,
 							},
 							{
-								Name:     "category",
-								Optional: true,
-								Ref:      "#/definitions/CategorySummary",
-								Type:     smd.Object,
+								Name: "isService",
+								Type: smd.Boolean,
 							},
 							{
 								Name:     "status",
@@ -1599,30 +8021,6 @@ func (NewsService) SMD() smd.ServiceInfo {
 							},
 						},
 						Definitions: map[string]smd.Definition{
-							"CategorySummary": {
-								Type: "object",
-								Properties: smd.PropertyList{
-									{
-										Name: "id",
-										Type: smd.Integer,
-									},
-									{
-										Name: "title",
-										Type: smd.String,
-									},
-									{
-										Name:     "sort",
-										Optional: true,
-										Type:     smd.Integer,
-									},
-									{
-										Name:     "status",
-										Optional: true,
-										Ref:      "#/definitions/Status",
-										Type:     smd.Object,
-									},
-								},
-							},
 							"Status": {
 								Type: "object",
 								Properties: smd.PropertyList{
@@ -1697,14 +8095,14 @@ func (NewsService) SMD() smd.ServiceInfo {
 }
 
 // Invoke is as generated code from zenrpc cmd
-func (s NewsService) Invoke(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
+func (s UserService) Invoke(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
 	resp := zenrpc.Response{}
 	var err error
 
 	switch method {
-	case RPC.NewsService.Count:
+	case RPC.UserService.Count:
 		var args = struct {
-			Search *NewsSearch `json:"search"`
+			Search *UserSearch `json:"search"`
 		}{}
 
 		if zenrpc.IsArray(params) {
@@ -1721,9 +8119,9 @@ func (s NewsService) Invoke(ctx context.Context, method string, params json.RawM
 
 		resp.Set(s.Count(ctx, args.Search))
 
-	case RPC.NewsService.Get:
+	case RPC.UserService.Get:
 		var args = struct {
-			Search  *NewsSearch `json:"search"`
+			Search  *UserSearch `json:"search"`
 			ViewOps *ViewOps    `json:"viewOps"`
 		}{}
 
@@ -1741,7 +8139,7 @@ func (s NewsService) Invoke(ctx context.Context, method string, params json.RawM
 
 		resp.Set(s.Get(ctx, args.Search, args.ViewOps))
 
-	case RPC.NewsService.GetByID:
+	case RPC.UserService.GetByID:
 		var args = struct {
 			Id int `json:"id"`
 		}{}
@@ -1760,13 +8158,13 @@ func (s NewsService) Invoke(ctx context.Context, method string, params json.RawM
 
 		resp.Set(s.GetByID(ctx, args.Id))
 
-	case RPC.NewsService.Add:
+	case RPC.UserService.Add:
 		var args = struct {
-			News News `json:"news"`
+			User User `json:"user"`
 		}{}
 
 		if zenrpc.IsArray(params) {
-			if params, err = zenrpc.ConvertToObject([]string{"news"}, params); err != nil {
+			if params, err = zenrpc.ConvertToObject([]string{"user"}, params); err != nil {
 				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
 			}
 		}
@@ -1777,15 +8175,15 @@ func (s NewsService) Invoke(ctx context.Context, method string, params json.RawM
 			}
 		}
 
-		resp.Set(s.Add(ctx, args.News))
+		resp.Set(s.Add(ctx, args.User))
 
-	case RPC.NewsService.Update:
+	case RPC.UserService.Update:
 		var args = struct {
-			News News `json:"news"`
+			User User `json:"user"`
 		}{}
 
 		if zenrpc.IsArray(params) {
-			if params, err = zenrpc.ConvertToObject([]string{"news"}, params); err != nil {
+			if params, err = zenrpc.ConvertToObject([]string{"user"}, params); err != nil {
 				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
 			}
 		}
@@ -1796,9 +8194,9 @@ func (s NewsService) Invoke(ctx context.Context, method string, params json.RawM
 			}
 		}
 
-		resp.Set(s.Update(ctx, args.News))
+		resp.Set(s.Update(ctx, args.User))
 
-	case RPC.NewsService.Delete:
+	case RPC.UserService.Delete:
 		var args = struct {
 			Id int `json:"id"`
 		}{}
@@ -1817,13 +8215,13 @@ func (s NewsService) Invoke(ctx context.Context, method string, params json.RawM
 
 		resp.Set(s.Delete(ctx, args.Id))
 
-	case RPC.NewsService.Validate:
+	case RPC.UserService.UpdateStatus:
 		var args = struct {
-			News News `json:"news"`
+			StatusUpdate StatusUpdate `json:"statusUpdate"`
 		}{}
 
 		if zenrpc.IsArray(params) {
-			if params, err = zenrpc.ConvertToObject([]string{"news"}, params); err != nil {
+			if params, err = zenrpc.ConvertToObject([]string{"statusUpdate"}, params); err != nil {
 				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
 			}
 		}
@@ -1834,7 +8232,142 @@ func (s NewsService) Invoke(ctx context.Context, method string, params json.RawM
 			}
 		}
 
-		resp.Set(s.Validate(ctx, args.News))
+		resp.Set(s.UpdateStatus(ctx, args.StatusUpdate))
+
+	case RPC.UserService.DeleteMany:
+		var args = struct {
+			Ids []int `json:"ids"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"ids"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.DeleteMany(ctx, args.Ids))
+
+	case RPC.UserService.CountLoginAttempts:
+		var args = struct {
+			Search *LoginAttemptSearch `json:"search"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"search"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.CountLoginAttempts(ctx, args.Search))
+
+	case RPC.UserService.GetLoginAttempts:
+		var args = struct {
+			Search  *LoginAttemptSearch `json:"search"`
+			ViewOps *ViewOps            `json:"viewOps"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"search", "viewOps"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.GetLoginAttempts(ctx, args.Search, args.ViewOps))
+
+	case RPC.UserService.Unlock:
+		var args = struct {
+			Login string `json:"login"`
+			Ip    string `json:"ip"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"login", "ip"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Unlock(ctx, args.Login, args.Ip))
+
+	case RPC.UserService.ResetTOTP:
+		var args = struct {
+			Id int `json:"id"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"id"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.ResetTOTP(ctx, args.Id))
+
+	case RPC.UserService.Permissions:
+		var args = struct {
+			Id int `json:"id"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"id"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Permissions(ctx, args.Id))
+
+	case RPC.UserService.Validate:
+		var args = struct {
+			User User `json:"user"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"user"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Validate(ctx, args.User))
 
 	default:
 		resp = zenrpc.NewResponseError(nil, zenrpc.MethodNotFound, "", nil)
@@ -1843,18 +8376,18 @@ func (s NewsService) Invoke(ctx context.Context, method string, params json.RawM
 	return resp
 }
 
-func (TagService) SMD() smd.ServiceInfo {
+func (RoleService) SMD() smd.ServiceInfo {
 	return smd.ServiceInfo{
 		Methods: map[string]smd.Service{
 			"Count": {
-				Description: `Count returns count Tags according to conditions in search params.`,
+				Description: `Count Roles according to conditions in search params`,
 				Parameters: []smd.JSONSchema{
 					{
 						Name:        "search",
 						Optional:    true,
-						Description: `TagSearch`,
+						Description: `RoleSearch`,
 						Type:        smd.Object,
-						TypeName:    "TagSearch",
+						TypeName:    "RoleSearch",
 						Properties: smd.PropertyList{
 							{
 								Name:     "id",
@@ -1862,7 +8395,7 @@ func (TagService) SMD() smd.ServiceInfo {
 								Type:     smd.Integer,
 							},
 							{
-								Name:     "name",
+								Name:     "title",
 								Optional: true,
 								Type:     smd.String,
 							},
@@ -1890,14 +8423,14 @@ func (TagService) SMD() smd.ServiceInfo {
 				},
 			},
 			"Get": {
-				Description: `Get returns а list of Tags according to conditions in search params.`,
+				Description: `Get а list of Roles according to conditions in search params`,
 				Parameters: []smd.JSONSchema{
 					{
 						Name:        "search",
 						Optional:    true,
-						Description: `TagSearch`,
+						Description: `RoleSearch`,
 						Type:        smd.Object,
-						TypeName:    "TagSearch",
+						TypeName:    "RoleSearch",
 						Properties: smd.PropertyList{
 							{
 								Name:     "id",
@@ -1905,7 +8438,7 @@ func (TagService) SMD() smd.ServiceInfo {
 								Type:     smd.Integer,
 							},
 							{
-								Name:     "name",
+								Name:     "title",
 								Optional: true,
 								Type:     smd.String,
 							},
@@ -1954,14 +8487,14 @@ func (TagService) SMD() smd.ServiceInfo {
 					},
 				},
 				Returns: smd.JSONSchema{
-					Description: `[]TagSummary`,
+					Description: `[]Role`,
 					Type:        smd.Array,
-					TypeName:    "[]TagSummary",
+					TypeName:    "[]Role",
 					Items: map[string]string{
-						"$ref": "#/definitions/TagSummary",
+						"$ref": "#/definitions/Role",
 					},
 					Definitions: map[string]smd.Definition{
-						"TagSummary": {
+						"Role": {
 							Type: "object",
 							Properties: smd.PropertyList{
 								{
@@ -1969,9 +8502,24 @@ func (TagService) SMD() smd.ServiceInfo {
 									Type: smd.Integer,
 								},
 								{
-									Name: "name",
+									Name: "title",
 									Type: smd.String,
 								},
+								{
+									Name: "permissions",
+									Type: smd.Array,
+									Items: map[string]string{
+										"type": smd.String,
+									},
+								},
+								{
+									Name: "isTotpRequired",
+									Type: smd.Boolean,
+								},
+								{
+									Name: "statusId",
+									Type: smd.Integer,
+								},
 								{
 									Name:     "status",
 									Optional: true,
@@ -2004,7 +8552,7 @@ func (TagService) SMD() smd.ServiceInfo {
 				},
 			},
 			"GetByID": {
-				Description: `GetByID returns a Tag by its ID.`,
+				Description: `GetByID returns a Role by its ID.`,
 				Parameters: []smd.JSONSchema{
 					{
 						Name:        "id",
@@ -2013,19 +8561,30 @@ func (TagService) SMD() smd.ServiceInfo {
 					},
 				},
 				Returns: smd.JSONSchema{
-					Description: `Tag`,
+					Description: `Role`,
 					Optional:    true,
 					Type:        smd.Object,
-					TypeName:    "Tag",
+					TypeName:    "Role",
 					Properties: smd.PropertyList{
 						{
 							Name: "id",
 							Type: smd.Integer,
 						},
 						{
-							Name: "name",
+							Name: "title",
 							Type: smd.String,
 						},
+						{
+							Name: "permissions",
+							Type: smd.Array,
+							Items: map[string]string{
+								"type": smd.String,
+							},
+						},
+						{
+							Name: "isTotpRequired",
+							Type: smd.Boolean,
+						},
 						{
 							Name: "statusId",
 							Type: smd.Integer,
@@ -2063,22 +8622,33 @@ func (TagService) SMD() smd.ServiceInfo {
 				},
 			},
 			"Add": {
-				Description: `Add adds a Tag from the query.`,
+				Description: `Add a Role from the query`,
 				Parameters: []smd.JSONSchema{
 					{
-						Name:        "tag",
-						Description: `Tag`,
+						Name:        "role",
+						Description: `Role`,
 						Type:        smd.Object,
-						TypeName:    "Tag",
+						TypeName:    "Role",
 						Properties: smd.PropertyList{
 							{
 								Name: "id",
 								Type: smd.Integer,
 							},
 							{
-								Name: "name",
+								Name: "title",
 								Type: smd.String,
 							},
+							{
+								Name: "permissions",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.String,
+								},
+							},
+							{
+								Name: "isTotpRequired",
+								Type: smd.Boolean,
+							},
 							{
 								Name: "statusId",
 								Type: smd.Integer,
@@ -2112,18 +8682,29 @@ func (TagService) SMD() smd.ServiceInfo {
 					},
 				},
 				Returns: smd.JSONSchema{
-					Description: `Tag`,
+					Description: `Role`,
 					Optional:    true,
 					Type:        smd.Object,
-					TypeName:    "Tag",
+					TypeName:    "Role",
 					Properties: smd.PropertyList{
 						{
-							Name: "id",
-							Type: smd.Integer,
+							Name: "id",
+							Type: smd.Integer,
+						},
+						{
+							Name: "title",
+							Type: smd.String,
+						},
+						{
+							Name: "permissions",
+							Type: smd.Array,
+							Items: map[string]string{
+								"type": smd.String,
+							},
 						},
 						{
-							Name: "name",
-							Type: smd.String,
+							Name: "isTotpRequired",
+							Type: smd.Boolean,
 						},
 						{
 							Name: "statusId",
@@ -2159,24 +8740,37 @@ func (TagService) SMD() smd.ServiceInfo {
 				Errors: map[int]string{
 					500: "Internal Error",
 					400: "Validation Error",
+					403: "Permissions, Which Current User Doesn't Have",
 				},
 			},
 			"Update": {
-				Description: `Update updates the Tag data identified by id from the query.`,
+				Description: `Update updates the Role data identified by id from the query`,
 				Parameters: []smd.JSONSchema{
 					{
-						Name:     "tag",
-						Type:     smd.Object,
-						TypeName: "Tag",
+						Name:        "role",
+						Description: `Role`,
+						Type:        smd.Object,
+						TypeName:    "Role",
 						Properties: smd.PropertyList{
 							{
 								Name: "id",
 								Type: smd.Integer,
 							},
 							{
-								Name: "name",
+								Name: "title",
 								Type: smd.String,
 							},
+							{
+								Name: "permissions",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.String,
+								},
+							},
+							{
+								Name: "isTotpRequired",
+								Type: smd.Boolean,
+							},
 							{
 								Name: "statusId",
 								Type: smd.Integer,
@@ -2210,18 +8804,19 @@ func (TagService) SMD() smd.ServiceInfo {
 					},
 				},
 				Returns: smd.JSONSchema{
-					Description: `Tag`,
+					Description: `Role`,
 					Type:        smd.Boolean,
-					TypeName:    "Tag",
+					TypeName:    "Role",
 				},
 				Errors: map[int]string{
 					500: "Internal Error",
 					400: "Validation Error",
+					403: "Permissions, Which Current User Doesn't Have",
 					404: "Not Found",
 				},
 			},
 			"Delete": {
-				Description: `Delete deletes the Tag by its ID.`,
+				Description: `Delete deletes the Role by its ID. Users lose its permissions.`,
 				Parameters: []smd.JSONSchema{
 					{
 						Name:        "id",
@@ -2240,22 +8835,33 @@ func (TagService) SMD() smd.ServiceInfo {
 				},
 			},
 			"Validate": {
-				Description: `Validate verifies that Tag data is valid.`,
+				Description: `Validate Verifies that Role data is valid.`,
 				Parameters: []smd.JSONSchema{
 					{
-						Name:        "tag",
-						Description: `Tag`,
+						Name:        "role",
+						Description: `Role`,
 						Type:        smd.Object,
-						TypeName:    "Tag",
+						TypeName:    "Role",
 						Properties: smd.PropertyList{
 							{
 								Name: "id",
 								Type: smd.Integer,
 							},
 							{
-								Name: "name",
+								Name: "title",
 								Type: smd.String,
 							},
+							{
+								Name: "permissions",
+								Type: smd.Array,
+								Items: map[string]string{
+									"type": smd.String,
+								},
+							},
+							{
+								Name: "isTotpRequired",
+								Type: smd.Boolean,
+							},
 							{
 								Name: "statusId",
 								Type: smd.Integer,
@@ -2342,14 +8948,14 @@ func (TagService) SMD() smd.ServiceInfo {
 }
 
 // Invoke is as generated code from zenrpc cmd
-func (s TagService) Invoke(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
+func (s RoleService) Invoke(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
 	resp := zenrpc.Response{}
 	var err error
 
 	switch method {
-	case RPC.TagService.Count:
+	case RPC.RoleService.Count:
 		var args = struct {
-			Search *TagSearch `json:"search"`
+			Search *RoleSearch `json:"search"`
 		}{}
 
 		if zenrpc.IsArray(params) {
@@ -2366,10 +8972,10 @@ func (s TagService) Invoke(ctx context.Context, method string, params json.RawMe
 
 		resp.Set(s.Count(ctx, args.Search))
 
-	case RPC.TagService.Get:
+	case RPC.RoleService.Get:
 		var args = struct {
-			Search  *TagSearch `json:"search"`
-			ViewOps *ViewOps   `json:"viewOps"`
+			Search  *RoleSearch `json:"search"`
+			ViewOps *ViewOps    `json:"viewOps"`
 		}{}
 
 		if zenrpc.IsArray(params) {
@@ -2386,7 +8992,7 @@ func (s TagService) Invoke(ctx context.Context, method string, params json.RawMe
 
 		resp.Set(s.Get(ctx, args.Search, args.ViewOps))
 
-	case RPC.TagService.GetByID:
+	case RPC.RoleService.GetByID:
 		var args = struct {
 			Id int `json:"id"`
 		}{}
@@ -2405,13 +9011,13 @@ func (s TagService) Invoke(ctx context.Context, method string, params json.RawMe
 
 		resp.Set(s.GetByID(ctx, args.Id))
 
-	case RPC.TagService.Add:
+	case RPC.RoleService.Add:
 		var args = struct {
-			Tag Tag `json:"tag"`
+			Role Role `json:"role"`
 		}{}
 
 		if zenrpc.IsArray(params) {
-			if params, err = zenrpc.ConvertToObject([]string{"tag"}, params); err != nil {
+			if params, err = zenrpc.ConvertToObject([]string{"role"}, params); err != nil {
 				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
 			}
 		}
@@ -2422,15 +9028,15 @@ func (s TagService) Invoke(ctx context.Context, method string, params json.RawMe
 			}
 		}
 
-		resp.Set(s.Add(ctx, args.Tag))
+		resp.Set(s.Add(ctx, args.Role))
 
-	case RPC.TagService.Update:
+	case RPC.RoleService.Update:
 		var args = struct {
-			Tag Tag `json:"tag"`
+			Role Role `json:"role"`
 		}{}
 
 		if zenrpc.IsArray(params) {
-			if params, err = zenrpc.ConvertToObject([]string{"tag"}, params); err != nil {
+			if params, err = zenrpc.ConvertToObject([]string{"role"}, params); err != nil {
 				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
 			}
 		}
@@ -2441,9 +9047,9 @@ func (s TagService) Invoke(ctx context.Context, method string, params json.RawMe
 			}
 		}
 
-		resp.Set(s.Update(ctx, args.Tag))
+		resp.Set(s.Update(ctx, args.Role))
 
-	case RPC.TagService.Delete:
+	case RPC.RoleService.Delete:
 		var args = struct {
 			Id int `json:"id"`
 		}{}
@@ -2462,13 +9068,13 @@ func (s TagService) Invoke(ctx context.Context, method string, params json.RawMe
 
 		resp.Set(s.Delete(ctx, args.Id))
 
-	case RPC.TagService.Validate:
+	case RPC.RoleService.Validate:
 		var args = struct {
-			Tag Tag `json:"tag"`
+			Role Role `json:"role"`
 		}{}
 
 		if zenrpc.IsArray(params) {
-			if params, err = zenrpc.ConvertToObject([]string{"tag"}, params); err != nil {
+			if params, err = zenrpc.ConvertToObject([]string{"role"}, params); err != nil {
 				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
 			}
 		}
@@ -2479,7 +9085,7 @@ func (s TagService) Invoke(ctx context.Context, method string, params json.RawMe
 			}
 		}
 
-		resp.Set(s.Validate(ctx, args.Tag))
+		resp.Set(s.Validate(ctx, args.Role))
 
 	default:
 		resp = zenrpc.NewResponseError(nil, zenrpc.MethodNotFound, "", nil)
@@ -2488,188 +9094,30 @@ func (s TagService) Invoke(ctx context.Context, method string, params json.RawMe
 	return resp
 }
 
-func (AuthService) SMD() smd.ServiceInfo {
+func (WebhookService) SMD() smd.ServiceInfo {
 	return smd.ServiceInfo{
 		Methods: map[string]smd.Service{
-			"Login": {
-				Description: `Login authenticates user.`,
-				Parameters: []smd.JSONSchema{
-					{
-						Name:        "login",
-						Description: `User login`,
-						Type:        smd.String,
-					},
-					{
-						Name:        "password",
-						Description: `User password`,
-						Type:        smd.String,
-					},
-					{
-						Name:        "remember",
-						Description: `Remember for week`,
-						Type:        smd.Boolean,
-					},
-				},
-				Returns: smd.JSONSchema{
-					Description: `User authentication key`,
-					Type:        smd.String,
-				},
-				Errors: map[int]string{
-					400: "Invalid login or password",
-					500: "Internal Error",
-				},
-			},
-			"Logout": {
-				Description: `Logout current user from every session`,
-				Parameters:  []smd.JSONSchema{},
-				Returns: smd.JSONSchema{
-					Description: `Successful logout`,
-					Type:        smd.Boolean,
-				},
-				Errors: map[int]string{
-					401: "Invalid authentication credentials",
-					500: "Internal Error",
-				},
-			},
-			"Profile": {
-				Description: `Profile is a function that returns current user profile`,
+			"Events": {
+				Description: `Events returns a list of supported webhook events.`,
 				Parameters:  []smd.JSONSchema{},
 				Returns: smd.JSONSchema{
-					Description: `UserProfile`,
-					Optional:    true,
-					Type:        smd.Object,
-					TypeName:    "UserProfile",
-					Properties: smd.PropertyList{
-						{
-							Name: "id",
-							Type: smd.Integer,
-						},
-						{
-							Name: "createdAt",
-							Type: smd.String,
-						},
-						{
-							Name: "login",
-							Type: smd.String,
-						},
-						{
-							Name:     "lastActivityAt",
-							Optional: true,
-							Type:     smd.String,
-						},
-						{
-							Name: "statusId",
-							Type: smd.Integer,
-						},
-					},
-				},
-				Errors: map[int]string{
-					401: "Invalid authentication credentials",
-				},
-			},
-			"ChangePassword": {
-				Description: `ChangePassword changes current user password.`,
-				Parameters: []smd.JSONSchema{
-					{
-						Name:        "password",
-						Description: `New user password`,
-						Type:        smd.String,
+					Description: `[]string`,
+					Type:        smd.Array,
+					TypeName:    "[]",
+					Items: map[string]string{
+						"type": smd.String,
 					},
 				},
-				Returns: smd.JSONSchema{
-					Description: `New user authentication key`,
-					Type:        smd.String,
-				},
-				Errors: map[int]string{
-					401: "Invalid authentication credentials",
-					500: "Internal Error",
-				},
-			},
-			"VfsAuthToken": {
-				Description: `VfsAuthToken get auth token for VFS requests`,
-				Parameters:  []smd.JSONSchema{},
-				Returns: smd.JSONSchema{
-					Type: smd.String,
-				},
 			},
-		},
-	}
-}
-
-// Invoke is as generated code from zenrpc cmd
-func (s AuthService) Invoke(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
-	resp := zenrpc.Response{}
-	var err error
-
-	switch method {
-	case RPC.AuthService.Login:
-		var args = struct {
-			Login    string `json:"login"`
-			Password string `json:"password"`
-			Remember bool   `json:"remember"`
-		}{}
-
-		if zenrpc.IsArray(params) {
-			if params, err = zenrpc.ConvertToObject([]string{"login", "password", "remember"}, params); err != nil {
-				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
-			}
-		}
-
-		if len(params) > 0 {
-			if err := json.Unmarshal(params, &args); err != nil {
-				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
-			}
-		}
-
-		resp.Set(s.Login(ctx, args.Login, args.Password, args.Remember))
-
-	case RPC.AuthService.Logout:
-		resp.Set(s.Logout(ctx))
-
-	case RPC.AuthService.Profile:
-		resp.Set(s.Profile(ctx))
-
-	case RPC.AuthService.ChangePassword:
-		var args = struct {
-			Password string `json:"password"`
-		}{}
-
-		if zenrpc.IsArray(params) {
-			if params, err = zenrpc.ConvertToObject([]string{"password"}, params); err != nil {
-				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
-			}
-		}
-
-		if len(params) > 0 {
-			if err := json.Unmarshal(params, &args); err != nil {
-				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
-			}
-		}
-
-		resp.Set(s.ChangePassword(ctx, args.Password))
-
-	case RPC.AuthService.VfsAuthToken:
-		resp.Set(s.VfsAuthToken(ctx))
-
-	default:
-		resp = zenrpc.NewResponseError(nil, zenrpc.MethodNotFound, "", nil)
-	}
-
-	return resp
-}
-
-func (UserService) SMD() smd.ServiceInfo {
-	return smd.ServiceInfo{
-		Methods: map[string]smd.Service{
 			"Count": {
-				Description: `Count Users according to conditions in search params`,
+				Description: `Count returns count Webhooks according to conditions in search params.`,
 				Parameters: []smd.JSONSchema{
 					{
 						Name:        "search",
 						Optional:    true,
-						Description: `UserSearch`,
+						Description: `WebhookSearch`,
 						Type:        smd.Object,
-						TypeName:    "UserSearch",
+						TypeName:    "WebhookSearch",
 						Properties: smd.PropertyList{
 							{
 								Name:     "id",
@@ -2677,24 +9125,19 @@ func (UserService) SMD() smd.ServiceInfo {
 								Type:     smd.Integer,
 							},
 							{
-								Name:     "login",
+								Name:     "title",
 								Optional: true,
 								Type:     smd.String,
 							},
 							{
-								Name:     "statusId",
-								Optional: true,
-								Type:     smd.Integer,
-							},
-							{
-								Name:     "lastActivityAtFrom",
+								Name:     "url",
 								Optional: true,
 								Type:     smd.String,
 							},
 							{
-								Name:     "lastActivityAtTo",
+								Name:     "statusId",
 								Optional: true,
-								Type:     smd.String,
+								Type:     smd.Integer,
 							},
 							{
 								Name: "ids",
@@ -2703,11 +9146,6 @@ func (UserService) SMD() smd.ServiceInfo {
 									"type": smd.Integer,
 								},
 							},
-							{
-								Name:     "notId",
-								Optional: true,
-								Type:     smd.Integer,
-							},
 						},
 					},
 				},
@@ -2720,40 +9158,35 @@ func (UserService) SMD() smd.ServiceInfo {
 				},
 			},
 			"Get": {
-				Description: `Get а list of Users according to conditions in search params`,
+				Description: `Get returns а list of Webhooks according to conditions in search params.`,
 				Parameters: []smd.JSONSchema{
 					{
 						Name:        "search",
 						Optional:    true,
-						Description: `UserSearch`,
+						Description: `WebhookSearch`,
 						Type:        smd.Object,
-						TypeName:    "UserSearch",
-						Properties: smd.PropertyList{
-							{
-								Name:     "id",
-								Optional: true,
-								Type:     smd.Integer,
-							},
-							{
-								Name:     "login",
-								Optional: true,
-								Type:     smd.String,
-							},
+						TypeName:    "WebhookSearch",
+						Properties: smd.PropertyList{
 							{
-								Name:     "statusId",
+								Name:     "id",
 								Optional: true,
 								Type:     smd.Integer,
 							},
 							{
-								Name:     "lastActivityAtFrom",
+								Name:     "title",
 								Optional: true,
 								Type:     smd.String,
 							},
 							{
-								Name:     "lastActivityAtTo",
+								Name:     "url",
 								Optional: true,
 								Type:     smd.String,
 							},
+							{
+								Name:     "statusId",
+								Optional: true,
+								Type:     smd.Integer,
+							},
 							{
 								Name: "ids",
 								Type: smd.Array,
@@ -2761,11 +9194,6 @@ func (UserService) SMD() smd.ServiceInfo {
 									"type": smd.Integer,
 								},
 							},
-							{
-								Name:     "notId",
-								Optional: true,
-								Type:     smd.Integer,
-							},
 						},
 					},
 					{
@@ -2799,14 +9227,14 @@ func (UserService) SMD() smd.ServiceInfo {
 					},
 				},
 				Returns: smd.JSONSchema{
-					Description: `[]UserSummary`,
+					Description: `[]WebhookSummary`,
 					Type:        smd.Array,
-					TypeName:    "[]UserSummary",
+					TypeName:    "[]WebhookSummary",
 					Items: map[string]string{
-						"$ref": "#/definitions/UserSummary",
+						"$ref": "#/definitions/WebhookSummary",
 					},
 					Definitions: map[string]smd.Definition{
-						"UserSummary": {
+						"WebhookSummary": {
 							Type: "object",
 							Properties: smd.PropertyList{
 								{
@@ -2814,17 +9242,23 @@ func (UserService) SMD() smd.ServiceInfo {
 									Type: smd.Integer,
 								},
 								{
-									Name: "createdAt",
+									Name: "title",
 									Type: smd.String,
 								},
 								{
-									Name: "login",
+									Name: "url",
 									Type: smd.String,
 								},
 								{
-									Name:     "lastActivityAt",
-									Optional: true,
-									Type:     smd.String,
+									Name: "events",
+									Type: smd.Array,
+									Items: map[string]string{
+										"type": smd.String,
+									},
+								},
+								{
+									Name: "createdAt",
+									Type: smd.String,
 								},
 								{
 									Name:     "status",
@@ -2858,7 +9292,7 @@ func (UserService) SMD() smd.ServiceInfo {
 				},
 			},
 			"GetByID": {
-				Description: `GetByID returns a User by its ID.`,
+				Description: `GetByID returns a Webhook by its ID.`,
 				Parameters: []smd.JSONSchema{
 					{
 						Name:        "id",
@@ -2867,31 +9301,39 @@ func (UserService) SMD() smd.ServiceInfo {
 					},
 				},
 				Returns: smd.JSONSchema{
-					Description: `User`,
+					Description: `Webhook`,
 					Optional:    true,
 					Type:        smd.Object,
-					TypeName:    "User",
+					TypeName:    "Webhook",
 					Properties: smd.PropertyList{
 						{
 							Name: "id",
 							Type: smd.Integer,
 						},
 						{
-							Name: "createdAt",
+							Name: "title",
 							Type: smd.String,
 						},
 						{
-							Name: "login",
+							Name: "url",
 							Type: smd.String,
 						},
 						{
-							Name: "password",
-							Type: smd.String,
+							Name:        "secret",
+							Description: `Secret is a key of request signature, generated if empty.`,
+							Type:        smd.String,
 						},
 						{
-							Name:     "lastActivityAt",
-							Optional: true,
-							Type:     smd.String,
+							Name:        "events",
+							Description: `Events is a list of subscribed events, empty list means all events.`,
+							Type:        smd.Array,
+							Items: map[string]string{
+								"type": smd.String,
+							},
+						},
+						{
+							Name: "createdAt",
+							Type: smd.String,
 						},
 						{
 							Name: "statusId",
@@ -2930,34 +9372,42 @@ func (UserService) SMD() smd.ServiceInfo {
 				},
 			},
 			"Add": {
-				Description: `Add a User from the query`,
+				Description: `Add adds a Webhook from the query. Secret is generated if empty.`,
 				Parameters: []smd.JSONSchema{
 					{
-						Name:        "user",
-						Description: `User`,
+						Name:        "webhook",
+						Description: `Webhook`,
 						Type:        smd.Object,
-						TypeName:    "User",
+						TypeName:    "Webhook",
 						Properties: smd.PropertyList{
 							{
 								Name: "id",
 								Type: smd.Integer,
 							},
 							{
-								Name: "createdAt",
+								Name: "title",
 								Type: smd.String,
 							},
 							{
-								Name: "login",
+								Name: "url",
 								Type: smd.String,
 							},
 							{
-								Name: "password",
-								Type: smd.String,
+								Name:        "secret",
+								Description: `Secret is a key of request signature, generated if empty.`,
+								Type:        smd.String,
 							},
 							{
-								Name:     "lastActivityAt",
-								Optional: true,
-								Type:     smd.String,
+								Name:        "events",
+								Description: `Events is a list of subscribed events, empty list means all events.`,
+								Type:        smd.Array,
+								Items: map[string]string{
+									"type": smd.String,
+								},
+							},
+							{
+								Name: "createdAt",
+								Type: smd.String,
 							},
 							{
 								Name: "statusId",
@@ -2992,31 +9442,39 @@ func (UserService) SMD() smd.ServiceInfo {
 					},
 				},
 				Returns: smd.JSONSchema{
-					Description: `User`,
+					Description: `Webhook`,
 					Optional:    true,
 					Type:        smd.Object,
-					TypeName:    "User",
+					TypeName:    "Webhook",
 					Properties: smd.PropertyList{
 						{
 							Name: "id",
 							Type: smd.Integer,
 						},
 						{
-							Name: "createdAt",
+							Name: "title",
 							Type: smd.String,
 						},
 						{
-							Name: "login",
+							Name: "url",
 							Type: smd.String,
 						},
 						{
-							Name: "password",
-							Type: smd.String,
+							Name:        "secret",
+							Description: `Secret is a key of request signature, generated if empty.`,
+							Type:        smd.String,
 						},
 						{
-							Name:     "lastActivityAt",
-							Optional: true,
-							Type:     smd.String,
+							Name:        "events",
+							Description: `Events is a list of subscribed events, empty list means all events.`,
+							Type:        smd.Array,
+							Items: map[string]string{
+								"type": smd.String,
+							},
+						},
+						{
+							Name: "createdAt",
+							Type: smd.String,
 						},
 						{
 							Name: "statusId",
@@ -3055,33 +9513,42 @@ func (UserService) SMD() smd.ServiceInfo {
 				},
 			},
 			"Update": {
-				Description: `Update updates the User data identified by id from the query`,
+				Description: `Update updates the Webhook data identified by id from the query. Secret is kept if empty.`,
 				Parameters: []smd.JSONSchema{
 					{
-						Name:     "user",
-						Type:     smd.Object,
-						TypeName: "User",
+						Name:        "webhook",
+						Description: `Webhook`,
+						Type:        smd.Object,
+						TypeName:    "Webhook",
 						Properties: smd.PropertyList{
 							{
 								Name: "id",
 								Type: smd.Integer,
 							},
 							{
-								Name: "createdAt",
+								Name: "title",
 								Type: smd.String,
 							},
 							{
-								Name: "login",
+								Name: "url",
 								Type: smd.String,
 							},
 							{
-								Name: "password",
-								Type: smd.String,
+								Name:        "secret",
+								Description: `Secret is a key of request signature, generated if empty.`,
+								Type:        smd.String,
 							},
 							{
-								Name:     "lastActivityAt",
-								Optional: true,
-								Type:     smd.String,
+								Name:        "events",
+								Description: `Events is a list of subscribed events, empty list means all events.`,
+								Type:        smd.Array,
+								Items: map[string]string{
+									"type": smd.String,
+								},
+							},
+							{
+								Name: "createdAt",
+								Type: smd.String,
 							},
 							{
 								Name: "statusId",
@@ -3116,9 +9583,9 @@ func (UserService) SMD() smd.ServiceInfo {
 					},
 				},
 				Returns: smd.JSONSchema{
-					Description: `User`,
+					Description: `Webhook`,
 					Type:        smd.Boolean,
-					TypeName:    "User",
+					TypeName:    "Webhook",
 				},
 				Errors: map[int]string{
 					500: "Internal Error",
@@ -3127,7 +9594,7 @@ func (UserService) SMD() smd.ServiceInfo {
 				},
 			},
 			"Delete": {
-				Description: `Delete deletes the User by its ID.`,
+				Description: `Delete deletes the Webhook by its ID. Its pending deliveries are failed by dispatcher.`,
 				Parameters: []smd.JSONSchema{
 					{
 						Name:        "id",
@@ -3145,108 +9612,368 @@ func (UserService) SMD() smd.ServiceInfo {
 					404: "Not Found",
 				},
 			},
-			"Validate": {
-				Description: `Validate Verifies that User data is valid.`,
+			"Validate": {
+				Description: `Validate verifies that Webhook data is valid.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "webhook",
+						Description: `Webhook`,
+						Type:        smd.Object,
+						TypeName:    "Webhook",
+						Properties: smd.PropertyList{
+							{
+								Name: "id",
+								Type: smd.Integer,
+							},
+							{
+								Name: "title",
+								Type: smd.String,
+							},
+							{
+								Name: "url",
+								Type: smd.String,
+							},
+							{
+								Name:        "secret",
+								Description: `Secret is a key of request signature, generated if empty.`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "events",
+								Description: `Events is a list of subscribed events, empty list means all events.`,
+								Type:        smd.Array,
+								Items: map[string]string{
+									"type": smd.String,
+								},
+							},
+							{
+								Name: "createdAt",
+								Type: smd.String,
+							},
+							{
+								Name: "statusId",
+								Type: smd.Integer,
+							},
+							{
+								Name:     "status",
+								Optional: true,
+								Ref:      "#/definitions/Status",
+								Type:     smd.Object,
+							},
+						},
+						Definitions: map[string]smd.Definition{
+							"Status": {
+								Type: "object",
+								Properties: smd.PropertyList{
+									{
+										Name: "id",
+										Type: smd.Integer,
+									},
+									{
+										Name: "alias",
+										Type: smd.String,
+									},
+									{
+										Name: "title",
+										Type: smd.String,
+									},
+								},
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `[]FieldError`,
+					Type:        smd.Array,
+					TypeName:    "[]FieldError",
+					Items: map[string]string{
+						"$ref": "#/definitions/FieldError",
+					},
+					Definitions: map[string]smd.Definition{
+						"FieldError": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "field",
+									Type: smd.String,
+								},
+								{
+									Name: "error",
+									Type: smd.String,
+								},
+								{
+									Name:        "constraint",
+									Optional:    true,
+									Description: `Help with generating an error message.`,
+									Ref:         "#/definitions/FieldErrorConstraint",
+									Type:        smd.Object,
+								},
+							},
+						},
+						"FieldErrorConstraint": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name:        "max",
+									Description: `Max value for field.`,
+									Type:        smd.Integer,
+								},
+								{
+									Name:        "min",
+									Description: `Min value for field.`,
+									Type:        smd.Integer,
+								},
+							},
+						},
+					},
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+				},
+			},
+			"CountDeliveries": {
+				Description: `CountDeliveries returns count of WebhookDeliveries according to conditions in search params.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "search",
+						Optional:    true,
+						Description: `WebhookDeliverySearch`,
+						Type:        smd.Object,
+						TypeName:    "WebhookDeliverySearch",
+						Properties: smd.PropertyList{
+							{
+								Name:     "id",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "webhookId",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "event",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "deliveryStatusId",
+								Optional: true,
+								Type:     smd.Integer,
+							},
+							{
+								Name:     "createdAtFrom",
+								Optional: true,
+								Type:     smd.String,
+							},
+							{
+								Name:     "createdAtTo",
+								Optional: true,
+								Type:     smd.String,
+							},
+						},
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `int`,
+					Type:        smd.Integer,
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+				},
+			},
+			"GetDeliveries": {
+				Description: `GetDeliveries returns а delivery log according to conditions in search params, latest first.`,
 				Parameters: []smd.JSONSchema{
 					{
-						Name:        "user",
-						Description: `User`,
+						Name:        "search",
+						Optional:    true,
+						Description: `WebhookDeliverySearch`,
 						Type:        smd.Object,
-						TypeName:    "User",
+						TypeName:    "WebhookDeliverySearch",
 						Properties: smd.PropertyList{
 							{
-								Name: "id",
-								Type: smd.Integer,
+								Name:     "id",
+								Optional: true,
+								Type:     smd.Integer,
 							},
 							{
-								Name: "createdAt",
-								Type: smd.String,
+								Name:     "webhookId",
+								Optional: true,
+								Type:     smd.Integer,
 							},
 							{
-								Name: "login",
-								Type: smd.String,
+								Name:     "event",
+								Optional: true,
+								Type:     smd.String,
 							},
 							{
-								Name: "password",
-								Type: smd.String,
+								Name:     "deliveryStatusId",
+								Optional: true,
+								Type:     smd.Integer,
 							},
 							{
-								Name:     "lastActivityAt",
+								Name:     "createdAtFrom",
 								Optional: true,
 								Type:     smd.String,
 							},
 							{
-								Name: "statusId",
-								Type: smd.Integer,
-							},
-							{
-								Name:     "status",
+								Name:     "createdAtTo",
 								Optional: true,
-								Ref:      "#/definitions/Status",
-								Type:     smd.Object,
+								Type:     smd.String,
 							},
 						},
-						Definitions: map[string]smd.Definition{
-							"Status": {
-								Type: "object",
-								Properties: smd.PropertyList{
-									{
-										Name: "id",
-										Type: smd.Integer,
-									},
-									{
-										Name: "alias",
-										Type: smd.String,
-									},
-									{
-										Name: "title",
-										Type: smd.String,
-									},
-								},
+					},
+					{
+						Name:        "viewOps",
+						Optional:    true,
+						Description: `ViewOps`,
+						Type:        smd.Object,
+						TypeName:    "ViewOps",
+						Properties: smd.PropertyList{
+							{
+								Name:        "page",
+								Description: `page number, default - 1`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "pageSize",
+								Description: `items count per page, max - 500`,
+								Type:        smd.Integer,
+							},
+							{
+								Name:        "sortColumn",
+								Description: `sort by column name`,
+								Type:        smd.String,
+							},
+							{
+								Name:        "sortDesc",
+								Description: `descending sort`,
+								Type:        smd.Boolean,
 							},
 						},
 					},
 				},
 				Returns: smd.JSONSchema{
-					Description: `[]FieldError`,
+					Description: `[]WebhookDelivery`,
 					Type:        smd.Array,
-					TypeName:    "[]FieldError",
+					TypeName:    "[]WebhookDelivery",
 					Items: map[string]string{
-						"$ref": "#/definitions/FieldError",
+						"$ref": "#/definitions/WebhookDelivery",
 					},
 					Definitions: map[string]smd.Definition{
-						"FieldError": {
+						"WebhookDelivery": {
 							Type: "object",
 							Properties: smd.PropertyList{
 								{
-									Name: "field",
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "webhookId",
+									Type: smd.Integer,
+								},
+								{
+									Name: "event",
 									Type: smd.String,
 								},
 								{
-									Name: "error",
+									Name: "payload",
 									Type: smd.String,
 								},
 								{
-									Name:        "constraint",
-									Optional:    true,
-									Description: `Help with generating an error message.`,
-									Ref:         "#/definitions/FieldErrorConstraint",
-									Type:        smd.Object,
+									Name: "attempts",
+									Type: smd.Integer,
+								},
+								{
+									Name: "nextAttemptAt",
+									Type: smd.String,
+								},
+								{
+									Name:     "responseCode",
+									Optional: true,
+									Type:     smd.Integer,
+								},
+								{
+									Name:     "error",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name:     "deliveredAt",
+									Optional: true,
+									Type:     smd.String,
+								},
+								{
+									Name: "createdAt",
+									Type: smd.String,
+								},
+								{
+									Name: "deliveryStatusId",
+									Type: smd.Integer,
+								},
+								{
+									Name:     "webhook",
+									Optional: true,
+									Ref:      "#/definitions/WebhookSummary",
+									Type:     smd.Object,
+								},
+								{
+									Name:     "deliveryStatus",
+									Optional: true,
+									Ref:      "#/definitions/Status",
+									Type:     smd.Object,
 								},
 							},
 						},
-						"FieldErrorConstraint": {
+						"WebhookSummary": {
 							Type: "object",
 							Properties: smd.PropertyList{
 								{
-									Name:        "max",
-									Description: `Max value for field.`,
-									Type:        smd.Integer,
+									Name: "id",
+									Type: smd.Integer,
 								},
 								{
-									Name:        "min",
-									Description: `Min value for field.`,
-									Type:        smd.Integer,
+									Name: "title",
+									Type: smd.String,
+								},
+								{
+									Name: "url",
+									Type: smd.String,
+								},
+								{
+									Name: "events",
+									Type: smd.Array,
+									Items: map[string]string{
+										"type": smd.String,
+									},
+								},
+								{
+									Name: "createdAt",
+									Type: smd.String,
+								},
+								{
+									Name:     "status",
+									Optional: true,
+									Ref:      "#/definitions/Status",
+									Type:     smd.Object,
+								},
+							},
+						},
+						"Status": {
+							Type: "object",
+							Properties: smd.PropertyList{
+								{
+									Name: "id",
+									Type: smd.Integer,
+								},
+								{
+									Name: "alias",
+									Type: smd.String,
+								},
+								{
+									Name: "title",
+									Type: smd.String,
 								},
 							},
 						},
@@ -3256,19 +9983,40 @@ func (UserService) SMD() smd.ServiceInfo {
 					500: "Internal Error",
 				},
 			},
+			"Redeliver": {
+				Description: `Redeliver schedules the WebhookDelivery to be sent again as soon as possible with a new attempts counter.`,
+				Parameters: []smd.JSONSchema{
+					{
+						Name:        "id",
+						Description: `int`,
+						Type:        smd.Integer,
+					},
+				},
+				Returns: smd.JSONSchema{
+					Description: `isScheduled`,
+					Type:        smd.Boolean,
+				},
+				Errors: map[int]string{
+					500: "Internal Error",
+					404: "Not Found",
+				},
+			},
 		},
 	}
 }
 
 // Invoke is as generated code from zenrpc cmd
-func (s UserService) Invoke(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
+func (s WebhookService) Invoke(ctx context.Context, method string, params json.RawMessage) zenrpc.Response {
 	resp := zenrpc.Response{}
 	var err error
 
 	switch method {
-	case RPC.UserService.Count:
+	case RPC.WebhookService.Events:
+		resp.Set(s.Events())
+
+	case RPC.WebhookService.Count:
 		var args = struct {
-			Search *UserSearch `json:"search"`
+			Search *WebhookSearch `json:"search"`
 		}{}
 
 		if zenrpc.IsArray(params) {
@@ -3285,10 +10033,10 @@ func (s UserService) Invoke(ctx context.Context, method string, params json.RawM
 
 		resp.Set(s.Count(ctx, args.Search))
 
-	case RPC.UserService.Get:
+	case RPC.WebhookService.Get:
 		var args = struct {
-			Search  *UserSearch `json:"search"`
-			ViewOps *ViewOps    `json:"viewOps"`
+			Search  *WebhookSearch `json:"search"`
+			ViewOps *ViewOps       `json:"viewOps"`
 		}{}
 
 		if zenrpc.IsArray(params) {
@@ -3305,7 +10053,7 @@ func (s UserService) Invoke(ctx context.Context, method string, params json.RawM
 
 		resp.Set(s.Get(ctx, args.Search, args.ViewOps))
 
-	case RPC.UserService.GetByID:
+	case RPC.WebhookService.GetByID:
 		var args = struct {
 			Id int `json:"id"`
 		}{}
@@ -3324,13 +10072,13 @@ func (s UserService) Invoke(ctx context.Context, method string, params json.RawM
 
 		resp.Set(s.GetByID(ctx, args.Id))
 
-	case RPC.UserService.Add:
+	case RPC.WebhookService.Add:
 		var args = struct {
-			User User `json:"user"`
+			Webhook Webhook `json:"webhook"`
 		}{}
 
 		if zenrpc.IsArray(params) {
-			if params, err = zenrpc.ConvertToObject([]string{"user"}, params); err != nil {
+			if params, err = zenrpc.ConvertToObject([]string{"webhook"}, params); err != nil {
 				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
 			}
 		}
@@ -3341,15 +10089,15 @@ func (s UserService) Invoke(ctx context.Context, method string, params json.RawM
 			}
 		}
 
-		resp.Set(s.Add(ctx, args.User))
+		resp.Set(s.Add(ctx, args.Webhook))
 
-	case RPC.UserService.Update:
+	case RPC.WebhookService.Update:
 		var args = struct {
-			User User `json:"user"`
+			Webhook Webhook `json:"webhook"`
 		}{}
 
 		if zenrpc.IsArray(params) {
-			if params, err = zenrpc.ConvertToObject([]string{"user"}, params); err != nil {
+			if params, err = zenrpc.ConvertToObject([]string{"webhook"}, params); err != nil {
 				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
 			}
 		}
@@ -3360,9 +10108,9 @@ func (s UserService) Invoke(ctx context.Context, method string, params json.RawM
 			}
 		}
 
-		resp.Set(s.Update(ctx, args.User))
+		resp.Set(s.Update(ctx, args.Webhook))
 
-	case RPC.UserService.Delete:
+	case RPC.WebhookService.Delete:
 		var args = struct {
 			Id int `json:"id"`
 		}{}
@@ -3381,13 +10129,13 @@ func (s UserService) Invoke(ctx context.Context, method string, params json.RawM
 
 		resp.Set(s.Delete(ctx, args.Id))
 
-	case RPC.UserService.Validate:
+	case RPC.WebhookService.Validate:
 		var args = struct {
-			User User `json:"user"`
+			Webhook Webhook `json:"webhook"`
 		}{}
 
 		if zenrpc.IsArray(params) {
-			if params, err = zenrpc.ConvertToObject([]string{"user"}, params); err != nil {
+			if params, err = zenrpc.ConvertToObject([]string{"webhook"}, params); err != nil {
 				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
 			}
 		}
@@ -3398,7 +10146,65 @@ func (s UserService) Invoke(ctx context.Context, method string, params json.RawM
 			}
 		}
 
-		resp.Set(s.Validate(ctx, args.User))
+		resp.Set(s.Validate(ctx, args.Webhook))
+
+	case RPC.WebhookService.CountDeliveries:
+		var args = struct {
+			Search *WebhookDeliverySearch `json:"search"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"search"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.CountDeliveries(ctx, args.Search))
+
+	case RPC.WebhookService.GetDeliveries:
+		var args = struct {
+			Search  *WebhookDeliverySearch `json:"search"`
+			ViewOps *ViewOps               `json:"viewOps"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"search", "viewOps"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.GetDeliveries(ctx, args.Search, args.ViewOps))
+
+	case RPC.WebhookService.Redeliver:
+		var args = struct {
+			Id int `json:"id"`
+		}{}
+
+		if zenrpc.IsArray(params) {
+			if params, err = zenrpc.ConvertToObject([]string{"id"}, params); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		if len(params) > 0 {
+			if err := json.Unmarshal(params, &args); err != nil {
+				return zenrpc.NewResponseError(nil, zenrpc.InvalidParams, "", err.Error())
+			}
+		}
+
+		resp.Set(s.Redeliver(ctx, args.Id))
 
 	default:
 		resp = zenrpc.NewResponseError(nil, zenrpc.MethodNotFound, "", nil)
@@ -0,0 +1,279 @@
+package vt
+
+import (
+	"context"
+	"crypto/rand"
+	"time"
+
+	"apisrv/pkg/db"
+	"apisrv/pkg/webhook"
+
+	"github.com/vmkteam/embedlog"
+	"github.com/vmkteam/zenrpc/v2"
+)
+
+type WebhookService struct {
+	zenrpc.Service
+	embedlog.Logger
+	webhookRepo db.WebhookRepo
+}
+
+func NewWebhookService(dbo db.DB, logger embedlog.Logger) *WebhookService {
+	return &WebhookService{
+		Logger:      logger,
+		webhookRepo: db.NewWebhookRepo(dbo),
+	}
+}
+
+func (s WebhookService) dbSort(ops *ViewOps) db.OpFunc {
+	v := s.webhookRepo.DefaultWebhookSort()
+	if ops == nil {
+		return v
+	}
+
+	switch ops.SortColumn {
+	case db.Columns.Webhook.ID, db.Columns.Webhook.Title, db.Columns.Webhook.URL, db.Columns.Webhook.CreatedAt, db.Columns.Webhook.StatusID:
+		v = db.WithSort(db.NewSortField(ops.SortColumn, ops.SortDesc))
+	}
+
+	return v
+}
+
+// Events returns a list of supported webhook events.
+//
+//zenrpc:return []string
+func (s WebhookService) Events() []string {
+	events := make([]string, 0, len(webhook.Events))
+	for _, e := range webhook.Events {
+		events = append(events, string(e))
+	}
+	return events
+}
+
+// Count returns count Webhooks according to conditions in search params.
+//
+//zenrpc:search WebhookSearch
+//zenrpc:return int
+//zenrpc:500 Internal Error
+func (s WebhookService) Count(ctx context.Context, search *WebhookSearch) (int, error) {
+	count, err := s.webhookRepo.CountWebhooks(ctx, search.ToDB())
+	if err != nil {
+		return 0, InternalError(err)
+	}
+	return count, nil
+}
+
+// Get returns а list of Webhooks according to conditions in search params.
+//
+//zenrpc:search WebhookSearch
+//zenrpc:viewOps ViewOps
+//zenrpc:return []WebhookSummary
+//zenrpc:500 Internal Error
+func (s WebhookService) Get(ctx context.Context, search *WebhookSearch, viewOps *ViewOps) ([]WebhookSummary, error) {
+	list, err := s.webhookRepo.WebhooksByFilters(ctx, search.ToDB(), viewOps.Pager(), s.dbSort(viewOps), s.webhookRepo.FullWebhook())
+	if err != nil {
+		return nil, InternalError(err)
+	}
+	webhooks := make([]WebhookSummary, 0, len(list))
+	for i := 0; i < len(list); i++ {
+		if webhook := NewWebhookSummary(&list[i]); webhook != nil {
+			webhooks = append(webhooks, *webhook)
+		}
+	}
+	return webhooks, nil
+}
+
+// GetByID returns a Webhook by its ID.
+//
+//zenrpc:id int
+//zenrpc:return Webhook
+//zenrpc:500 Internal Error
+//zenrpc:404 Not Found
+func (s WebhookService) GetByID(ctx context.Context, id int) (*Webhook, error) {
+	db, err := s.byID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return NewWebhook(db), nil
+}
+
+func (s WebhookService) byID(ctx context.Context, id int) (*db.Webhook, error) {
+	db, err := s.webhookRepo.WebhookByID(ctx, id, s.webhookRepo.FullWebhook())
+	if err != nil {
+		return nil, InternalError(err)
+	} else if db == nil {
+		return nil, ErrNotFound
+	}
+	return db, nil
+}
+
+// Add adds a Webhook from the query. Secret is generated if empty.
+//
+//zenrpc:webhook Webhook
+//zenrpc:return Webhook
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+func (s WebhookService) Add(ctx context.Context, webhook Webhook) (*Webhook, error) {
+	if ve := s.isValid(ctx, webhook, false); ve.HasErrors() {
+		return nil, ve.Error()
+	}
+
+	if webhook.Secret == "" {
+		webhook.Secret = rand.Text()
+	}
+
+	db, err := s.webhookRepo.AddWebhook(ctx, webhook.ToDB())
+	if err != nil {
+		return nil, InternalError(err)
+	}
+	return NewWebhook(db), nil
+}
+
+// Update updates the Webhook data identified by id from the query. Secret is kept if empty.
+//
+//zenrpc:webhook Webhook
+//zenrpc:return Webhook
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+//zenrpc:404 Not Found
+func (s WebhookService) Update(ctx context.Context, webhook Webhook) (bool, error) {
+	current, err := s.byID(ctx, webhook.ID)
+	if err != nil {
+		return false, err
+	}
+
+	if ve := s.isValid(ctx, webhook, true); ve.HasErrors() {
+		return false, ve.Error()
+	}
+
+	if webhook.Secret == "" {
+		webhook.Secret = current.Secret
+	}
+
+	ok, err := s.webhookRepo.UpdateWebhook(ctx, webhook.ToDB())
+	if err != nil {
+		return false, InternalError(err)
+	}
+	return ok, nil
+}
+
+// Delete deletes the Webhook by its ID. Its pending deliveries are failed by dispatcher.
+//
+//zenrpc:id int
+//zenrpc:return isDeleted
+//zenrpc:500 Internal Error
+//zenrpc:400 Validation Error
+//zenrpc:404 Not Found
+func (s WebhookService) Delete(ctx context.Context, id int) (bool, error) {
+	if _, err := s.byID(ctx, id); err != nil {
+		return false, err
+	}
+
+	ok, err := s.webhookRepo.DeleteWebhook(ctx, id)
+	if err != nil {
+		return false, InternalError(err)
+	}
+	return ok, err
+}
+
+// Validate verifies that Webhook data is valid.
+//
+//zenrpc:webhook Webhook
+//zenrpc:return []FieldError
+//zenrpc:500 Internal Error
+func (s WebhookService) Validate(ctx context.Context, webhook Webhook) ([]FieldError, error) {
+	isUpdate := webhook.ID != 0
+	if isUpdate {
+		_, err := s.byID(ctx, webhook.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ve := s.isValid(ctx, webhook, isUpdate)
+	if ve.HasInternalError() {
+		return nil, ve.Error()
+	}
+
+	return ve.Fields(), nil
+}
+
+func (s WebhookService) isValid(ctx context.Context, hook Webhook, isUpdate bool) Validator {
+	var v Validator
+
+	if v.CheckBasic(ctx, hook); v.HasInternalError() {
+		return v
+	}
+
+	// custom validation starts here
+	for _, e := range hook.Events {
+		if !webhook.Event(e).IsValid() {
+			v.Append("events", FieldErrorIncorrect)
+			break
+		}
+	}
+
+	return v
+}
+
+// CountDeliveries returns count of WebhookDeliveries according to conditions in search params.
+//
+//zenrpc:search WebhookDeliverySearch
+//zenrpc:return int
+//zenrpc:500 Internal Error
+func (s WebhookService) CountDeliveries(ctx context.Context, search *WebhookDeliverySearch) (int, error) {
+	count, err := s.webhookRepo.CountWebhookDeliveries(ctx, search.ToDB())
+	if err != nil {
+		return 0, InternalError(err)
+	}
+	return count, nil
+}
+
+// GetDeliveries returns а delivery log according to conditions in search params, latest first.
+//
+//zenrpc:search WebhookDeliverySearch
+//zenrpc:viewOps ViewOps
+//zenrpc:return []WebhookDelivery
+//zenrpc:500 Internal Error
+func (s WebhookService) GetDeliveries(ctx context.Context, search *WebhookDeliverySearch, viewOps *ViewOps) ([]WebhookDelivery, error) {
+	list, err := s.webhookRepo.WebhookDeliveriesByFilters(ctx, search.ToDB(), viewOps.Pager(), s.webhookRepo.DefaultWebhookDeliverySort(), s.webhookRepo.FullWebhookDelivery())
+	if err != nil {
+		return nil, InternalError(err)
+	}
+	deliveries := make([]WebhookDelivery, 0, len(list))
+	for i := 0; i < len(list); i++ {
+		if delivery := NewWebhookDelivery(&list[i]); delivery != nil {
+			deliveries = append(deliveries, *delivery)
+		}
+	}
+	return deliveries, nil
+}
+
+// Redeliver schedules the WebhookDelivery to be sent again as soon as possible with a new attempts counter.
+//
+//zenrpc:id int
+//zenrpc:return isScheduled
+//zenrpc:500 Internal Error
+//zenrpc:404 Not Found
+func (s WebhookService) Redeliver(ctx context.Context, id int) (bool, error) {
+	delivery, err := s.webhookRepo.WebhookDeliveryByID(ctx, id)
+	if err != nil {
+		return false, InternalError(err)
+	} else if delivery == nil {
+		return false, ErrNotFound
+	}
+
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = time.Now()
+	delivery.DeliveryStatusID = db.DeliveryPending
+
+	ok, err := s.webhookRepo.UpdateWebhookDelivery(ctx, delivery, db.WithColumns(
+		db.Columns.WebhookDelivery.Attempts,
+		db.Columns.WebhookDelivery.NextAttemptAt,
+		db.Columns.WebhookDelivery.DeliveryStatusID,
+	))
+	if err != nil {
+		return false, InternalError(err)
+	}
+	return ok, nil
+}
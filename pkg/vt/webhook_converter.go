@@ -0,0 +1,64 @@
+package vt
+
+import (
+	"apisrv/pkg/db"
+)
+
+func NewWebhook(in *db.Webhook) *Webhook {
+	if in == nil {
+		return nil
+	}
+
+	webhook := &Webhook{
+		ID:        in.ID,
+		Title:     in.Title,
+		URL:       in.URL,
+		Secret:    in.Secret,
+		Events:    in.Events,
+		CreatedAt: in.CreatedAt,
+		StatusID:  in.StatusID,
+
+		Status: NewStatus(in.StatusID),
+	}
+
+	return webhook
+}
+
+func NewWebhookSummary(in *db.Webhook) *WebhookSummary {
+	if in == nil {
+		return nil
+	}
+
+	return &WebhookSummary{
+		ID:        in.ID,
+		Title:     in.Title,
+		URL:       in.URL,
+		Events:    in.Events,
+		CreatedAt: in.CreatedAt,
+
+		Status: NewStatus(in.StatusID),
+	}
+}
+
+func NewWebhookDelivery(in *db.WebhookDelivery) *WebhookDelivery {
+	if in == nil {
+		return nil
+	}
+
+	return &WebhookDelivery{
+		ID:               in.ID,
+		WebhookID:        in.WebhookID,
+		Event:            in.Event,
+		Payload:          in.Payload,
+		Attempts:         in.Attempts,
+		NextAttemptAt:    in.NextAttemptAt,
+		ResponseCode:     in.ResponseCode,
+		Error:            in.Error,
+		DeliveredAt:      in.DeliveredAt,
+		CreatedAt:        in.CreatedAt,
+		DeliveryStatusID: in.DeliveryStatusID,
+
+		Webhook:        NewWebhookSummary(in.Webhook),
+		DeliveryStatus: NewDeliveryStatus(in.DeliveryStatusID),
+	}
+}
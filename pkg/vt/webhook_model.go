@@ -0,0 +1,115 @@
+package vt
+
+import (
+	"time"
+
+	"apisrv/pkg/db"
+)
+
+type Webhook struct {
+	ID    int    `json:"id"`
+	Title string `json:"title" validate:"required,max=255"`
+	URL   string `json:"url" validate:"required,http_url,max=1024"`
+	// Secret is a key of request signature, generated if empty.
+	Secret string `json:"secret" validate:"omitempty,min=16,max=64"`
+	// Events is a list of subscribed events, empty list means all events.
+	Events    []string  `json:"events"`
+	CreatedAt time.Time `json:"createdAt"`
+	StatusID  int       `json:"statusId" validate:"required,status"`
+
+	Status *Status `json:"status"`
+}
+
+func (w *Webhook) ToDB() *db.Webhook {
+	if w == nil {
+		return nil
+	}
+
+	webhook := &db.Webhook{
+		ID:       w.ID,
+		Title:    w.Title,
+		URL:      w.URL,
+		Secret:   w.Secret,
+		Events:   w.Events,
+		StatusID: w.StatusID,
+	}
+
+	if webhook.Events == nil {
+		webhook.Events = []string{}
+	}
+
+	return webhook
+}
+
+type WebhookSearch struct {
+	ID       *int    `json:"id"`
+	Title    *string `json:"title"`
+	URL      *string `json:"url"`
+	StatusID *int    `json:"statusId"`
+	IDs      []int   `json:"ids"`
+}
+
+func (ws *WebhookSearch) ToDB() *db.WebhookSearch {
+	if ws == nil {
+		return nil
+	}
+
+	return &db.WebhookSearch{
+		ID:         ws.ID,
+		TitleILike: ws.Title,
+		URLILike:   ws.URL,
+		StatusID:   ws.StatusID,
+		IDs:        ws.IDs,
+	}
+}
+
+type WebhookSummary struct {
+	ID        int       `json:"id"`
+	Title     string    `json:"title"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	Status *Status `json:"status"`
+}
+
+type WebhookDelivery struct {
+	ID               int        `json:"id"`
+	WebhookID        int        `json:"webhookId"`
+	Event            string     `json:"event"`
+	Payload          string     `json:"payload"`
+	Attempts         int        `json:"attempts"`
+	NextAttemptAt    time.Time  `json:"nextAttemptAt"`
+	ResponseCode     *int       `json:"responseCode"`
+	Error            *string    `json:"error"`
+	DeliveredAt      *time.Time `json:"deliveredAt"`
+	CreatedAt        time.Time  `json:"createdAt"`
+	DeliveryStatusID int        `json:"deliveryStatusId"`
+
+	Webhook        *WebhookSummary `json:"webhook"`
+	DeliveryStatus *Status         `json:"deliveryStatus"`
+}
+
+type WebhookDeliverySearch struct {
+	ID               *int       `json:"id"`
+	WebhookID        *int       `json:"webhookId"`
+	Event            *string    `json:"event"`
+	DeliveryStatusID *int       `json:"deliveryStatusId"`
+	CreatedAtFrom    *time.Time `json:"createdAtFrom"`
+	CreatedAtTo      *time.Time `json:"createdAtTo"`
+}
+
+func (wds *WebhookDeliverySearch) ToDB() *db.WebhookDeliverySearch {
+	if wds == nil {
+		return nil
+	}
+
+	return &db.WebhookDeliverySearch{
+		ID:               wds.ID,
+		WebhookID:        wds.WebhookID,
+		Event:            wds.Event,
+		DeliveryStatusID: wds.DeliveryStatusID,
+		CreatedAtFrom:    wds.CreatedAtFrom,
+		CreatedAtTo:      wds.CreatedAtTo,
+	}
+}
@@ -0,0 +1,279 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"apisrv/pkg/db"
+
+	"github.com/vmkteam/embedlog"
+)
+
+const (
+	defaultInterval          = 5 * time.Second
+	defaultTimeout           = 10 * time.Second
+	defaultMaxAttempts       = 8
+	defaultBatchSize         = 50
+	defaultPublishedLookback = time.Hour
+
+	// retryBaseDelay is a delay after the first failed attempt, it doubles after each next one up to retryMaxDelay.
+	retryBaseDelay = 30 * time.Second
+	retryMaxDelay  = time.Hour
+	// maxErrorLen is a max length of delivery error stored in db.
+	maxErrorLen = 1024
+
+	userAgent = "apisrv-webhook/1.0"
+
+	HeaderID        = "X-Webhook-Id"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+var errWebhookDisabled = errors.New("webhook is disabled or deleted")
+
+// Config is a webhooks dispatcher configuration.
+type Config struct {
+	// Interval is an interval of pending deliveries check, default is 5s.
+	Interval time.Duration
+	// Timeout is a webhook request timeout, default is 10s.
+	Timeout time.Duration
+	// MaxAttempts is a max number of delivery attempts, default is 8.
+	MaxAttempts int
+	// BatchSize is a max number of deliveries sent at once, default is 50.
+	BatchSize int
+	// PublishedLookback is a period of news.published events emitted on the first start, when there is no saved check time, default is 1h.
+	PublishedLookback time.Duration
+}
+
+// Dispatcher sends pending webhook deliveries and emits news.published events when news publishedAt comes.
+// Failed deliveries are retried with exponential backoff.
+type Dispatcher struct {
+	embedlog.Logger
+	cfg      Config
+	repo     db.WebhookRepo
+	newsRepo db.NewsRepo
+	emitter  Emitter
+	client   *http.Client
+}
+
+func NewDispatcher(dbo db.DB, logger embedlog.Logger, cfg Config) *Dispatcher {
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultInterval
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = defaultMaxAttempts
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultBatchSize
+	}
+	if cfg.PublishedLookback <= 0 {
+		cfg.PublishedLookback = defaultPublishedLookback
+	}
+
+	return &Dispatcher{
+		Logger:   logger,
+		cfg:      cfg,
+		repo:     db.NewWebhookRepo(dbo),
+		newsRepo: db.NewNewsRepo(dbo).WithEnabledOnly(),
+		emitter:  NewEmitter(dbo),
+		client:   &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+// Run emits news.published events and sends pending deliveries every interval until ctx is done.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := d.EmitPublished(ctx, time.Now()); err != nil {
+				d.Error(ctx, "emit news published events", "err", err)
+			}
+
+			if err := d.Dispatch(ctx); err != nil {
+				d.Error(ctx, "dispatch webhooks", "err", err)
+			}
+		}
+	}
+}
+
+// EmitPublished emits news.published events for news published since the previous check till now.
+// The previous check time is saved in db, so publications made while app was stopped are emitted after restart.
+// Events are deduplicated by news publishedAt, so each publication is emitted once even with several dispatchers.
+// News, which go live with publishedAt in the past (backdated or enabled later), are emitted by Emitter.EmitPublished on save.
+func (d *Dispatcher) EmitPublished(ctx context.Context, now time.Time) error {
+	from := now.Add(-d.cfg.PublishedLookback)
+	cursor, err := d.repo.WebhookCursorByID(ctx, db.CursorNewsPublished)
+	if err != nil {
+		return fmt.Errorf("read published cursor: %w", err)
+	} else if cursor != nil {
+		from = cursor.Value
+	}
+
+	list, err := d.newsRepo.NewsByFilters(ctx, &db.NewsSearch{PublishedFrom: &from, PublishedTo: &now}, db.PagerNoLimit)
+	if err != nil {
+		return fmt.Errorf("read published news: %w", err)
+	}
+
+	for i := range list {
+		if err = d.emitter.emitPublished(ctx, &list[i]); err != nil {
+			return fmt.Errorf("news id=%d: %w", list[i].ID, err)
+		}
+	}
+
+	if err = d.repo.SetWebhookCursor(ctx, db.CursorNewsPublished, now); err != nil {
+		return fmt.Errorf("save published cursor: %w", err)
+	}
+
+	return nil
+}
+
+// Dispatch sends claimed pending deliveries concurrently.
+func (d *Dispatcher) Dispatch(ctx context.Context) error {
+	// lease covers request timeout and status update
+	deliveries, err := d.repo.ClaimWebhookDeliveries(ctx, d.cfg.BatchSize, 2*d.cfg.Timeout)
+	if err != nil {
+		return fmt.Errorf("claim deliveries: %w", err)
+	} else if len(deliveries) == 0 {
+		return nil
+	}
+
+	ids := make([]int, 0, len(deliveries))
+	for _, dl := range deliveries {
+		ids = append(ids, dl.WebhookID)
+	}
+
+	hooks, err := d.repo.WebhooksByFilters(ctx, &db.WebhookSearch{IDs: ids}, db.PagerNoLimit)
+	if err != nil {
+		return fmt.Errorf("read webhooks: %w", err)
+	}
+
+	index := make(map[int]*db.Webhook, len(hooks))
+	for i := range hooks {
+		index[hooks[i].ID] = &hooks[i]
+	}
+
+	var wg sync.WaitGroup
+	for i := range deliveries {
+		wg.Add(1)
+		go func(dl *db.WebhookDelivery) {
+			defer wg.Done()
+			if err := d.deliver(ctx, index[dl.WebhookID], dl); err != nil {
+				d.Error(ctx, "update webhook delivery", "deliveryId", dl.ID, "err", err)
+			}
+		}(&deliveries[i])
+	}
+	wg.Wait()
+
+	return nil
+}
+
+// deliver sends delivery and updates its status: delivered on success, pending with next attempt time on failure,
+// failed if attempts are exhausted or webhook is disabled.
+func (d *Dispatcher) deliver(ctx context.Context, hook *db.Webhook, dl *db.WebhookDelivery) error {
+	now := time.Now()
+	dl.Attempts++
+
+	var err error
+	if hook == nil || hook.StatusID != db.StatusEnabled {
+		err = errWebhookDisabled
+	} else {
+		dl.ResponseCode, err = d.send(ctx, hook, dl, now)
+	}
+
+	switch {
+	case err == nil:
+		dl.DeliveryStatusID = db.DeliveryDelivered
+		dl.DeliveredAt = &now
+		dl.Error = nil
+	case errors.Is(err, errWebhookDisabled) || dl.Attempts >= d.cfg.MaxAttempts:
+		dl.DeliveryStatusID = db.DeliveryFailed
+		dl.Error = errorString(err)
+	default:
+		dl.NextAttemptAt = now.Add(retryDelay(dl.Attempts))
+		dl.Error = errorString(err)
+	}
+
+	_, err = d.repo.UpdateWebhookDelivery(ctx, dl, db.WithColumns(
+		db.Columns.WebhookDelivery.Attempts,
+		db.Columns.WebhookDelivery.NextAttemptAt,
+		db.Columns.WebhookDelivery.ResponseCode,
+		db.Columns.WebhookDelivery.Error,
+		db.Columns.WebhookDelivery.DeliveredAt,
+		db.Columns.WebhookDelivery.DeliveryStatusID,
+	))
+
+	return err
+}
+
+// send posts signed delivery payload to webhook url. Non 2xx response is an error.
+func (d *Dispatcher) send(ctx context.Context, hook *db.Webhook, dl *db.WebhookDelivery, now time.Time) (*int, error) {
+	body := []byte(dl.Payload)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	ts := now.Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(HeaderID, strconv.Itoa(dl.ID))
+	req.Header.Set(HeaderEvent, dl.Event)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(ts, 10))
+	req.Header.Set(HeaderSignature, "sha256="+Sign(hook.Secret, ts, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	code := resp.StatusCode
+	if code < 200 || code >= 300 {
+		return &code, fmt.Errorf("unexpected response status %d", code)
+	}
+
+	return &code, nil
+}
+
+// retryDelay returns delay before the next attempt after failed attempts.
+func retryDelay(attempts int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempts && delay < retryMaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, retryMaxDelay)
+}
+
+// errorString returns error text cut to maxErrorLen bytes on a rune boundary.
+func errorString(err error) *string {
+	s := err.Error()
+	if len(s) > maxErrorLen {
+		cut := maxErrorLen
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		s = s[:cut]
+	}
+
+	return &s
+}
@@ -0,0 +1,251 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"apisrv/pkg/db"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+)
+
+// Event is a content lifecycle event name.
+type Event string
+
+const (
+	EventNewsCreated   Event = "news.created"
+	EventNewsUpdated   Event = "news.updated"
+	EventNewsDeleted   Event = "news.deleted"
+	EventNewsPublished Event = "news.published"
+
+	EventCategoryCreated Event = "category.created"
+	EventCategoryUpdated Event = "category.updated"
+	EventCategoryDeleted Event = "category.deleted"
+
+	EventTagCreated Event = "tag.created"
+	EventTagUpdated Event = "tag.updated"
+	EventTagDeleted Event = "tag.deleted"
+
+	EventSuggestionCreated Event = "suggestion.created"
+)
+
+// Events is a list of all supported events.
+var Events = []Event{
+	EventNewsCreated, EventNewsUpdated, EventNewsDeleted, EventNewsPublished,
+	EventCategoryCreated, EventCategoryUpdated, EventCategoryDeleted,
+	EventTagCreated, EventTagUpdated, EventTagDeleted,
+	EventSuggestionCreated,
+}
+
+// IsValid checks that event is supported.
+func (e Event) IsValid() bool {
+	return slices.Contains(Events, e)
+}
+
+// Payload is a JSON body of webhook request.
+type Payload struct {
+	Event     Event     `json:"event"`
+	CreatedAt time.Time `json:"createdAt"`
+	Data      any       `json:"data"`
+}
+
+// News is a payload data of news events.
+type News struct {
+	ID          int       `json:"id"`
+	Title       string    `json:"title"`
+	Slug        string    `json:"slug"`
+	CategoryID  int       `json:"categoryId"`
+	TagIDs      []int     `json:"tagIds"`
+	PublishedAt time.Time `json:"publishedAt"`
+	StatusID    int       `json:"statusId"`
+}
+
+func NewNews(in *db.News) *News {
+	if in == nil {
+		return nil
+	}
+
+	return &News{
+		ID:          in.ID,
+		Title:       in.Title,
+		Slug:        in.Slug,
+		CategoryID:  in.CategoryID,
+		TagIDs:      in.TagIDs,
+		PublishedAt: in.PublishedAt,
+		StatusID:    in.StatusID,
+	}
+}
+
+// Category is a payload data of category events.
+type Category struct {
+	ID       int    `json:"id"`
+	Title    string `json:"title"`
+	Slug     string `json:"slug"`
+	StatusID int    `json:"statusId"`
+}
+
+func NewCategory(in *db.Category) *Category {
+	if in == nil {
+		return nil
+	}
+
+	return &Category{
+		ID:       in.ID,
+		Title:    in.Title,
+		Slug:     in.Slug,
+		StatusID: in.StatusID,
+	}
+}
+
+// Tag is a payload data of tag events.
+type Tag struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	StatusID int    `json:"statusId"`
+}
+
+func NewTag(in *db.Tag) *Tag {
+	if in == nil {
+		return nil
+	}
+
+	return &Tag{
+		ID:       in.ID,
+		Name:     in.Name,
+		StatusID: in.StatusID,
+	}
+}
+
+// Suggestion is a payload data of suggestion events.
+type Suggestion struct {
+	ID         int    `json:"id"`
+	Title      string `json:"title"`
+	CategoryID int    `json:"categoryId"`
+}
+
+func NewSuggestion(in *db.Suggestion) *Suggestion {
+	if in == nil {
+		return nil
+	}
+
+	return &Suggestion{
+		ID:         in.ID,
+		Title:      in.Title,
+		CategoryID: in.CategoryID,
+	}
+}
+
+// Object is a payload data of delete events.
+type Object struct {
+	ID int `json:"id"`
+}
+
+// Emitter adds event deliveries for all enabled webhooks subscribed to the event.
+// Deliveries are stored in db and sent by Dispatcher, so emitting within a transaction guarantees
+// that events are delivered only for committed changes.
+type Emitter struct {
+	repo db.WebhookRepo
+}
+
+func NewEmitter(dbo orm.DB) Emitter {
+	return Emitter{repo: db.NewWebhookRepo(dbo).WithEnabledOnly()}
+}
+
+// WithTransaction returns Emitter, which adds deliveries within tx.
+func (e Emitter) WithTransaction(tx *pg.Tx) Emitter {
+	e.repo = e.repo.WithTransaction(tx)
+	return e
+}
+
+// Emit adds event deliveries with data as payload.
+func (e Emitter) Emit(ctx context.Context, event Event, data any) error {
+	return e.emit(ctx, event, nil, data)
+}
+
+// EmitPublished adds news.published deliveries, if news goes live: it becomes enabled with publishedAt in the past
+// or its publishedAt is moved to the past. Prev is nil for added news. News with future publishedAt are emitted by Dispatcher.
+func (e Emitter) EmitPublished(ctx context.Context, prev, news *db.News) error {
+	now := time.Now()
+	if !isLive(news, now) || (prev != nil && isLive(prev, now)) {
+		return nil
+	}
+
+	return e.emitPublished(ctx, news)
+}
+
+// emitPublished adds news.published deliveries deduplicated by news publishedAt, so each publication is emitted once.
+func (e Emitter) emitPublished(ctx context.Context, news *db.News) error {
+	key := string(EventNewsPublished) + ":" + strconv.Itoa(news.ID) + ":" + strconv.FormatInt(news.PublishedAt.Unix(), 10)
+	return e.emit(ctx, EventNewsPublished, &key, NewNews(news))
+}
+
+// isLive checks that news is enabled and published at the time.
+func isLive(news *db.News, t time.Time) bool {
+	return news.StatusID == db.StatusEnabled && !news.PublishedAt.After(t)
+}
+
+// emit adds event deliveries. Non-nil key deduplicates deliveries of the same event for each webhook.
+func (e Emitter) emit(ctx context.Context, event Event, key *string, data any) error {
+	hooks, err := e.repo.WebhooksByFilters(ctx, nil, db.PagerNoLimit, db.WithColumns(db.Columns.Webhook.ID, db.Columns.Webhook.Events))
+	if err != nil {
+		return fmt.Errorf("read webhooks: %w", err)
+	}
+
+	now := time.Now()
+	var deliveries []db.WebhookDelivery
+	for _, hook := range hooks {
+		if !subscribed(hook.Events, event) {
+			continue
+		}
+
+		deliveries = append(deliveries, db.WebhookDelivery{
+			WebhookID:        hook.ID,
+			Event:            string(event),
+			Key:              key,
+			NextAttemptAt:    now,
+			DeliveryStatusID: db.DeliveryPending,
+		})
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(Payload{Event: event, CreatedAt: now, Data: data})
+	if err != nil {
+		return fmt.Errorf("marshal payload: %w", err)
+	}
+
+	for i := range deliveries {
+		deliveries[i].Payload = string(body)
+	}
+
+	if err = e.repo.AddWebhookDeliveries(ctx, deliveries); err != nil {
+		return fmt.Errorf("add webhook deliveries: %w", err)
+	}
+
+	return nil
+}
+
+// subscribed checks that webhook with events subscription receives event. Empty events means all events.
+func subscribed(events []string, event Event) bool {
+	return len(events) == 0 || slices.Contains(events, string(event))
+}
+
+// Sign returns signature of webhook request body sent at timestamp: hex encoded HMAC-SHA256 of "{timestamp}.{body}" with webhook secret.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
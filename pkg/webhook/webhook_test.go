@@ -0,0 +1,104 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"apisrv/pkg/db"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSign(t *testing.T) {
+	Convey("Test Sign", t, func() {
+		body := []byte(`{"event":"news.created"}`)
+
+		s := Sign("secret", 1700000000, body)
+		So(s, ShouldHaveLength, 64)
+		So(Sign("secret", 1700000000, body), ShouldEqual, s)
+		So(Sign("other", 1700000000, body), ShouldNotEqual, s)
+		So(Sign("secret", 1700000001, body), ShouldNotEqual, s)
+	})
+}
+
+func TestSubscribed(t *testing.T) {
+	Convey("Test subscribed", t, func() {
+		So(subscribed(nil, EventNewsCreated), ShouldBeTrue)
+		So(subscribed([]string{"news.created", "news.deleted"}, EventNewsCreated), ShouldBeTrue)
+		So(subscribed([]string{"news.deleted"}, EventNewsCreated), ShouldBeFalse)
+		So(Event("news.created").IsValid(), ShouldBeTrue)
+		So(Event("news.viewed").IsValid(), ShouldBeFalse)
+	})
+}
+
+func TestIsLive(t *testing.T) {
+	Convey("Test isLive", t, func() {
+		now := time.Now()
+		So(isLive(&db.News{StatusID: db.StatusEnabled, PublishedAt: now.Add(-time.Hour)}, now), ShouldBeTrue)
+		So(isLive(&db.News{StatusID: db.StatusEnabled, PublishedAt: now}, now), ShouldBeTrue)
+		So(isLive(&db.News{StatusID: db.StatusEnabled, PublishedAt: now.Add(time.Hour)}, now), ShouldBeFalse)
+		So(isLive(&db.News{StatusID: db.StatusDisabled, PublishedAt: now.Add(-time.Hour)}, now), ShouldBeFalse)
+	})
+}
+
+func TestRetryDelay(t *testing.T) {
+	Convey("Test retryDelay", t, func() {
+		So(retryDelay(1), ShouldEqual, 30*time.Second)
+		So(retryDelay(2), ShouldEqual, time.Minute)
+		So(retryDelay(4), ShouldEqual, 4*time.Minute)
+		So(retryDelay(7), ShouldEqual, 32*time.Minute)
+		So(retryDelay(20), ShouldEqual, time.Hour)
+	})
+}
+
+func TestErrorString(t *testing.T) {
+	Convey("Test errorString", t, func() {
+		So(*errorString(errors.New("timeout")), ShouldEqual, "timeout")
+
+		// multibyte rune at the limit is not cut in the middle
+		s := *errorString(errors.New(strings.Repeat("a", maxErrorLen-1) + "ж"))
+		So(s, ShouldHaveLength, maxErrorLen-1)
+		So(utf8.ValidString(s), ShouldBeTrue)
+	})
+}
+
+func TestDispatcher_send(t *testing.T) {
+	Convey("Test Dispatcher send", t, func() {
+		var req *http.Request
+		var body []byte
+		code := http.StatusOK
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			req = r
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(code)
+		}))
+		defer srv.Close()
+
+		d := &Dispatcher{client: srv.Client()}
+		hook := &db.Webhook{ID: 1, URL: srv.URL, Secret: "secret"}
+		dl := &db.WebhookDelivery{ID: 10, WebhookID: 1, Event: string(EventNewsCreated), Payload: `{"event":"news.created"}`}
+		now := time.Unix(1700000000, 0)
+
+		respCode, err := d.send(context.Background(), hook, dl, now)
+		So(err, ShouldBeNil)
+		So(*respCode, ShouldEqual, http.StatusOK)
+		So(string(body), ShouldEqual, dl.Payload)
+		So(req.Header.Get(HeaderID), ShouldEqual, "10")
+		So(req.Header.Get(HeaderEvent), ShouldEqual, "news.created")
+		So(req.Header.Get(HeaderTimestamp), ShouldEqual, strconv.FormatInt(now.Unix(), 10))
+		So(req.Header.Get(HeaderSignature), ShouldEqual, "sha256="+Sign("secret", now.Unix(), body))
+
+		code = http.StatusServiceUnavailable
+		respCode, err = d.send(context.Background(), hook, dl, now)
+		So(err, ShouldNotBeNil)
+		So(*respCode, ShouldEqual, http.StatusServiceUnavailable)
+	})
+}